@@ -166,747 +166,2800 @@ func (x *_GenesisState_9_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_blacklistedList      protoreflect.FieldDescriptor
-	fd_GenesisState_paused               protoreflect.FieldDescriptor
-	fd_GenesisState_masterMinter         protoreflect.FieldDescriptor
-	fd_GenesisState_mintersList          protoreflect.FieldDescriptor
-	fd_GenesisState_pauser               protoreflect.FieldDescriptor
-	fd_GenesisState_blacklister          protoreflect.FieldDescriptor
-	fd_GenesisState_owner                protoreflect.FieldDescriptor
-	fd_GenesisState_minterControllerList protoreflect.FieldDescriptor
-	fd_GenesisState_mintingDenom         protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
 
-func init() {
-	file_circle_fiattokenfactory_v1_genesis_proto_init()
-	md_GenesisState = File_circle_fiattokenfactory_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_blacklistedList = md_GenesisState.Fields().ByName("blacklistedList")
-	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
-	fd_GenesisState_masterMinter = md_GenesisState.Fields().ByName("masterMinter")
-	fd_GenesisState_mintersList = md_GenesisState.Fields().ByName("mintersList")
-	fd_GenesisState_pauser = md_GenesisState.Fields().ByName("pauser")
-	fd_GenesisState_blacklister = md_GenesisState.Fields().ByName("blacklister")
-	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
-	fd_GenesisState_minterControllerList = md_GenesisState.Fields().ByName("minterControllerList")
-	fd_GenesisState_mintingDenom = md_GenesisState.Fields().ByName("mintingDenom")
+type _GenesisState_11_list struct {
+	list *[]*GenesisState
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisState)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisState)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisState)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(GenesisState)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*MinterController
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterController)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterController)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.BlacklistedList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.BlacklistedList})
-		if !f(fd_GenesisState_blacklistedList, value) {
-			return
-		}
-	}
-	if x.Paused != nil {
-		value := protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
-		if !f(fd_GenesisState_paused, value) {
-			return
-		}
-	}
-	if x.MasterMinter != nil {
-		value := protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
-		if !f(fd_GenesisState_masterMinter, value) {
-			return
-		}
-	}
-	if len(x.MintersList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintersList})
-		if !f(fd_GenesisState_mintersList, value) {
-			return
-		}
-	}
-	if x.Pauser != nil {
-		value := protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
-		if !f(fd_GenesisState_pauser, value) {
-			return
-		}
-	}
-	if x.Blacklister != nil {
-		value := protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
-		if !f(fd_GenesisState_blacklister, value) {
-			return
-		}
-	}
-	if x.Owner != nil {
-		value := protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
-		if !f(fd_GenesisState_owner, value) {
-			return
-		}
-	}
-	if len(x.MinterControllerList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.MinterControllerList})
-		if !f(fd_GenesisState_minterControllerList, value) {
-			return
-		}
-	}
-	if x.MintingDenom != nil {
-		value := protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
-		if !f(fd_GenesisState_mintingDenom, value) {
-			return
-		}
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(MinterController)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		return len(x.BlacklistedList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		return x.Paused != nil
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		return x.MasterMinter != nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		return len(x.MintersList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		return x.Pauser != nil
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		return x.Blacklister != nil
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		return x.Owner != nil
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		return len(x.MinterControllerList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		return x.MintingDenom != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(MinterController)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*MintRateLimit
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		x.BlacklistedList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		x.Paused = nil
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		x.MasterMinter = nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		x.MintersList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		x.Pauser = nil
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		x.Blacklister = nil
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		x.Owner = nil
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		x.MinterControllerList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		x.MintingDenom = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(MintRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		if len(x.BlacklistedList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.BlacklistedList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		value := x.Paused
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		value := x.MasterMinter
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		if len(x.MintersList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.MintersList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		value := x.Pauser
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		value := x.Blacklister
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		value := x.Owner
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		if len(x.MinterControllerList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_9_list{})
-		}
-		listValue := &_GenesisState_9_list{list: &x.MinterControllerList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		value := x.MintingDenom
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(MintRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.BlacklistedList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		x.Paused = value.Message().Interface().(*Paused)
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		x.MasterMinter = value.Message().Interface().(*MasterMinter)
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.MintersList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		x.Pauser = value.Message().Interface().(*Pauser)
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		x.Blacklister = value.Message().Interface().(*Blacklister)
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		x.Owner = value.Message().Interface().(*Owner)
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_9_list)
-		x.MinterControllerList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		x.MintingDenom = value.Message().Interface().(*MintingDenom)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		if x.BlacklistedList == nil {
-			x.BlacklistedList = []*Blacklisted{}
-		}
-		value := &_GenesisState_2_list{list: &x.BlacklistedList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		if x.Paused == nil {
-			x.Paused = new(Paused)
-		}
-		return protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		if x.MasterMinter == nil {
-			x.MasterMinter = new(MasterMinter)
-		}
-		return protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		if x.MintersList == nil {
-			x.MintersList = []*Minters{}
-		}
-		value := &_GenesisState_5_list{list: &x.MintersList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		if x.Pauser == nil {
-			x.Pauser = new(Pauser)
-		}
-		return protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		if x.Blacklister == nil {
-			x.Blacklister = new(Blacklister)
-		}
-		return protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		if x.Owner == nil {
-			x.Owner = new(Owner)
-		}
-		return protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		if x.MinterControllerList == nil {
-			x.MinterControllerList = []*MinterController{}
-		}
-		value := &_GenesisState_9_list{list: &x.MinterControllerList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		if x.MintingDenom == nil {
-			x.MintingDenom = new(MintingDenom)
-		}
-		return protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*MintWindow
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		list := []*Blacklisted{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		m := new(Paused)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		m := new(MasterMinter)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		list := []*Minters{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		m := new(Pauser)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		m := new(Blacklister)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		m := new(Owner)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		list := []*MinterController{}
-		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		m := new(MintingDenom)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintWindow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintWindow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(MintWindow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(MintWindow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*Frozen
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.BlacklistedList) > 0 {
-			for _, e := range x.BlacklistedList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Paused != nil {
-			l = options.Size(x.Paused)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MasterMinter != nil {
-			l = options.Size(x.MasterMinter)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MintersList) > 0 {
-			for _, e := range x.MintersList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pauser != nil {
-			l = options.Size(x.Pauser)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Blacklister != nil {
-			l = options.Size(x.Blacklister)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Owner != nil {
-			l = options.Size(x.Owner)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MinterControllerList) > 0 {
-			for _, e := range x.MinterControllerList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MintingDenom != nil {
-			l = options.Size(x.MintingDenom)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.MintingDenom != nil {
-			encoded, err := options.Marshal(x.MintingDenom)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.MinterControllerList) > 0 {
-			for iNdEx := len(x.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinterControllerList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Frozen)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Frozen)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(Frozen)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(Frozen)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*AllowedChannel
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(AllowedChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(AllowedChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*ChannelRateLimit
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*ChannelFlow
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelFlow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelFlow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelFlow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(ChannelFlow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*PendingOutflow
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingOutflow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingOutflow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(PendingOutflow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(PendingOutflow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_28_list)(nil)
+
+type _GenesisState_28_list struct {
+	list *[]*QuarantinedRefund
+}
+
+func (x *_GenesisState_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedRefund)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuarantinedRefund)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_28_list) AppendMutable() protoreflect.Value {
+	v := new(QuarantinedRefund)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_28_list) NewElement() protoreflect.Value {
+	v := new(QuarantinedRefund)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                       protoreflect.MessageDescriptor
+	fd_GenesisState_blacklistedList       protoreflect.FieldDescriptor
+	fd_GenesisState_paused                protoreflect.FieldDescriptor
+	fd_GenesisState_masterMinter          protoreflect.FieldDescriptor
+	fd_GenesisState_mintersList           protoreflect.FieldDescriptor
+	fd_GenesisState_pauser                protoreflect.FieldDescriptor
+	fd_GenesisState_blacklister           protoreflect.FieldDescriptor
+	fd_GenesisState_owner                 protoreflect.FieldDescriptor
+	fd_GenesisState_minterControllerList  protoreflect.FieldDescriptor
+	fd_GenesisState_mintingDenom          protoreflect.FieldDescriptor
+	fd_GenesisState_fiatTokens            protoreflect.FieldDescriptor
+	fd_GenesisState_pendingMasterMinter   protoreflect.FieldDescriptor
+	fd_GenesisState_pendingPauser         protoreflect.FieldDescriptor
+	fd_GenesisState_pendingBlacklister    protoreflect.FieldDescriptor
+	fd_GenesisState_pendingOwner          protoreflect.FieldDescriptor
+	fd_GenesisState_minterControllerLinks protoreflect.FieldDescriptor
+	fd_GenesisState_mintRateLimits        protoreflect.FieldDescriptor
+	fd_GenesisState_mintWindows           protoreflect.FieldDescriptor
+	fd_GenesisState_maxSupply             protoreflect.FieldDescriptor
+	fd_GenesisState_wiper                 protoreflect.FieldDescriptor
+	fd_GenesisState_pendingWiper          protoreflect.FieldDescriptor
+	fd_GenesisState_frozenList            protoreflect.FieldDescriptor
+	fd_GenesisState_allowedChannelList    protoreflect.FieldDescriptor
+	fd_GenesisState_channelRateLimits     protoreflect.FieldDescriptor
+	fd_GenesisState_channelFlows          protoreflect.FieldDescriptor
+	fd_GenesisState_pendingOutflows       protoreflect.FieldDescriptor
+	fd_GenesisState_params                protoreflect.FieldDescriptor
+	fd_GenesisState_quarantinedRefunds    protoreflect.FieldDescriptor
+	fd_GenesisState_channelAllowlist      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_genesis_proto_init()
+	md_GenesisState = File_circle_fiattokenfactory_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_blacklistedList = md_GenesisState.Fields().ByName("blacklistedList")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_masterMinter = md_GenesisState.Fields().ByName("masterMinter")
+	fd_GenesisState_mintersList = md_GenesisState.Fields().ByName("mintersList")
+	fd_GenesisState_pauser = md_GenesisState.Fields().ByName("pauser")
+	fd_GenesisState_blacklister = md_GenesisState.Fields().ByName("blacklister")
+	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
+	fd_GenesisState_minterControllerList = md_GenesisState.Fields().ByName("minterControllerList")
+	fd_GenesisState_mintingDenom = md_GenesisState.Fields().ByName("mintingDenom")
+	fd_GenesisState_fiatTokens = md_GenesisState.Fields().ByName("fiatTokens")
+	fd_GenesisState_pendingMasterMinter = md_GenesisState.Fields().ByName("pendingMasterMinter")
+	fd_GenesisState_pendingPauser = md_GenesisState.Fields().ByName("pendingPauser")
+	fd_GenesisState_pendingBlacklister = md_GenesisState.Fields().ByName("pendingBlacklister")
+	fd_GenesisState_pendingOwner = md_GenesisState.Fields().ByName("pendingOwner")
+	fd_GenesisState_minterControllerLinks = md_GenesisState.Fields().ByName("minterControllerLinks")
+	fd_GenesisState_mintRateLimits = md_GenesisState.Fields().ByName("mintRateLimits")
+	fd_GenesisState_mintWindows = md_GenesisState.Fields().ByName("mintWindows")
+	fd_GenesisState_maxSupply = md_GenesisState.Fields().ByName("maxSupply")
+	fd_GenesisState_wiper = md_GenesisState.Fields().ByName("wiper")
+	fd_GenesisState_pendingWiper = md_GenesisState.Fields().ByName("pendingWiper")
+	fd_GenesisState_frozenList = md_GenesisState.Fields().ByName("frozenList")
+	fd_GenesisState_allowedChannelList = md_GenesisState.Fields().ByName("allowedChannelList")
+	fd_GenesisState_channelRateLimits = md_GenesisState.Fields().ByName("channelRateLimits")
+	fd_GenesisState_channelFlows = md_GenesisState.Fields().ByName("channelFlows")
+	fd_GenesisState_pendingOutflows = md_GenesisState.Fields().ByName("pendingOutflows")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_quarantinedRefunds = md_GenesisState.Fields().ByName("quarantinedRefunds")
+	fd_GenesisState_channelAllowlist = md_GenesisState.Fields().ByName("channelAllowlist")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.BlacklistedList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.BlacklistedList})
+		if !f(fd_GenesisState_blacklistedList, value) {
+			return
+		}
+	}
+	if x.Paused != nil {
+		value := protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.MasterMinter != nil {
+		value := protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
+		if !f(fd_GenesisState_masterMinter, value) {
+			return
+		}
+	}
+	if len(x.MintersList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintersList})
+		if !f(fd_GenesisState_mintersList, value) {
+			return
+		}
+	}
+	if x.Pauser != nil {
+		value := protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
+		if !f(fd_GenesisState_pauser, value) {
+			return
+		}
+	}
+	if x.Blacklister != nil {
+		value := protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
+		if !f(fd_GenesisState_blacklister, value) {
+			return
+		}
+	}
+	if x.Owner != nil {
+		value := protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
+		if !f(fd_GenesisState_owner, value) {
+			return
+		}
+	}
+	if len(x.MinterControllerList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.MinterControllerList})
+		if !f(fd_GenesisState_minterControllerList, value) {
+			return
+		}
+	}
+	if x.MintingDenom != nil {
+		value := protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
+		if !f(fd_GenesisState_mintingDenom, value) {
+			return
+		}
+	}
+	if len(x.FiatTokens) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.FiatTokens})
+		if !f(fd_GenesisState_fiatTokens, value) {
+			return
+		}
+	}
+	if x.PendingMasterMinter != nil {
+		value := protoreflect.ValueOfMessage(x.PendingMasterMinter.ProtoReflect())
+		if !f(fd_GenesisState_pendingMasterMinter, value) {
+			return
+		}
+	}
+	if x.PendingPauser != nil {
+		value := protoreflect.ValueOfMessage(x.PendingPauser.ProtoReflect())
+		if !f(fd_GenesisState_pendingPauser, value) {
+			return
+		}
+	}
+	if x.PendingBlacklister != nil {
+		value := protoreflect.ValueOfMessage(x.PendingBlacklister.ProtoReflect())
+		if !f(fd_GenesisState_pendingBlacklister, value) {
+			return
+		}
+	}
+	if x.PendingOwner != nil {
+		value := protoreflect.ValueOfMessage(x.PendingOwner.ProtoReflect())
+		if !f(fd_GenesisState_pendingOwner, value) {
+			return
+		}
+	}
+	if len(x.MinterControllerLinks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.MinterControllerLinks})
+		if !f(fd_GenesisState_minterControllerLinks, value) {
+			return
+		}
+	}
+	if len(x.MintRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.MintRateLimits})
+		if !f(fd_GenesisState_mintRateLimits, value) {
+			return
+		}
+	}
+	if len(x.MintWindows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.MintWindows})
+		if !f(fd_GenesisState_mintWindows, value) {
+			return
+		}
+	}
+	if x.MaxSupply != nil {
+		value := protoreflect.ValueOfMessage(x.MaxSupply.ProtoReflect())
+		if !f(fd_GenesisState_maxSupply, value) {
+			return
+		}
+	}
+	if x.Wiper != nil {
+		value := protoreflect.ValueOfMessage(x.Wiper.ProtoReflect())
+		if !f(fd_GenesisState_wiper, value) {
+			return
+		}
+	}
+	if x.PendingWiper != nil {
+		value := protoreflect.ValueOfMessage(x.PendingWiper.ProtoReflect())
+		if !f(fd_GenesisState_pendingWiper, value) {
+			return
+		}
+	}
+	if len(x.FrozenList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.FrozenList})
+		if !f(fd_GenesisState_frozenList, value) {
+			return
+		}
+	}
+	if len(x.AllowedChannelList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.AllowedChannelList})
+		if !f(fd_GenesisState_allowedChannelList, value) {
+			return
+		}
+	}
+	if len(x.ChannelRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.ChannelRateLimits})
+		if !f(fd_GenesisState_channelRateLimits, value) {
+			return
+		}
+	}
+	if len(x.ChannelFlows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.ChannelFlows})
+		if !f(fd_GenesisState_channelFlows, value) {
+			return
+		}
+	}
+	if len(x.PendingOutflows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.PendingOutflows})
+		if !f(fd_GenesisState_pendingOutflows, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.QuarantinedRefunds) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.QuarantinedRefunds})
+		if !f(fd_GenesisState_quarantinedRefunds, value) {
+			return
+		}
+	}
+	if x.ChannelAllowlist != nil {
+		value := protoreflect.ValueOfMessage(x.ChannelAllowlist.ProtoReflect())
+		if !f(fd_GenesisState_channelAllowlist, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		return len(x.BlacklistedList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		return x.Paused != nil
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		return x.MasterMinter != nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		return len(x.MintersList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		return x.Pauser != nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		return x.Blacklister != nil
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		return x.Owner != nil
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		return len(x.MinterControllerList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		return x.MintingDenom != nil
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		return len(x.FiatTokens) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		return x.PendingMasterMinter != nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		return x.PendingPauser != nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		return x.PendingBlacklister != nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		return x.PendingOwner != nil
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		return len(x.MinterControllerLinks) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		return len(x.MintRateLimits) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		return len(x.MintWindows) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		return x.MaxSupply != nil
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		return x.Wiper != nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		return x.PendingWiper != nil
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		return len(x.FrozenList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		return len(x.AllowedChannelList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		return len(x.ChannelRateLimits) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		return len(x.ChannelFlows) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		return len(x.PendingOutflows) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		return x.Params != nil
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		return len(x.QuarantinedRefunds) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		return x.ChannelAllowlist != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		x.BlacklistedList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		x.Paused = nil
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		x.MasterMinter = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		x.MintersList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		x.Pauser = nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		x.Blacklister = nil
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		x.Owner = nil
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		x.MinterControllerList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		x.MintingDenom = nil
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		x.FiatTokens = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		x.PendingMasterMinter = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		x.PendingPauser = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		x.PendingBlacklister = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		x.PendingOwner = nil
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		x.MinterControllerLinks = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		x.MintRateLimits = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		x.MintWindows = nil
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		x.MaxSupply = nil
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		x.Wiper = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		x.PendingWiper = nil
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		x.FrozenList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		x.AllowedChannelList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		x.ChannelRateLimits = nil
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		x.ChannelFlows = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		x.PendingOutflows = nil
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		x.Params = nil
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		x.QuarantinedRefunds = nil
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		x.ChannelAllowlist = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		if len(x.BlacklistedList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.BlacklistedList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		value := x.MasterMinter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		if len(x.MintersList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.MintersList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		value := x.Pauser
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		value := x.Blacklister
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		value := x.Owner
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		if len(x.MinterControllerList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.MinterControllerList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		value := x.MintingDenom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		if len(x.FiatTokens) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.FiatTokens}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		value := x.PendingMasterMinter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		value := x.PendingPauser
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		value := x.PendingBlacklister
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		value := x.PendingOwner
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		if len(x.MinterControllerLinks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.MinterControllerLinks}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		if len(x.MintRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.MintRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		if len(x.MintWindows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.MintWindows}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		value := x.Wiper
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		value := x.PendingWiper
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		if len(x.FrozenList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.FrozenList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		if len(x.AllowedChannelList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.AllowedChannelList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		if len(x.ChannelRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		if len(x.ChannelFlows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.ChannelFlows}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		if len(x.PendingOutflows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.PendingOutflows}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		if len(x.QuarantinedRefunds) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.QuarantinedRefunds}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		value := x.ChannelAllowlist
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.BlacklistedList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		x.Paused = value.Message().Interface().(*Paused)
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		x.MasterMinter = value.Message().Interface().(*MasterMinter)
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.MintersList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		x.Pauser = value.Message().Interface().(*Pauser)
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		x.Blacklister = value.Message().Interface().(*Blacklister)
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		x.Owner = value.Message().Interface().(*Owner)
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.MinterControllerList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		x.MintingDenom = value.Message().Interface().(*MintingDenom)
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.FiatTokens = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		x.PendingMasterMinter = value.Message().Interface().(*PendingRole)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		x.PendingPauser = value.Message().Interface().(*PendingRole)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		x.PendingBlacklister = value.Message().Interface().(*PendingRole)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		x.PendingOwner = value.Message().Interface().(*PendingRole)
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.MinterControllerLinks = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.MintRateLimits = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.MintWindows = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		x.MaxSupply = value.Message().Interface().(*MaxSupply)
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		x.Wiper = value.Message().Interface().(*Wiper)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		x.PendingWiper = value.Message().Interface().(*PendingRole)
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.FrozenList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.AllowedChannelList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.ChannelRateLimits = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.ChannelFlows = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.PendingOutflows = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.QuarantinedRefunds = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		x.ChannelAllowlist = value.Message().Interface().(*ChannelAllowlist)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		if x.BlacklistedList == nil {
+			x.BlacklistedList = []*Blacklisted{}
+		}
+		value := &_GenesisState_2_list{list: &x.BlacklistedList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		if x.Paused == nil {
+			x.Paused = new(Paused)
+		}
+		return protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		if x.MasterMinter == nil {
+			x.MasterMinter = new(MasterMinter)
+		}
+		return protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		if x.MintersList == nil {
+			x.MintersList = []*Minters{}
+		}
+		value := &_GenesisState_5_list{list: &x.MintersList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		if x.Pauser == nil {
+			x.Pauser = new(Pauser)
+		}
+		return protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		if x.Blacklister == nil {
+			x.Blacklister = new(Blacklister)
+		}
+		return protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		if x.Owner == nil {
+			x.Owner = new(Owner)
+		}
+		return protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		if x.MinterControllerList == nil {
+			x.MinterControllerList = []*MinterController{}
+		}
+		value := &_GenesisState_9_list{list: &x.MinterControllerList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		if x.MintingDenom == nil {
+			x.MintingDenom = new(MintingDenom)
+		}
+		return protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		if x.FiatTokens == nil {
+			x.FiatTokens = []*GenesisState{}
+		}
+		value := &_GenesisState_11_list{list: &x.FiatTokens}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		if x.PendingMasterMinter == nil {
+			x.PendingMasterMinter = new(PendingRole)
+		}
+		return protoreflect.ValueOfMessage(x.PendingMasterMinter.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		if x.PendingPauser == nil {
+			x.PendingPauser = new(PendingRole)
+		}
+		return protoreflect.ValueOfMessage(x.PendingPauser.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		if x.PendingBlacklister == nil {
+			x.PendingBlacklister = new(PendingRole)
+		}
+		return protoreflect.ValueOfMessage(x.PendingBlacklister.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		if x.PendingOwner == nil {
+			x.PendingOwner = new(PendingRole)
+		}
+		return protoreflect.ValueOfMessage(x.PendingOwner.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		if x.MinterControllerLinks == nil {
+			x.MinterControllerLinks = []*MinterController{}
+		}
+		value := &_GenesisState_16_list{list: &x.MinterControllerLinks}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		if x.MintRateLimits == nil {
+			x.MintRateLimits = []*MintRateLimit{}
+		}
+		value := &_GenesisState_17_list{list: &x.MintRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		if x.MintWindows == nil {
+			x.MintWindows = []*MintWindow{}
+		}
+		value := &_GenesisState_18_list{list: &x.MintWindows}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		if x.MaxSupply == nil {
+			x.MaxSupply = new(MaxSupply)
+		}
+		return protoreflect.ValueOfMessage(x.MaxSupply.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		if x.Wiper == nil {
+			x.Wiper = new(Wiper)
+		}
+		return protoreflect.ValueOfMessage(x.Wiper.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		if x.PendingWiper == nil {
+			x.PendingWiper = new(PendingRole)
+		}
+		return protoreflect.ValueOfMessage(x.PendingWiper.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		if x.FrozenList == nil {
+			x.FrozenList = []*Frozen{}
+		}
+		value := &_GenesisState_22_list{list: &x.FrozenList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		if x.AllowedChannelList == nil {
+			x.AllowedChannelList = []*AllowedChannel{}
+		}
+		value := &_GenesisState_23_list{list: &x.AllowedChannelList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		if x.ChannelRateLimits == nil {
+			x.ChannelRateLimits = []*ChannelRateLimit{}
+		}
+		value := &_GenesisState_24_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		if x.ChannelFlows == nil {
+			x.ChannelFlows = []*ChannelFlow{}
+		}
+		value := &_GenesisState_25_list{list: &x.ChannelFlows}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		if x.PendingOutflows == nil {
+			x.PendingOutflows = []*PendingOutflow{}
+		}
+		value := &_GenesisState_26_list{list: &x.PendingOutflows}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		if x.QuarantinedRefunds == nil {
+			x.QuarantinedRefunds = []*QuarantinedRefund{}
+		}
+		value := &_GenesisState_28_list{list: &x.QuarantinedRefunds}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		if x.ChannelAllowlist == nil {
+			x.ChannelAllowlist = new(ChannelAllowlist)
+		}
+		return protoreflect.ValueOfMessage(x.ChannelAllowlist.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		list := []*Blacklisted{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		m := new(Paused)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		m := new(MasterMinter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		list := []*Minters{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		m := new(Pauser)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		m := new(Blacklister)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		m := new(Owner)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		list := []*MinterController{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		m := new(MintingDenom)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.fiatTokens":
+		list := []*GenesisState{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter":
+		m := new(PendingRole)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingPauser":
+		m := new(PendingRole)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingBlacklister":
+		m := new(PendingRole)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwner":
+		m := new(PendingRole)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerLinks":
+		list := []*MinterController{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.mintRateLimits":
+		list := []*MintRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.mintWindows":
+		list := []*MintWindow{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.maxSupply":
+		m := new(MaxSupply)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.wiper":
+		m := new(Wiper)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pendingWiper":
+		m := new(PendingRole)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.frozenList":
+		list := []*Frozen{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.allowedChannelList":
+		list := []*AllowedChannel{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		list := []*ChannelRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.channelFlows":
+		list := []*ChannelFlow{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOutflows":
+		list := []*PendingOutflow{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds":
+		list := []*QuarantinedRefund{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.channelAllowlist":
+		m := new(ChannelAllowlist)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.BlacklistedList) > 0 {
+			for _, e := range x.BlacklistedList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Paused != nil {
+			l = options.Size(x.Paused)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MasterMinter != nil {
+			l = options.Size(x.MasterMinter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MintersList) > 0 {
+			for _, e := range x.MintersList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pauser != nil {
+			l = options.Size(x.Pauser)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Blacklister != nil {
+			l = options.Size(x.Blacklister)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Owner != nil {
+			l = options.Size(x.Owner)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinterControllerList) > 0 {
+			for _, e := range x.MinterControllerList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MintingDenom != nil {
+			l = options.Size(x.MintingDenom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FiatTokens) > 0 {
+			for _, e := range x.FiatTokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PendingMasterMinter != nil {
+			l = options.Size(x.PendingMasterMinter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingPauser != nil {
+			l = options.Size(x.PendingPauser)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingBlacklister != nil {
+			l = options.Size(x.PendingBlacklister)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingOwner != nil {
+			l = options.Size(x.PendingOwner)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinterControllerLinks) > 0 {
+			for _, e := range x.MinterControllerLinks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintRateLimits) > 0 {
+			for _, e := range x.MintRateLimits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintWindows) > 0 {
+			for _, e := range x.MintWindows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxSupply != nil {
+			l = options.Size(x.MaxSupply)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Wiper != nil {
+			l = options.Size(x.Wiper)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingWiper != nil {
+			l = options.Size(x.PendingWiper)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FrozenList) > 0 {
+			for _, e := range x.FrozenList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedChannelList) > 0 {
+			for _, e := range x.AllowedChannelList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for _, e := range x.ChannelRateLimits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChannelFlows) > 0 {
+			for _, e := range x.ChannelFlows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingOutflows) > 0 {
+			for _, e := range x.PendingOutflows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.QuarantinedRefunds) > 0 {
+			for _, e := range x.QuarantinedRefunds {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ChannelAllowlist != nil {
+			l = options.Size(x.ChannelAllowlist)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ChannelAllowlist != nil {
+			encoded, err := options.Marshal(x.ChannelAllowlist)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.QuarantinedRefunds) > 0 {
+			for iNdEx := len(x.QuarantinedRefunds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QuarantinedRefunds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.PendingOutflows) > 0 {
+			for iNdEx := len(x.PendingOutflows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingOutflows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.ChannelFlows) > 0 {
+			for iNdEx := len(x.ChannelFlows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelFlows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for iNdEx := len(x.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.AllowedChannelList) > 0 {
+			for iNdEx := len(x.AllowedChannelList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedChannelList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.FrozenList) > 0 {
+			for iNdEx := len(x.FrozenList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FrozenList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if x.PendingWiper != nil {
+			encoded, err := options.Marshal(x.PendingWiper)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.Wiper != nil {
+			encoded, err := options.Marshal(x.Wiper)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.MaxSupply != nil {
+			encoded, err := options.Marshal(x.MaxSupply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.MintWindows) > 0 {
+			for iNdEx := len(x.MintWindows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintWindows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.MintRateLimits) > 0 {
+			for iNdEx := len(x.MintRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.MinterControllerLinks) > 0 {
+			for iNdEx := len(x.MinterControllerLinks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterControllerLinks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.PendingOwner != nil {
+			encoded, err := options.Marshal(x.PendingOwner)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.PendingBlacklister != nil {
+			encoded, err := options.Marshal(x.PendingBlacklister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.PendingPauser != nil {
+			encoded, err := options.Marshal(x.PendingPauser)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.PendingMasterMinter != nil {
+			encoded, err := options.Marshal(x.PendingMasterMinter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.FiatTokens) > 0 {
+			for iNdEx := len(x.FiatTokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FiatTokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.MintingDenom != nil {
+			encoded, err := options.Marshal(x.MintingDenom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.MinterControllerList) > 0 {
+			for iNdEx := len(x.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterControllerList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.Owner != nil {
+			encoded, err := options.Marshal(x.Owner)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Blacklister != nil {
+			encoded, err := options.Marshal(x.Blacklister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Pauser != nil {
+			encoded, err := options.Marshal(x.Pauser)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MintersList) > 0 {
+			for iNdEx := len(x.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintersList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.MasterMinter != nil {
+			encoded, err := options.Marshal(x.MasterMinter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Paused != nil {
+			encoded, err := options.Marshal(x.Paused)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BlacklistedList) > 0 {
+			for iNdEx := len(x.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlacklistedList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlacklistedList = append(x.BlacklistedList, &Blacklisted{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedList[len(x.BlacklistedList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Paused == nil {
+					x.Paused = &Paused{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paused); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MasterMinter == nil {
+					x.MasterMinter = &MasterMinter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MasterMinter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintersList = append(x.MintersList, &Minters{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintersList[len(x.MintersList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pauser == nil {
+					x.Pauser = &Pauser{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pauser); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Blacklister == nil {
+					x.Blacklister = &Blacklister{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blacklister); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Owner == nil {
+					x.Owner = &Owner{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Owner); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterControllerList = append(x.MinterControllerList, &MinterController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterControllerList[len(x.MinterControllerList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintingDenom == nil {
+					x.MintingDenom = &MintingDenom{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingDenom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FiatTokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FiatTokens = append(x.FiatTokens, &GenesisState{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FiatTokens[len(x.FiatTokens)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingMasterMinter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingMasterMinter == nil {
+					x.PendingMasterMinter = &PendingRole{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingMasterMinter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingPauser", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4a
-			}
-		}
-		if x.Owner != nil {
-			encoded, err := options.Marshal(x.Owner)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.Blacklister != nil {
-			encoded, err := options.Marshal(x.Blacklister)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Pauser != nil {
-			encoded, err := options.Marshal(x.Pauser)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.MintersList) > 0 {
-			for iNdEx := len(x.MintersList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MintersList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if x.PendingPauser == nil {
+					x.PendingPauser = &PendingRole{}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if x.MasterMinter != nil {
-			encoded, err := options.Marshal(x.MasterMinter)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Paused != nil {
-			encoded, err := options.Marshal(x.Paused)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BlacklistedList) > 0 {
-			for iNdEx := len(x.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BlacklistedList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingPauser); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingBlacklister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingBlacklister == nil {
+					x.PendingBlacklister = &PendingRole{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingBlacklister); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingOwner == nil {
+					x.PendingOwner = &PendingRole{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingOwner); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterControllerLinks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterControllerLinks = append(x.MinterControllerLinks, &MinterController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterControllerLinks[len(x.MinterControllerLinks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				x.MintRateLimits = append(x.MintRateLimits, &MintRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintRateLimits[len(x.MintRateLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintWindows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.MintWindows = append(x.MintWindows, &MintWindow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintWindows[len(x.MintWindows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 2:
+				iNdEx = postIndex
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -933,14 +2986,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlacklistedList = append(x.BlacklistedList, &Blacklisted{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedList[len(x.BlacklistedList)-1]); err != nil {
+				if x.MaxSupply == nil {
+					x.MaxSupply = &MaxSupply{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxSupply); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wiper", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -967,16 +3022,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Paused == nil {
-					x.Paused = &Paused{}
+				if x.Wiper == nil {
+					x.Wiper = &Wiper{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paused); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wiper); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingWiper", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1003,16 +3058,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MasterMinter == nil {
-					x.MasterMinter = &MasterMinter{}
+				if x.PendingWiper == nil {
+					x.PendingWiper = &PendingRole{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MasterMinter); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingWiper); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 22:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1039,14 +3094,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MintersList = append(x.MintersList, &Minters{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintersList[len(x.MintersList)-1]); err != nil {
+				x.FrozenList = append(x.FrozenList, &Frozen{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FrozenList[len(x.FrozenList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannelList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1073,16 +3128,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pauser == nil {
-					x.Pauser = &Pauser{}
+				x.AllowedChannelList = append(x.AllowedChannelList, &AllowedChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedChannelList[len(x.AllowedChannelList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pauser); err != nil {
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelRateLimits = append(x.ChannelRateLimits, &ChannelRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelRateLimits[len(x.ChannelRateLimits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 25:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelFlows", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1109,16 +3196,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Blacklister == nil {
-					x.Blacklister = &Blacklister{}
+				x.ChannelFlows = append(x.ChannelFlows, &ChannelFlow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelFlows[len(x.ChannelFlows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blacklister); err != nil {
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOutflows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingOutflows = append(x.PendingOutflows, &PendingOutflow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingOutflows[len(x.PendingOutflows)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 27:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1145,16 +3264,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Owner == nil {
-					x.Owner = &Owner{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Owner); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 28:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRefunds", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1181,14 +3300,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinterControllerList = append(x.MinterControllerList, &MinterController{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterControllerList[len(x.MinterControllerList)-1]); err != nil {
+				x.QuarantinedRefunds = append(x.QuarantinedRefunds, &QuarantinedRefund{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QuarantinedRefunds[len(x.QuarantinedRefunds)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
+			case 29:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelAllowlist", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1215,10 +3334,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MintingDenom == nil {
-					x.MintingDenom = &MintingDenom{}
+				if x.ChannelAllowlist == nil {
+					x.ChannelAllowlist = &ChannelAllowlist{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingDenom); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelAllowlist); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1285,6 +3404,29 @@ type GenesisState struct {
 	Owner                *Owner              `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	MinterControllerList []*MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList,omitempty"`
 	MintingDenom         *MintingDenom       `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	// fiatTokens holds the state of every additionally registered fiat token,
+	// each identified by its mintingDenom.
+	FiatTokens          []*GenesisState `protobuf:"bytes,11,rep,name=fiatTokens,proto3" json:"fiatTokens,omitempty"`
+	PendingMasterMinter *PendingRole    `protobuf:"bytes,12,opt,name=pendingMasterMinter,proto3" json:"pendingMasterMinter,omitempty"`
+	PendingPauser       *PendingRole    `protobuf:"bytes,13,opt,name=pendingPauser,proto3" json:"pendingPauser,omitempty"`
+	PendingBlacklister  *PendingRole    `protobuf:"bytes,14,opt,name=pendingBlacklister,proto3" json:"pendingBlacklister,omitempty"`
+	PendingOwner        *PendingRole    `protobuf:"bytes,15,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
+	// every (controller, minter) link, including each controller's primary minter
+	MinterControllerLinks []*MinterController `protobuf:"bytes,16,rep,name=minterControllerLinks,proto3" json:"minterControllerLinks,omitempty"`
+	MintRateLimits        []*MintRateLimit    `protobuf:"bytes,17,rep,name=mintRateLimits,proto3" json:"mintRateLimits,omitempty"`
+	MintWindows           []*MintWindow       `protobuf:"bytes,18,rep,name=mintWindows,proto3" json:"mintWindows,omitempty"`
+	MaxSupply             *MaxSupply          `protobuf:"bytes,19,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	Wiper                 *Wiper              `protobuf:"bytes,20,opt,name=wiper,proto3" json:"wiper,omitempty"`
+	PendingWiper          *PendingRole        `protobuf:"bytes,21,opt,name=pendingWiper,proto3" json:"pendingWiper,omitempty"`
+	FrozenList            []*Frozen           `protobuf:"bytes,22,rep,name=frozenList,proto3" json:"frozenList,omitempty"`
+	AllowedChannelList    []*AllowedChannel   `protobuf:"bytes,23,rep,name=allowedChannelList,proto3" json:"allowedChannelList,omitempty"`
+	ChannelRateLimits     []*ChannelRateLimit `protobuf:"bytes,24,rep,name=channelRateLimits,proto3" json:"channelRateLimits,omitempty"`
+	ChannelFlows          []*ChannelFlow      `protobuf:"bytes,25,rep,name=channelFlows,proto3" json:"channelFlows,omitempty"`
+	PendingOutflows       []*PendingOutflow   `protobuf:"bytes,26,rep,name=pendingOutflows,proto3" json:"pendingOutflows,omitempty"`
+	// module wide parameters, only set on the top level genesis state
+	Params             *Params              `protobuf:"bytes,27,opt,name=params,proto3" json:"params,omitempty"`
+	QuarantinedRefunds []*QuarantinedRefund `protobuf:"bytes,28,rep,name=quarantinedRefunds,proto3" json:"quarantinedRefunds,omitempty"`
+	ChannelAllowlist   *ChannelAllowlist    `protobuf:"bytes,29,opt,name=channelAllowlist,proto3" json:"channelAllowlist,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1370,6 +3512,139 @@ func (x *GenesisState) GetMintingDenom() *MintingDenom {
 	return nil
 }
 
+func (x *GenesisState) GetFiatTokens() []*GenesisState {
+	if x != nil {
+		return x.FiatTokens
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingMasterMinter() *PendingRole {
+	if x != nil {
+		return x.PendingMasterMinter
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingPauser() *PendingRole {
+	if x != nil {
+		return x.PendingPauser
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingBlacklister() *PendingRole {
+	if x != nil {
+		return x.PendingBlacklister
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingOwner() *PendingRole {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMinterControllerLinks() []*MinterController {
+	if x != nil {
+		return x.MinterControllerLinks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintRateLimits() []*MintRateLimit {
+	if x != nil {
+		return x.MintRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintWindows() []*MintWindow {
+	if x != nil {
+		return x.MintWindows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMaxSupply() *MaxSupply {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWiper() *Wiper {
+	if x != nil {
+		return x.Wiper
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingWiper() *PendingRole {
+	if x != nil {
+		return x.PendingWiper
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFrozenList() []*Frozen {
+	if x != nil {
+		return x.FrozenList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowedChannelList() []*AllowedChannel {
+	if x != nil {
+		return x.AllowedChannelList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelRateLimits() []*ChannelRateLimit {
+	if x != nil {
+		return x.ChannelRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelFlows() []*ChannelFlow {
+	if x != nil {
+		return x.ChannelFlows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingOutflows() []*PendingOutflow {
+	if x != nil {
+		return x.PendingOutflows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQuarantinedRefunds() []*QuarantinedRefund {
+	if x != nil {
+		return x.QuarantinedRefunds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelAllowlist() *ChannelAllowlist {
+	if x != nil {
+		return x.ChannelAllowlist
+	}
+	return nil
+}
+
 var File_circle_fiattokenfactory_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_circle_fiattokenfactory_v1_genesis_proto_rawDesc = []byte{
@@ -1377,96 +3652,221 @@ var file_circle_fiattokenfactory_v1_genesis_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72, 0x63,
 	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x30, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
 	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61,
+	0x76, 0x31, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61,
 	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
 	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x32, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x74, 0x6f, 0x1a, 0x2b, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
-	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x26, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x30, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x32, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x26, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
 	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xc2, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x57, 0x0a, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
-	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63,
-	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x33, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75,
+	0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x69, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x11, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x49, 0x0a, 0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x6d,
+	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4e, 0x0a, 0x0a, 0x66,
+	0x69, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0a, 0x66, 0x69, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x13, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x49, 0x0a,
-	0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4b,
+	0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x15, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15,
+	0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e,
+	0x6d, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x4e,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x43,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x77, 0x69, 0x70, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x69, 0x70, 0x65, 0x72, 0x52, 0x05, 0x77, 0x69, 0x70, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x70, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x97, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69,
-	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a,
-	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x70, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x1a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x64, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x12, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x42, 0x97, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e,
+	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa,
+	0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1483,32 +3883,64 @@ func file_circle_fiattokenfactory_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_circle_fiattokenfactory_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_circle_fiattokenfactory_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),     // 0: circle.fiattokenfactory.v1.GenesisState
-	(*Blacklisted)(nil),      // 1: circle.fiattokenfactory.v1.Blacklisted
-	(*Paused)(nil),           // 2: circle.fiattokenfactory.v1.Paused
-	(*MasterMinter)(nil),     // 3: circle.fiattokenfactory.v1.MasterMinter
-	(*Minters)(nil),          // 4: circle.fiattokenfactory.v1.Minters
-	(*Pauser)(nil),           // 5: circle.fiattokenfactory.v1.Pauser
-	(*Blacklister)(nil),      // 6: circle.fiattokenfactory.v1.Blacklister
-	(*Owner)(nil),            // 7: circle.fiattokenfactory.v1.Owner
-	(*MinterController)(nil), // 8: circle.fiattokenfactory.v1.MinterController
-	(*MintingDenom)(nil),     // 9: circle.fiattokenfactory.v1.MintingDenom
+	(*GenesisState)(nil),      // 0: circle.fiattokenfactory.v1.GenesisState
+	(*Blacklisted)(nil),       // 1: circle.fiattokenfactory.v1.Blacklisted
+	(*Paused)(nil),            // 2: circle.fiattokenfactory.v1.Paused
+	(*MasterMinter)(nil),      // 3: circle.fiattokenfactory.v1.MasterMinter
+	(*Minters)(nil),           // 4: circle.fiattokenfactory.v1.Minters
+	(*Pauser)(nil),            // 5: circle.fiattokenfactory.v1.Pauser
+	(*Blacklister)(nil),       // 6: circle.fiattokenfactory.v1.Blacklister
+	(*Owner)(nil),             // 7: circle.fiattokenfactory.v1.Owner
+	(*MinterController)(nil),  // 8: circle.fiattokenfactory.v1.MinterController
+	(*MintingDenom)(nil),      // 9: circle.fiattokenfactory.v1.MintingDenom
+	(*PendingRole)(nil),       // 10: circle.fiattokenfactory.v1.PendingRole
+	(*MintRateLimit)(nil),     // 11: circle.fiattokenfactory.v1.MintRateLimit
+	(*MintWindow)(nil),        // 12: circle.fiattokenfactory.v1.MintWindow
+	(*MaxSupply)(nil),         // 13: circle.fiattokenfactory.v1.MaxSupply
+	(*Wiper)(nil),             // 14: circle.fiattokenfactory.v1.Wiper
+	(*Frozen)(nil),            // 15: circle.fiattokenfactory.v1.Frozen
+	(*AllowedChannel)(nil),    // 16: circle.fiattokenfactory.v1.AllowedChannel
+	(*ChannelRateLimit)(nil),  // 17: circle.fiattokenfactory.v1.ChannelRateLimit
+	(*ChannelFlow)(nil),       // 18: circle.fiattokenfactory.v1.ChannelFlow
+	(*PendingOutflow)(nil),    // 19: circle.fiattokenfactory.v1.PendingOutflow
+	(*Params)(nil),            // 20: circle.fiattokenfactory.v1.Params
+	(*QuarantinedRefund)(nil), // 21: circle.fiattokenfactory.v1.QuarantinedRefund
+	(*ChannelAllowlist)(nil),  // 22: circle.fiattokenfactory.v1.ChannelAllowlist
 }
 var file_circle_fiattokenfactory_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: circle.fiattokenfactory.v1.GenesisState.blacklistedList:type_name -> circle.fiattokenfactory.v1.Blacklisted
-	2, // 1: circle.fiattokenfactory.v1.GenesisState.paused:type_name -> circle.fiattokenfactory.v1.Paused
-	3, // 2: circle.fiattokenfactory.v1.GenesisState.masterMinter:type_name -> circle.fiattokenfactory.v1.MasterMinter
-	4, // 3: circle.fiattokenfactory.v1.GenesisState.mintersList:type_name -> circle.fiattokenfactory.v1.Minters
-	5, // 4: circle.fiattokenfactory.v1.GenesisState.pauser:type_name -> circle.fiattokenfactory.v1.Pauser
-	6, // 5: circle.fiattokenfactory.v1.GenesisState.blacklister:type_name -> circle.fiattokenfactory.v1.Blacklister
-	7, // 6: circle.fiattokenfactory.v1.GenesisState.owner:type_name -> circle.fiattokenfactory.v1.Owner
-	8, // 7: circle.fiattokenfactory.v1.GenesisState.minterControllerList:type_name -> circle.fiattokenfactory.v1.MinterController
-	9, // 8: circle.fiattokenfactory.v1.GenesisState.mintingDenom:type_name -> circle.fiattokenfactory.v1.MintingDenom
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	1,  // 0: circle.fiattokenfactory.v1.GenesisState.blacklistedList:type_name -> circle.fiattokenfactory.v1.Blacklisted
+	2,  // 1: circle.fiattokenfactory.v1.GenesisState.paused:type_name -> circle.fiattokenfactory.v1.Paused
+	3,  // 2: circle.fiattokenfactory.v1.GenesisState.masterMinter:type_name -> circle.fiattokenfactory.v1.MasterMinter
+	4,  // 3: circle.fiattokenfactory.v1.GenesisState.mintersList:type_name -> circle.fiattokenfactory.v1.Minters
+	5,  // 4: circle.fiattokenfactory.v1.GenesisState.pauser:type_name -> circle.fiattokenfactory.v1.Pauser
+	6,  // 5: circle.fiattokenfactory.v1.GenesisState.blacklister:type_name -> circle.fiattokenfactory.v1.Blacklister
+	7,  // 6: circle.fiattokenfactory.v1.GenesisState.owner:type_name -> circle.fiattokenfactory.v1.Owner
+	8,  // 7: circle.fiattokenfactory.v1.GenesisState.minterControllerList:type_name -> circle.fiattokenfactory.v1.MinterController
+	9,  // 8: circle.fiattokenfactory.v1.GenesisState.mintingDenom:type_name -> circle.fiattokenfactory.v1.MintingDenom
+	0,  // 9: circle.fiattokenfactory.v1.GenesisState.fiatTokens:type_name -> circle.fiattokenfactory.v1.GenesisState
+	10, // 10: circle.fiattokenfactory.v1.GenesisState.pendingMasterMinter:type_name -> circle.fiattokenfactory.v1.PendingRole
+	10, // 11: circle.fiattokenfactory.v1.GenesisState.pendingPauser:type_name -> circle.fiattokenfactory.v1.PendingRole
+	10, // 12: circle.fiattokenfactory.v1.GenesisState.pendingBlacklister:type_name -> circle.fiattokenfactory.v1.PendingRole
+	10, // 13: circle.fiattokenfactory.v1.GenesisState.pendingOwner:type_name -> circle.fiattokenfactory.v1.PendingRole
+	8,  // 14: circle.fiattokenfactory.v1.GenesisState.minterControllerLinks:type_name -> circle.fiattokenfactory.v1.MinterController
+	11, // 15: circle.fiattokenfactory.v1.GenesisState.mintRateLimits:type_name -> circle.fiattokenfactory.v1.MintRateLimit
+	12, // 16: circle.fiattokenfactory.v1.GenesisState.mintWindows:type_name -> circle.fiattokenfactory.v1.MintWindow
+	13, // 17: circle.fiattokenfactory.v1.GenesisState.maxSupply:type_name -> circle.fiattokenfactory.v1.MaxSupply
+	14, // 18: circle.fiattokenfactory.v1.GenesisState.wiper:type_name -> circle.fiattokenfactory.v1.Wiper
+	10, // 19: circle.fiattokenfactory.v1.GenesisState.pendingWiper:type_name -> circle.fiattokenfactory.v1.PendingRole
+	15, // 20: circle.fiattokenfactory.v1.GenesisState.frozenList:type_name -> circle.fiattokenfactory.v1.Frozen
+	16, // 21: circle.fiattokenfactory.v1.GenesisState.allowedChannelList:type_name -> circle.fiattokenfactory.v1.AllowedChannel
+	17, // 22: circle.fiattokenfactory.v1.GenesisState.channelRateLimits:type_name -> circle.fiattokenfactory.v1.ChannelRateLimit
+	18, // 23: circle.fiattokenfactory.v1.GenesisState.channelFlows:type_name -> circle.fiattokenfactory.v1.ChannelFlow
+	19, // 24: circle.fiattokenfactory.v1.GenesisState.pendingOutflows:type_name -> circle.fiattokenfactory.v1.PendingOutflow
+	20, // 25: circle.fiattokenfactory.v1.GenesisState.params:type_name -> circle.fiattokenfactory.v1.Params
+	21, // 26: circle.fiattokenfactory.v1.GenesisState.quarantinedRefunds:type_name -> circle.fiattokenfactory.v1.QuarantinedRefund
+	22, // 27: circle.fiattokenfactory.v1.GenesisState.channelAllowlist:type_name -> circle.fiattokenfactory.v1.ChannelAllowlist
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_circle_fiattokenfactory_v1_genesis_proto_init() }
@@ -1516,15 +3948,24 @@ func file_circle_fiattokenfactory_v1_genesis_proto_init() {
 	if File_circle_fiattokenfactory_v1_genesis_proto != nil {
 		return
 	}
+	file_circle_fiattokenfactory_v1_allowed_channel_proto_init()
 	file_circle_fiattokenfactory_v1_blacklisted_proto_init()
 	file_circle_fiattokenfactory_v1_blacklister_proto_init()
+	file_circle_fiattokenfactory_v1_channel_rate_limit_proto_init()
+	file_circle_fiattokenfactory_v1_frozen_proto_init()
 	file_circle_fiattokenfactory_v1_master_minter_proto_init()
+	file_circle_fiattokenfactory_v1_max_supply_proto_init()
+	file_circle_fiattokenfactory_v1_mint_rate_limit_proto_init()
 	file_circle_fiattokenfactory_v1_minter_controller_proto_init()
 	file_circle_fiattokenfactory_v1_minters_proto_init()
 	file_circle_fiattokenfactory_v1_minting_denom_proto_init()
 	file_circle_fiattokenfactory_v1_owner_proto_init()
+	file_circle_fiattokenfactory_v1_params_proto_init()
 	file_circle_fiattokenfactory_v1_paused_proto_init()
+	file_circle_fiattokenfactory_v1_pending_role_proto_init()
 	file_circle_fiattokenfactory_v1_pauser_proto_init()
+	file_circle_fiattokenfactory_v1_quarantined_refund_proto_init()
+	file_circle_fiattokenfactory_v1_wiper_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -4,6 +4,7 @@ package fiattokenfactoryv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -12,15 +13,76 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Paused_8_list)(nil)
+
+type _Paused_8_list struct {
+	list *[]*PauseExpiry
+}
+
+func (x *_Paused_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Paused_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Paused_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExpiry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Paused_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseExpiry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Paused_8_list) AppendMutable() protoreflect.Value {
+	v := new(PauseExpiry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Paused_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Paused_8_list) NewElement() protoreflect.Value {
+	v := new(PauseExpiry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Paused_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Paused        protoreflect.MessageDescriptor
-	fd_Paused_paused protoreflect.FieldDescriptor
+	md_Paused          protoreflect.MessageDescriptor
+	fd_Paused_paused   protoreflect.FieldDescriptor
+	fd_Paused_mint     protoreflect.FieldDescriptor
+	fd_Paused_burn     protoreflect.FieldDescriptor
+	fd_Paused_transfer protoreflect.FieldDescriptor
+	fd_Paused_ibc      protoreflect.FieldDescriptor
+	fd_Paused_expiries protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_fiattokenfactory_v1_paused_proto_init()
 	md_Paused = File_circle_fiattokenfactory_v1_paused_proto.Messages().ByName("Paused")
 	fd_Paused_paused = md_Paused.Fields().ByName("paused")
+	fd_Paused_mint = md_Paused.Fields().ByName("mint")
+	fd_Paused_burn = md_Paused.Fields().ByName("burn")
+	fd_Paused_transfer = md_Paused.Fields().ByName("transfer")
+	fd_Paused_ibc = md_Paused.Fields().ByName("ibc")
+	fd_Paused_expiries = md_Paused.Fields().ByName("expiries")
 }
 
 var _ protoreflect.Message = (*fastReflection_Paused)(nil)
@@ -94,6 +156,36 @@ func (x *fastReflection_Paused) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Mint != false {
+		value := protoreflect.ValueOfBool(x.Mint)
+		if !f(fd_Paused_mint, value) {
+			return
+		}
+	}
+	if x.Burn != false {
+		value := protoreflect.ValueOfBool(x.Burn)
+		if !f(fd_Paused_burn, value) {
+			return
+		}
+	}
+	if x.Transfer != false {
+		value := protoreflect.ValueOfBool(x.Transfer)
+		if !f(fd_Paused_transfer, value) {
+			return
+		}
+	}
+	if x.Ibc != false {
+		value := protoreflect.ValueOfBool(x.Ibc)
+		if !f(fd_Paused_ibc, value) {
+			return
+		}
+	}
+	if len(x.Expiries) != 0 {
+		value := protoreflect.ValueOfList(&_Paused_8_list{list: &x.Expiries})
+		if !f(fd_Paused_expiries, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -111,6 +203,16 @@ func (x *fastReflection_Paused) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		return x.Paused != false
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		return x.Mint != false
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		return x.Burn != false
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		return x.Transfer != false
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		return x.Ibc != false
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		return len(x.Expiries) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -129,6 +231,16 @@ func (x *fastReflection_Paused) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		x.Paused = false
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		x.Mint = false
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		x.Burn = false
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		x.Transfer = false
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		x.Ibc = false
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		x.Expiries = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -148,6 +260,24 @@ func (x *fastReflection_Paused) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		value := x.Paused
 		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		value := x.Mint
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		value := x.Burn
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		value := x.Transfer
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		value := x.Ibc
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		if len(x.Expiries) == 0 {
+			return protoreflect.ValueOfList(&_Paused_8_list{})
+		}
+		listValue := &_Paused_8_list{list: &x.Expiries}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -170,6 +300,18 @@ func (x *fastReflection_Paused) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		x.Paused = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		x.Mint = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		x.Burn = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		x.Transfer = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		x.Ibc = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		lv := value.List()
+		clv := lv.(*_Paused_8_list)
+		x.Expiries = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -190,8 +332,22 @@ func (x *fastReflection_Paused) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Paused) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		if x.Expiries == nil {
+			x.Expiries = []*PauseExpiry{}
+		}
+		value := &_Paused_8_list{list: &x.Expiries}
+		return protoreflect.ValueOfList(value)
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		panic(fmt.Errorf("field paused of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		panic(fmt.Errorf("field mint of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		panic(fmt.Errorf("field burn of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		panic(fmt.Errorf("field transfer of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		panic(fmt.Errorf("field ibc of message circle.fiattokenfactory.v1.Paused is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -207,6 +363,17 @@ func (x *fastReflection_Paused) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.mint":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.burn":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.transfer":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.ibc":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.expiries":
+		list := []*PauseExpiry{}
+		return protoreflect.ValueOfList(&_Paused_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -279,6 +446,24 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 		if x.Paused {
 			n += 2
 		}
+		if x.Mint {
+			n += 2
+		}
+		if x.Burn {
+			n += 2
+		}
+		if x.Transfer {
+			n += 2
+		}
+		if x.Ibc {
+			n += 2
+		}
+		if len(x.Expiries) > 0 {
+			for _, e := range x.Expiries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -308,6 +493,62 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Expiries) > 0 {
+			for iNdEx := len(x.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Expiries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.Ibc {
+			i--
+			if x.Ibc {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Transfer {
+			i--
+			if x.Transfer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Burn {
+			i--
+			if x.Burn {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Mint {
+			i--
+			if x.Mint {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.Paused {
 			i--
 			if x.Paused {
@@ -387,6 +628,120 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Paused = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Mint = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Burn = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Transfer = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ibc", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Ibc = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Expiries = append(x.Expiries, &PauseExpiry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiries[len(x.Expiries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -422,110 +777,805 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: circle/fiattokenfactory/v1/paused.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_PauseExpiry        protoreflect.MessageDescriptor
+	fd_PauseExpiry_scope  protoreflect.FieldDescriptor
+	fd_PauseExpiry_height protoreflect.FieldDescriptor
+	fd_PauseExpiry_time   protoreflect.FieldDescriptor
 )
 
-type Paused struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_circle_fiattokenfactory_v1_paused_proto_init()
+	md_PauseExpiry = File_circle_fiattokenfactory_v1_paused_proto.Messages().ByName("PauseExpiry")
+	fd_PauseExpiry_scope = md_PauseExpiry.Fields().ByName("scope")
+	fd_PauseExpiry_height = md_PauseExpiry.Fields().ByName("height")
+	fd_PauseExpiry_time = md_PauseExpiry.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PauseExpiry)(nil)
+
+type fastReflection_PauseExpiry PauseExpiry
 
-	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+func (x *PauseExpiry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauseExpiry)(x)
 }
 
-func (x *Paused) Reset() {
-	*x = Paused{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_fiattokenfactory_v1_paused_proto_msgTypes[0]
+func (x *PauseExpiry) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_paused_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Paused) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_PauseExpiry_messageType fastReflection_PauseExpiry_messageType
+var _ protoreflect.MessageType = fastReflection_PauseExpiry_messageType{}
 
-func (*Paused) ProtoMessage() {}
+type fastReflection_PauseExpiry_messageType struct{}
 
-// Deprecated: Use Paused.ProtoReflect.Descriptor instead.
-func (*Paused) Descriptor() ([]byte, []int) {
-	return file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_PauseExpiry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauseExpiry)(nil)
 }
-
-func (x *Paused) GetPaused() bool {
-	if x != nil {
-		return x.Paused
-	}
-	return false
+func (x fastReflection_PauseExpiry_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauseExpiry)
 }
-
-var File_circle_fiattokenfactory_v1_paused_proto protoreflect.FileDescriptor
-
-var file_circle_fiattokenfactory_v1_paused_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75,
-	0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x20, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x96, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02,
-	0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69,
-	0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x fastReflection_PauseExpiry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseExpiry
 }
 
-var (
-	file_circle_fiattokenfactory_v1_paused_proto_rawDescOnce sync.Once
-	file_circle_fiattokenfactory_v1_paused_proto_rawDescData = file_circle_fiattokenfactory_v1_paused_proto_rawDesc
-)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PauseExpiry) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseExpiry
+}
 
-func file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP() []byte {
-	file_circle_fiattokenfactory_v1_paused_proto_rawDescOnce.Do(func() {
-		file_circle_fiattokenfactory_v1_paused_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_fiattokenfactory_v1_paused_proto_rawDescData)
-	})
-	return file_circle_fiattokenfactory_v1_paused_proto_rawDescData
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PauseExpiry) Type() protoreflect.MessageType {
+	return _fastReflection_PauseExpiry_messageType
 }
 
-var file_circle_fiattokenfactory_v1_paused_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_circle_fiattokenfactory_v1_paused_proto_goTypes = []interface{}{
-	(*Paused)(nil), // 0: circle.fiattokenfactory.v1.Paused
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PauseExpiry) New() protoreflect.Message {
+	return new(fastReflection_PauseExpiry)
 }
-var file_circle_fiattokenfactory_v1_paused_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PauseExpiry) Interface() protoreflect.ProtoMessage {
+	return (*PauseExpiry)(x)
 }
 
-func init() { file_circle_fiattokenfactory_v1_paused_proto_init() }
-func file_circle_fiattokenfactory_v1_paused_proto_init() {
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PauseExpiry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Scope != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Scope))
+		if !f(fd_PauseExpiry_scope, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PauseExpiry_height, value) {
+			return
+		}
+	}
+	if x.Time != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Time)
+		if !f(fd_PauseExpiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PauseExpiry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		return x.Scope != 0
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		return x.Height != int64(0)
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		return x.Time != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExpiry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		x.Scope = 0
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		x.Height = int64(0)
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		x.Time = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PauseExpiry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		value := x.Scope
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		value := x.Time
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExpiry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		x.Scope = (PauseScope)(value.Enum())
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		x.Height = value.Int()
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		x.Time = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExpiry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		panic(fmt.Errorf("field scope of message circle.fiattokenfactory.v1.PauseExpiry is not mutable"))
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		panic(fmt.Errorf("field height of message circle.fiattokenfactory.v1.PauseExpiry is not mutable"))
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		panic(fmt.Errorf("field time of message circle.fiattokenfactory.v1.PauseExpiry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PauseExpiry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.PauseExpiry.scope":
+		return protoreflect.ValueOfEnum(0)
+	case "circle.fiattokenfactory.v1.PauseExpiry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.fiattokenfactory.v1.PauseExpiry.time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.PauseExpiry"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.PauseExpiry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PauseExpiry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.PauseExpiry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PauseExpiry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PauseExpiry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PauseExpiry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PauseExpiry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PauseExpiry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Scope != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scope))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != 0 {
+			n += 1 + runtime.Sov(uint64(x.Time))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PauseExpiry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Time))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Scope != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scope))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PauseExpiry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseExpiry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				}
+				x.Scope = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scope |= PauseScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				x.Time = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Time |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/fiattokenfactory/v1/paused.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PauseScope enumerates the capabilities of a fiat token that can be paused
+// independently of each other.
+type PauseScope int32
+
+const (
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// minting and raising minter allowances
+	PauseScope_PAUSE_SCOPE_MINT PauseScope = 1
+	// burning
+	PauseScope_PAUSE_SCOPE_BURN PauseScope = 2
+	// bank transfers between accounts on this chain
+	PauseScope_PAUSE_SCOPE_TRANSFER PauseScope = 3
+	// inbound and outbound ICS-20 transfers
+	PauseScope_PAUSE_SCOPE_IBC PauseScope = 4
+)
+
+// Enum value maps for PauseScope.
+var (
+	PauseScope_name = map[int32]string{
+		0: "PAUSE_SCOPE_UNSPECIFIED",
+		1: "PAUSE_SCOPE_MINT",
+		2: "PAUSE_SCOPE_BURN",
+		3: "PAUSE_SCOPE_TRANSFER",
+		4: "PAUSE_SCOPE_IBC",
+	}
+	PauseScope_value = map[string]int32{
+		"PAUSE_SCOPE_UNSPECIFIED": 0,
+		"PAUSE_SCOPE_MINT":        1,
+		"PAUSE_SCOPE_BURN":        2,
+		"PAUSE_SCOPE_TRANSFER":    3,
+		"PAUSE_SCOPE_IBC":         4,
+	}
+)
+
+func (x PauseScope) Enum() *PauseScope {
+	p := new(PauseScope)
+	*p = x
+	return p
+}
+
+func (x PauseScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_circle_fiattokenfactory_v1_paused_proto_enumTypes[0].Descriptor()
+}
+
+func (PauseScope) Type() protoreflect.EnumType {
+	return &file_circle_fiattokenfactory_v1_paused_proto_enumTypes[0]
+}
+
+func (x PauseScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseScope.Descriptor instead.
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP(), []int{0}
+}
+
+type Paused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: single flag that paused every capability. It is only read to
+	// migrate state and genesis files written before the per-scope flags.
+	//
+	// Deprecated: Do not use.
+	Paused   bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Mint     bool `protobuf:"varint,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Burn     bool `protobuf:"varint,3,opt,name=burn,proto3" json:"burn,omitempty"`
+	Transfer bool `protobuf:"varint,4,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Ibc      bool `protobuf:"varint,5,opt,name=ibc,proto3" json:"ibc,omitempty"`
+	// scheduled expiry of each paused scope, a scope paused indefinitely has no entry
+	Expiries []*PauseExpiry `protobuf:"bytes,8,rep,name=expiries,proto3" json:"expiries,omitempty"`
+}
+
+func (x *Paused) Reset() {
+	*x = Paused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_paused_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Paused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Paused) ProtoMessage() {}
+
+// Deprecated: Use Paused.ProtoReflect.Descriptor instead.
+func (*Paused) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP(), []int{0}
+}
+
+// Deprecated: Do not use.
+func (x *Paused) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Paused) GetMint() bool {
+	if x != nil {
+		return x.Mint
+	}
+	return false
+}
+
+func (x *Paused) GetBurn() bool {
+	if x != nil {
+		return x.Burn
+	}
+	return false
+}
+
+func (x *Paused) GetTransfer() bool {
+	if x != nil {
+		return x.Transfer
+	}
+	return false
+}
+
+func (x *Paused) GetIbc() bool {
+	if x != nil {
+		return x.Ibc
+	}
+	return false
+}
+
+func (x *Paused) GetExpiries() []*PauseExpiry {
+	if x != nil {
+		return x.Expiries
+	}
+	return nil
+}
+
+// PauseExpiry schedules the unpause of a single scope.
+type PauseExpiry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope PauseScope `protobuf:"varint,1,opt,name=scope,proto3,enum=circle.fiattokenfactory.v1.PauseScope" json:"scope,omitempty"`
+	// block height at which the scope is unpaused, zero when it does not expire by height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// unix time in seconds at which the scope is unpaused, zero when it does not expire by time
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *PauseExpiry) Reset() {
+	*x = PauseExpiry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_paused_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseExpiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseExpiry) ProtoMessage() {}
+
+// Deprecated: Use PauseExpiry.ProtoReflect.Descriptor instead.
+func (*PauseExpiry) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PauseExpiry) GetScope() PauseScope {
+	if x != nil {
+		return x.Scope
+	}
+	return PauseScope_PAUSE_SCOPE_UNSPECIFIED
+}
+
+func (x *PauseExpiry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PauseExpiry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+var File_circle_fiattokenfactory_v1_paused_proto protoreflect.FileDescriptor
+
+var file_circle_fiattokenfactory_v1_paused_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x06,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x62, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x69, 0x62, 0x63, 0x12, 0x49, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22,
+	0x77, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x3c,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x2a, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x49, 0x42, 0x43, 0x10, 0x04, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x96, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x43,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c,
+	0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_circle_fiattokenfactory_v1_paused_proto_rawDescOnce sync.Once
+	file_circle_fiattokenfactory_v1_paused_proto_rawDescData = file_circle_fiattokenfactory_v1_paused_proto_rawDesc
+)
+
+func file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP() []byte {
+	file_circle_fiattokenfactory_v1_paused_proto_rawDescOnce.Do(func() {
+		file_circle_fiattokenfactory_v1_paused_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_fiattokenfactory_v1_paused_proto_rawDescData)
+	})
+	return file_circle_fiattokenfactory_v1_paused_proto_rawDescData
+}
+
+var file_circle_fiattokenfactory_v1_paused_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_circle_fiattokenfactory_v1_paused_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_circle_fiattokenfactory_v1_paused_proto_goTypes = []interface{}{
+	(PauseScope)(0),     // 0: circle.fiattokenfactory.v1.PauseScope
+	(*Paused)(nil),      // 1: circle.fiattokenfactory.v1.Paused
+	(*PauseExpiry)(nil), // 2: circle.fiattokenfactory.v1.PauseExpiry
+}
+var file_circle_fiattokenfactory_v1_paused_proto_depIdxs = []int32{
+	2, // 0: circle.fiattokenfactory.v1.Paused.expiries:type_name -> circle.fiattokenfactory.v1.PauseExpiry
+	0, // 1: circle.fiattokenfactory.v1.PauseExpiry.scope:type_name -> circle.fiattokenfactory.v1.PauseScope
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_circle_fiattokenfactory_v1_paused_proto_init() }
+func file_circle_fiattokenfactory_v1_paused_proto_init() {
 	if File_circle_fiattokenfactory_v1_paused_proto != nil {
 		return
 	}
@@ -542,19 +1592,32 @@ func file_circle_fiattokenfactory_v1_paused_proto_init() {
 				return nil
 			}
 		}
+		file_circle_fiattokenfactory_v1_paused_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseExpiry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circle_fiattokenfactory_v1_paused_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_circle_fiattokenfactory_v1_paused_proto_goTypes,
 		DependencyIndexes: file_circle_fiattokenfactory_v1_paused_proto_depIdxs,
+		EnumInfos:         file_circle_fiattokenfactory_v1_paused_proto_enumTypes,
 		MessageInfos:      file_circle_fiattokenfactory_v1_paused_proto_msgTypes,
 	}.Build()
 	File_circle_fiattokenfactory_v1_paused_proto = out.File
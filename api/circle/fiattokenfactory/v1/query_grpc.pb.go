@@ -19,18 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Blacklisted_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Blacklisted"
-	Query_BlacklistedAll_FullMethodName      = "/circle.fiattokenfactory.v1.Query/BlacklistedAll"
-	Query_Paused_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Paused"
-	Query_MasterMinter_FullMethodName        = "/circle.fiattokenfactory.v1.Query/MasterMinter"
-	Query_Minters_FullMethodName             = "/circle.fiattokenfactory.v1.Query/Minters"
-	Query_MintersAll_FullMethodName          = "/circle.fiattokenfactory.v1.Query/MintersAll"
-	Query_Pauser_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Pauser"
-	Query_Blacklister_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Blacklister"
-	Query_Owner_FullMethodName               = "/circle.fiattokenfactory.v1.Query/Owner"
-	Query_MinterController_FullMethodName    = "/circle.fiattokenfactory.v1.Query/MinterController"
-	Query_MinterControllerAll_FullMethodName = "/circle.fiattokenfactory.v1.Query/MinterControllerAll"
-	Query_MintingDenom_FullMethodName        = "/circle.fiattokenfactory.v1.Query/MintingDenom"
+	Query_Blacklisted_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Blacklisted"
+	Query_BlacklistedAll_FullMethodName           = "/circle.fiattokenfactory.v1.Query/BlacklistedAll"
+	Query_Paused_FullMethodName                   = "/circle.fiattokenfactory.v1.Query/Paused"
+	Query_MasterMinter_FullMethodName             = "/circle.fiattokenfactory.v1.Query/MasterMinter"
+	Query_Minters_FullMethodName                  = "/circle.fiattokenfactory.v1.Query/Minters"
+	Query_MintersAll_FullMethodName               = "/circle.fiattokenfactory.v1.Query/MintersAll"
+	Query_Pauser_FullMethodName                   = "/circle.fiattokenfactory.v1.Query/Pauser"
+	Query_Blacklister_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Blacklister"
+	Query_Owner_FullMethodName                    = "/circle.fiattokenfactory.v1.Query/Owner"
+	Query_PendingOwner_FullMethodName             = "/circle.fiattokenfactory.v1.Query/PendingOwner"
+	Query_MinterController_FullMethodName         = "/circle.fiattokenfactory.v1.Query/MinterController"
+	Query_MinterControllerAll_FullMethodName      = "/circle.fiattokenfactory.v1.Query/MinterControllerAll"
+	Query_MintersOfController_FullMethodName      = "/circle.fiattokenfactory.v1.Query/MintersOfController"
+	Query_ControllersOfMinter_FullMethodName      = "/circle.fiattokenfactory.v1.Query/ControllersOfMinter"
+	Query_MinterControllerByMinter_FullMethodName = "/circle.fiattokenfactory.v1.Query/MinterControllerByMinter"
+	Query_MintingDenom_FullMethodName             = "/circle.fiattokenfactory.v1.Query/MintingDenom"
+	Query_FiatToken_FullMethodName                = "/circle.fiattokenfactory.v1.Query/FiatToken"
+	Query_FiatTokenAll_FullMethodName             = "/circle.fiattokenfactory.v1.Query/FiatTokenAll"
+	Query_MintRateLimit_FullMethodName            = "/circle.fiattokenfactory.v1.Query/MintRateLimit"
+	Query_PendingMasterMinter_FullMethodName      = "/circle.fiattokenfactory.v1.Query/PendingMasterMinter"
+	Query_PendingPauser_FullMethodName            = "/circle.fiattokenfactory.v1.Query/PendingPauser"
+	Query_PendingBlacklister_FullMethodName       = "/circle.fiattokenfactory.v1.Query/PendingBlacklister"
+	Query_MaxSupply_FullMethodName                = "/circle.fiattokenfactory.v1.Query/MaxSupply"
+	Query_Wiper_FullMethodName                    = "/circle.fiattokenfactory.v1.Query/Wiper"
+	Query_PendingWiper_FullMethodName             = "/circle.fiattokenfactory.v1.Query/PendingWiper"
+	Query_Frozen_FullMethodName                   = "/circle.fiattokenfactory.v1.Query/Frozen"
+	Query_FrozenAll_FullMethodName                = "/circle.fiattokenfactory.v1.Query/FrozenAll"
+	Query_AllowedChannel_FullMethodName           = "/circle.fiattokenfactory.v1.Query/AllowedChannel"
+	Query_AllowedChannelAll_FullMethodName        = "/circle.fiattokenfactory.v1.Query/AllowedChannelAll"
+	Query_ChannelRateLimit_FullMethodName         = "/circle.fiattokenfactory.v1.Query/ChannelRateLimit"
+	Query_ChannelRateLimitAll_FullMethodName      = "/circle.fiattokenfactory.v1.Query/ChannelRateLimitAll"
+	Query_QuarantinedRefund_FullMethodName        = "/circle.fiattokenfactory.v1.Query/QuarantinedRefund"
+	Query_QuarantinedRefundAll_FullMethodName     = "/circle.fiattokenfactory.v1.Query/QuarantinedRefundAll"
+	Query_Params_FullMethodName                   = "/circle.fiattokenfactory.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -55,12 +77,58 @@ type QueryClient interface {
 	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
 	// Queries a Owner by index.
 	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
+	// Queries a PendingOwner by index.
+	PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error)
 	// Queries a MinterController by index.
 	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
 	// Queries a list of MinterController items.
 	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
+	// Queries all minters managed by a controller.
+	MintersOfController(ctx context.Context, in *QueryMintersOfControllerRequest, opts ...grpc.CallOption) (*QueryMintersOfControllerResponse, error)
+	// Queries all controllers managing a minter.
+	ControllersOfMinter(ctx context.Context, in *QueryControllersOfMinterRequest, opts ...grpc.CallOption) (*QueryControllersOfMinterResponse, error)
+	// Queries the MinterControllers managing a minter, either as their primary
+	// minter or through a link. It reads the same minter to controller index as
+	// ControllersOfMinter, under the minter_controller routes.
+	MinterControllerByMinter(ctx context.Context, in *QueryGetMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerByMinterResponse, error)
 	// Queries a MintingDenom by index.
 	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+	// Queries a FiatToken by denom.
+	FiatToken(ctx context.Context, in *QueryGetFiatTokenRequest, opts ...grpc.CallOption) (*QueryGetFiatTokenResponse, error)
+	// Queries a list of FiatToken items.
+	FiatTokenAll(ctx context.Context, in *QueryAllFiatTokenRequest, opts ...grpc.CallOption) (*QueryAllFiatTokenResponse, error)
+	// Queries the mint rate limit of a minter and its remaining capacity.
+	MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error)
+	// Queries a PendingMasterMinter by index.
+	PendingMasterMinter(ctx context.Context, in *QueryGetPendingMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetPendingMasterMinterResponse, error)
+	// Queries a PendingPauser by index.
+	PendingPauser(ctx context.Context, in *QueryGetPendingPauserRequest, opts ...grpc.CallOption) (*QueryGetPendingPauserResponse, error)
+	// Queries a PendingBlacklister by index.
+	PendingBlacklister(ctx context.Context, in *QueryGetPendingBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetPendingBlacklisterResponse, error)
+	// Queries the MaxSupply together with the current supply and headroom.
+	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
+	// Queries a Wiper by index.
+	Wiper(ctx context.Context, in *QueryGetWiperRequest, opts ...grpc.CallOption) (*QueryGetWiperResponse, error)
+	// Queries a PendingWiper by index.
+	PendingWiper(ctx context.Context, in *QueryGetPendingWiperRequest, opts ...grpc.CallOption) (*QueryGetPendingWiperResponse, error)
+	// Queries a Frozen by index.
+	Frozen(ctx context.Context, in *QueryGetFrozenRequest, opts ...grpc.CallOption) (*QueryGetFrozenResponse, error)
+	// Queries a list of Frozen items.
+	FrozenAll(ctx context.Context, in *QueryAllFrozenRequest, opts ...grpc.CallOption) (*QueryAllFrozenResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error)
+	// Queries the rate limit of a channel together with its usage in its rolling window.
+	ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error)
+	// Queries a list of ChannelRateLimit items.
+	ChannelRateLimitAll(ctx context.Context, in *QueryAllChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChannelRateLimitResponse, error)
+	// Queries the refunds quarantined for an address.
+	QuarantinedRefund(ctx context.Context, in *QueryGetQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryGetQuarantinedRefundResponse, error)
+	// Queries a list of QuarantinedRefund items.
+	QuarantinedRefundAll(ctx context.Context, in *QueryAllQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryAllQuarantinedRefundResponse, error)
+	// Queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -152,6 +220,15 @@ func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error) {
+	out := new(QueryGetPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_PendingOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
 	out := new(QueryGetMinterControllerResponse)
 	err := c.cc.Invoke(ctx, Query_MinterController_FullMethodName, in, out, opts...)
@@ -170,6 +247,33 @@ func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinte
 	return out, nil
 }
 
+func (c *queryClient) MintersOfController(ctx context.Context, in *QueryMintersOfControllerRequest, opts ...grpc.CallOption) (*QueryMintersOfControllerResponse, error) {
+	out := new(QueryMintersOfControllerResponse)
+	err := c.cc.Invoke(ctx, Query_MintersOfController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ControllersOfMinter(ctx context.Context, in *QueryControllersOfMinterRequest, opts ...grpc.CallOption) (*QueryControllersOfMinterResponse, error) {
+	out := new(QueryControllersOfMinterResponse)
+	err := c.cc.Invoke(ctx, Query_ControllersOfMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerByMinter(ctx context.Context, in *QueryGetMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerByMinterResponse, error) {
+	out := new(QueryGetMinterControllerByMinterResponse)
+	err := c.cc.Invoke(ctx, Query_MinterControllerByMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
 	out := new(QueryGetMintingDenomResponse)
 	err := c.cc.Invoke(ctx, Query_MintingDenom_FullMethodName, in, out, opts...)
@@ -179,6 +283,168 @@ func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenom
 	return out, nil
 }
 
+func (c *queryClient) FiatToken(ctx context.Context, in *QueryGetFiatTokenRequest, opts ...grpc.CallOption) (*QueryGetFiatTokenResponse, error) {
+	out := new(QueryGetFiatTokenResponse)
+	err := c.cc.Invoke(ctx, Query_FiatToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FiatTokenAll(ctx context.Context, in *QueryAllFiatTokenRequest, opts ...grpc.CallOption) (*QueryAllFiatTokenResponse, error) {
+	out := new(QueryAllFiatTokenResponse)
+	err := c.cc.Invoke(ctx, Query_FiatTokenAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error) {
+	out := new(QueryMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_MintRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMasterMinter(ctx context.Context, in *QueryGetPendingMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetPendingMasterMinterResponse, error) {
+	out := new(QueryGetPendingMasterMinterResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMasterMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPauser(ctx context.Context, in *QueryGetPendingPauserRequest, opts ...grpc.CallOption) (*QueryGetPendingPauserResponse, error) {
+	out := new(QueryGetPendingPauserResponse)
+	err := c.cc.Invoke(ctx, Query_PendingPauser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBlacklister(ctx context.Context, in *QueryGetPendingBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetPendingBlacklisterResponse, error) {
+	out := new(QueryGetPendingBlacklisterResponse)
+	err := c.cc.Invoke(ctx, Query_PendingBlacklister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error) {
+	out := new(QueryMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_MaxSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Wiper(ctx context.Context, in *QueryGetWiperRequest, opts ...grpc.CallOption) (*QueryGetWiperResponse, error) {
+	out := new(QueryGetWiperResponse)
+	err := c.cc.Invoke(ctx, Query_Wiper_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingWiper(ctx context.Context, in *QueryGetPendingWiperRequest, opts ...grpc.CallOption) (*QueryGetPendingWiperResponse, error) {
+	out := new(QueryGetPendingWiperResponse)
+	err := c.cc.Invoke(ctx, Query_PendingWiper_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Frozen(ctx context.Context, in *QueryGetFrozenRequest, opts ...grpc.CallOption) (*QueryGetFrozenResponse, error) {
+	out := new(QueryGetFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_Frozen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAll(ctx context.Context, in *QueryAllFrozenRequest, opts ...grpc.CallOption) (*QueryAllFrozenResponse, error) {
+	out := new(QueryAllFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error) {
+	out := new(QueryGetAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error) {
+	out := new(QueryAllAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedChannelAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error) {
+	out := new(QueryChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimitAll(ctx context.Context, in *QueryAllChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChannelRateLimitResponse, error) {
+	out := new(QueryAllChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelRateLimitAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedRefund(ctx context.Context, in *QueryGetQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryGetQuarantinedRefundResponse, error) {
+	out := new(QueryGetQuarantinedRefundResponse)
+	err := c.cc.Invoke(ctx, Query_QuarantinedRefund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedRefundAll(ctx context.Context, in *QueryAllQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryAllQuarantinedRefundResponse, error) {
+	out := new(QueryAllQuarantinedRefundResponse)
+	err := c.cc.Invoke(ctx, Query_QuarantinedRefundAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -201,12 +467,58 @@ type QueryServer interface {
 	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
 	// Queries a Owner by index.
 	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
+	// Queries a PendingOwner by index.
+	PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error)
 	// Queries a MinterController by index.
 	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
 	// Queries a list of MinterController items.
 	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
+	// Queries all minters managed by a controller.
+	MintersOfController(context.Context, *QueryMintersOfControllerRequest) (*QueryMintersOfControllerResponse, error)
+	// Queries all controllers managing a minter.
+	ControllersOfMinter(context.Context, *QueryControllersOfMinterRequest) (*QueryControllersOfMinterResponse, error)
+	// Queries the MinterControllers managing a minter, either as their primary
+	// minter or through a link. It reads the same minter to controller index as
+	// ControllersOfMinter, under the minter_controller routes.
+	MinterControllerByMinter(context.Context, *QueryGetMinterControllerByMinterRequest) (*QueryGetMinterControllerByMinterResponse, error)
 	// Queries a MintingDenom by index.
 	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+	// Queries a FiatToken by denom.
+	FiatToken(context.Context, *QueryGetFiatTokenRequest) (*QueryGetFiatTokenResponse, error)
+	// Queries a list of FiatToken items.
+	FiatTokenAll(context.Context, *QueryAllFiatTokenRequest) (*QueryAllFiatTokenResponse, error)
+	// Queries the mint rate limit of a minter and its remaining capacity.
+	MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error)
+	// Queries a PendingMasterMinter by index.
+	PendingMasterMinter(context.Context, *QueryGetPendingMasterMinterRequest) (*QueryGetPendingMasterMinterResponse, error)
+	// Queries a PendingPauser by index.
+	PendingPauser(context.Context, *QueryGetPendingPauserRequest) (*QueryGetPendingPauserResponse, error)
+	// Queries a PendingBlacklister by index.
+	PendingBlacklister(context.Context, *QueryGetPendingBlacklisterRequest) (*QueryGetPendingBlacklisterResponse, error)
+	// Queries the MaxSupply together with the current supply and headroom.
+	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
+	// Queries a Wiper by index.
+	Wiper(context.Context, *QueryGetWiperRequest) (*QueryGetWiperResponse, error)
+	// Queries a PendingWiper by index.
+	PendingWiper(context.Context, *QueryGetPendingWiperRequest) (*QueryGetPendingWiperResponse, error)
+	// Queries a Frozen by index.
+	Frozen(context.Context, *QueryGetFrozenRequest) (*QueryGetFrozenResponse, error)
+	// Queries a list of Frozen items.
+	FrozenAll(context.Context, *QueryAllFrozenRequest) (*QueryAllFrozenResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(context.Context, *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(context.Context, *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error)
+	// Queries the rate limit of a channel together with its usage in its rolling window.
+	ChannelRateLimit(context.Context, *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error)
+	// Queries a list of ChannelRateLimit items.
+	ChannelRateLimitAll(context.Context, *QueryAllChannelRateLimitRequest) (*QueryAllChannelRateLimitResponse, error)
+	// Queries the refunds quarantined for an address.
+	QuarantinedRefund(context.Context, *QueryGetQuarantinedRefundRequest) (*QueryGetQuarantinedRefundResponse, error)
+	// Queries a list of QuarantinedRefund items.
+	QuarantinedRefundAll(context.Context, *QueryAllQuarantinedRefundRequest) (*QueryAllQuarantinedRefundResponse, error)
+	// Queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -241,15 +553,81 @@ func (UnimplementedQueryServer) Blacklister(context.Context, *QueryGetBlackliste
 func (UnimplementedQueryServer) Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
 }
+func (UnimplementedQueryServer) PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
 func (UnimplementedQueryServer) MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
 }
 func (UnimplementedQueryServer) MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
 }
+func (UnimplementedQueryServer) MintersOfController(context.Context, *QueryMintersOfControllerRequest) (*QueryMintersOfControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintersOfController not implemented")
+}
+func (UnimplementedQueryServer) ControllersOfMinter(context.Context, *QueryControllersOfMinterRequest) (*QueryControllersOfMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllersOfMinter not implemented")
+}
+func (UnimplementedQueryServer) MinterControllerByMinter(context.Context, *QueryGetMinterControllerByMinterRequest) (*QueryGetMinterControllerByMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerByMinter not implemented")
+}
 func (UnimplementedQueryServer) MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
 }
+func (UnimplementedQueryServer) FiatToken(context.Context, *QueryGetFiatTokenRequest) (*QueryGetFiatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FiatToken not implemented")
+}
+func (UnimplementedQueryServer) FiatTokenAll(context.Context, *QueryAllFiatTokenRequest) (*QueryAllFiatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FiatTokenAll not implemented")
+}
+func (UnimplementedQueryServer) MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRateLimit not implemented")
+}
+func (UnimplementedQueryServer) PendingMasterMinter(context.Context, *QueryGetPendingMasterMinterRequest) (*QueryGetPendingMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMasterMinter not implemented")
+}
+func (UnimplementedQueryServer) PendingPauser(context.Context, *QueryGetPendingPauserRequest) (*QueryGetPendingPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPauser not implemented")
+}
+func (UnimplementedQueryServer) PendingBlacklister(context.Context, *QueryGetPendingBlacklisterRequest) (*QueryGetPendingBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBlacklister not implemented")
+}
+func (UnimplementedQueryServer) MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxSupply not implemented")
+}
+func (UnimplementedQueryServer) Wiper(context.Context, *QueryGetWiperRequest) (*QueryGetWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wiper not implemented")
+}
+func (UnimplementedQueryServer) PendingWiper(context.Context, *QueryGetPendingWiperRequest) (*QueryGetPendingWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWiper not implemented")
+}
+func (UnimplementedQueryServer) Frozen(context.Context, *QueryGetFrozenRequest) (*QueryGetFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
+}
+func (UnimplementedQueryServer) FrozenAll(context.Context, *QueryAllFrozenRequest) (*QueryAllFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAll not implemented")
+}
+func (UnimplementedQueryServer) AllowedChannel(context.Context, *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannel not implemented")
+}
+func (UnimplementedQueryServer) AllowedChannelAll(context.Context, *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannelAll not implemented")
+}
+func (UnimplementedQueryServer) ChannelRateLimit(context.Context, *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimit not implemented")
+}
+func (UnimplementedQueryServer) ChannelRateLimitAll(context.Context, *QueryAllChannelRateLimitRequest) (*QueryAllChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimitAll not implemented")
+}
+func (UnimplementedQueryServer) QuarantinedRefund(context.Context, *QueryGetQuarantinedRefundRequest) (*QueryGetQuarantinedRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedRefund not implemented")
+}
+func (UnimplementedQueryServer) QuarantinedRefundAll(context.Context, *QueryAllQuarantinedRefundRequest) (*QueryAllQuarantinedRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedRefundAll not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -425,6 +803,24 @@ func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryGetPendingOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetMinterControllerRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +857,60 @@ func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MintersOfController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintersOfControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintersOfController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintersOfController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintersOfController(ctx, req.(*QueryMintersOfControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ControllersOfMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllersOfMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ControllersOfMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ControllersOfMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ControllersOfMinter(ctx, req.(*QueryControllersOfMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerByMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterControllerByMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinterControllerByMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, req.(*QueryGetMinterControllerByMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetMintingDenomRequest)
 	if err := dec(in); err != nil {
@@ -479,6 +929,330 @@ func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FiatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFiatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FiatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FiatToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FiatToken(ctx, req.(*QueryGetFiatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FiatTokenAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFiatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FiatTokenAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FiatTokenAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FiatTokenAll(ctx, req.(*QueryAllFiatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRateLimit(ctx, req.(*QueryMintRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingMasterMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMasterMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMasterMinter(ctx, req.(*QueryGetPendingMasterMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingPauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPauser(ctx, req.(*QueryGetPendingPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBlacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingBlacklisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBlacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingBlacklister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBlacklister(ctx, req.(*QueryGetPendingBlacklisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaxSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxSupply(ctx, req.(*QueryMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Wiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWiperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Wiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Wiper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Wiper(ctx, req.(*QueryGetWiperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingWiperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingWiper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWiper(ctx, req.(*QueryGetPendingWiperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Frozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Frozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Frozen(ctx, req.(*QueryGetFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAll(ctx, req.(*QueryAllFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannel(ctx, req.(*QueryGetAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedChannelAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannelAll(ctx, req.(*QueryAllAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimit(ctx, req.(*QueryChannelRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelRateLimitAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimitAll(ctx, req.(*QueryAllChannelRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQuarantinedRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuarantinedRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedRefund(ctx, req.(*QueryGetQuarantinedRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedRefundAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQuarantinedRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedRefundAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuarantinedRefundAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedRefundAll(ctx, req.(*QueryAllQuarantinedRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +1296,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Owner",
 			Handler:    _Query_Owner_Handler,
 		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
 		{
 			MethodName: "MinterController",
 			Handler:    _Query_MinterController_Handler,
@@ -530,10 +1308,94 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MinterControllerAll",
 			Handler:    _Query_MinterControllerAll_Handler,
 		},
+		{
+			MethodName: "MintersOfController",
+			Handler:    _Query_MintersOfController_Handler,
+		},
+		{
+			MethodName: "ControllersOfMinter",
+			Handler:    _Query_ControllersOfMinter_Handler,
+		},
+		{
+			MethodName: "MinterControllerByMinter",
+			Handler:    _Query_MinterControllerByMinter_Handler,
+		},
 		{
 			MethodName: "MintingDenom",
 			Handler:    _Query_MintingDenom_Handler,
 		},
+		{
+			MethodName: "FiatToken",
+			Handler:    _Query_FiatToken_Handler,
+		},
+		{
+			MethodName: "FiatTokenAll",
+			Handler:    _Query_FiatTokenAll_Handler,
+		},
+		{
+			MethodName: "MintRateLimit",
+			Handler:    _Query_MintRateLimit_Handler,
+		},
+		{
+			MethodName: "PendingMasterMinter",
+			Handler:    _Query_PendingMasterMinter_Handler,
+		},
+		{
+			MethodName: "PendingPauser",
+			Handler:    _Query_PendingPauser_Handler,
+		},
+		{
+			MethodName: "PendingBlacklister",
+			Handler:    _Query_PendingBlacklister_Handler,
+		},
+		{
+			MethodName: "MaxSupply",
+			Handler:    _Query_MaxSupply_Handler,
+		},
+		{
+			MethodName: "Wiper",
+			Handler:    _Query_Wiper_Handler,
+		},
+		{
+			MethodName: "PendingWiper",
+			Handler:    _Query_PendingWiper_Handler,
+		},
+		{
+			MethodName: "Frozen",
+			Handler:    _Query_Frozen_Handler,
+		},
+		{
+			MethodName: "FrozenAll",
+			Handler:    _Query_FrozenAll_Handler,
+		},
+		{
+			MethodName: "AllowedChannel",
+			Handler:    _Query_AllowedChannel_Handler,
+		},
+		{
+			MethodName: "AllowedChannelAll",
+			Handler:    _Query_AllowedChannelAll_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimit",
+			Handler:    _Query_ChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimitAll",
+			Handler:    _Query_ChannelRateLimitAll_Handler,
+		},
+		{
+			MethodName: "QuarantinedRefund",
+			Handler:    _Query_QuarantinedRefund_Handler,
+		},
+		{
+			MethodName: "QuarantinedRefundAll",
+			Handler:    _Query_QuarantinedRefundAll_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/fiattokenfactory/v1/query.proto",
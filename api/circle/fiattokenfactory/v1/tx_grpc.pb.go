@@ -19,21 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateMasterMinter_FullMethodName        = "/circle.fiattokenfactory.v1.Msg/UpdateMasterMinter"
-	Msg_UpdatePauser_FullMethodName              = "/circle.fiattokenfactory.v1.Msg/UpdatePauser"
-	Msg_UpdateBlacklister_FullMethodName         = "/circle.fiattokenfactory.v1.Msg/UpdateBlacklister"
-	Msg_UpdateOwner_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/UpdateOwner"
-	Msg_AcceptOwner_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/AcceptOwner"
-	Msg_ConfigureMinter_FullMethodName           = "/circle.fiattokenfactory.v1.Msg/ConfigureMinter"
-	Msg_RemoveMinter_FullMethodName              = "/circle.fiattokenfactory.v1.Msg/RemoveMinter"
-	Msg_Mint_FullMethodName                      = "/circle.fiattokenfactory.v1.Msg/Mint"
-	Msg_Burn_FullMethodName                      = "/circle.fiattokenfactory.v1.Msg/Burn"
-	Msg_Blacklist_FullMethodName                 = "/circle.fiattokenfactory.v1.Msg/Blacklist"
-	Msg_Unblacklist_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/Unblacklist"
-	Msg_Pause_FullMethodName                     = "/circle.fiattokenfactory.v1.Msg/Pause"
-	Msg_Unpause_FullMethodName                   = "/circle.fiattokenfactory.v1.Msg/Unpause"
-	Msg_ConfigureMinterController_FullMethodName = "/circle.fiattokenfactory.v1.Msg/ConfigureMinterController"
-	Msg_RemoveMinterController_FullMethodName    = "/circle.fiattokenfactory.v1.Msg/RemoveMinterController"
+	Msg_UpdateMasterMinter_FullMethodName         = "/circle.fiattokenfactory.v1.Msg/UpdateMasterMinter"
+	Msg_UpdatePauser_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/UpdatePauser"
+	Msg_UpdateBlacklister_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/UpdateBlacklister"
+	Msg_UpdateOwner_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/UpdateOwner"
+	Msg_AcceptOwner_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/AcceptOwner"
+	Msg_ConfigureMinter_FullMethodName            = "/circle.fiattokenfactory.v1.Msg/ConfigureMinter"
+	Msg_RemoveMinter_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/RemoveMinter"
+	Msg_Mint_FullMethodName                       = "/circle.fiattokenfactory.v1.Msg/Mint"
+	Msg_Burn_FullMethodName                       = "/circle.fiattokenfactory.v1.Msg/Burn"
+	Msg_Blacklist_FullMethodName                  = "/circle.fiattokenfactory.v1.Msg/Blacklist"
+	Msg_Unblacklist_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/Unblacklist"
+	Msg_Pause_FullMethodName                      = "/circle.fiattokenfactory.v1.Msg/Pause"
+	Msg_Unpause_FullMethodName                    = "/circle.fiattokenfactory.v1.Msg/Unpause"
+	Msg_ConfigureMinterController_FullMethodName  = "/circle.fiattokenfactory.v1.Msg/ConfigureMinterController"
+	Msg_RemoveMinterController_FullMethodName     = "/circle.fiattokenfactory.v1.Msg/RemoveMinterController"
+	Msg_RegisterFiatToken_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/RegisterFiatToken"
+	Msg_AcceptMasterMinter_FullMethodName         = "/circle.fiattokenfactory.v1.Msg/AcceptMasterMinter"
+	Msg_AcceptPauser_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/AcceptPauser"
+	Msg_AcceptBlacklister_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/AcceptBlacklister"
+	Msg_CancelPendingRole_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/CancelPendingRole"
+	Msg_AddMinterToController_FullMethodName      = "/circle.fiattokenfactory.v1.Msg/AddMinterToController"
+	Msg_RemoveMinterFromController_FullMethodName = "/circle.fiattokenfactory.v1.Msg/RemoveMinterFromController"
+	Msg_IncreaseMinterAllowance_FullMethodName    = "/circle.fiattokenfactory.v1.Msg/IncreaseMinterAllowance"
+	Msg_DecreaseMinterAllowance_FullMethodName    = "/circle.fiattokenfactory.v1.Msg/DecreaseMinterAllowance"
+	Msg_SetMintRateLimit_FullMethodName           = "/circle.fiattokenfactory.v1.Msg/SetMintRateLimit"
+	Msg_SetMaxSupply_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/SetMaxSupply"
+	Msg_BlacklistBatch_FullMethodName             = "/circle.fiattokenfactory.v1.Msg/BlacklistBatch"
+	Msg_UnblacklistBatch_FullMethodName           = "/circle.fiattokenfactory.v1.Msg/UnblacklistBatch"
+	Msg_UpdateWiper_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/UpdateWiper"
+	Msg_AcceptWiper_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/AcceptWiper"
+	Msg_WipeBlacklistedBalance_FullMethodName     = "/circle.fiattokenfactory.v1.Msg/WipeBlacklistedBalance"
+	Msg_Freeze_FullMethodName                     = "/circle.fiattokenfactory.v1.Msg/Freeze"
+	Msg_Unfreeze_FullMethodName                   = "/circle.fiattokenfactory.v1.Msg/Unfreeze"
+	Msg_ReleaseQuarantinedRefund_FullMethodName   = "/circle.fiattokenfactory.v1.Msg/ReleaseQuarantinedRefund"
+	Msg_AddAllowedChannel_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/AddAllowedChannel"
+	Msg_RemoveAllowedChannel_FullMethodName       = "/circle.fiattokenfactory.v1.Msg/RemoveAllowedChannel"
+	Msg_SetChannelAllowlist_FullMethodName        = "/circle.fiattokenfactory.v1.Msg/SetChannelAllowlist"
+	Msg_SetChannelRateLimit_FullMethodName        = "/circle.fiattokenfactory.v1.Msg/SetChannelRateLimit"
+	Msg_AuthorityUpdateRole_FullMethodName        = "/circle.fiattokenfactory.v1.Msg/AuthorityUpdateRole"
+	Msg_AuthorityPause_FullMethodName             = "/circle.fiattokenfactory.v1.Msg/AuthorityPause"
+	Msg_UpdateParams_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -55,6 +81,32 @@ type MsgClient interface {
 	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
 	ConfigureMinterController(ctx context.Context, in *MsgConfigureMinterController, opts ...grpc.CallOption) (*MsgConfigureMinterControllerResponse, error)
 	RemoveMinterController(ctx context.Context, in *MsgRemoveMinterController, opts ...grpc.CallOption) (*MsgRemoveMinterControllerResponse, error)
+	RegisterFiatToken(ctx context.Context, in *MsgRegisterFiatToken, opts ...grpc.CallOption) (*MsgRegisterFiatTokenResponse, error)
+	AcceptMasterMinter(ctx context.Context, in *MsgAcceptMasterMinter, opts ...grpc.CallOption) (*MsgAcceptMasterMinterResponse, error)
+	AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error)
+	AcceptBlacklister(ctx context.Context, in *MsgAcceptBlacklister, opts ...grpc.CallOption) (*MsgAcceptBlacklisterResponse, error)
+	CancelPendingRole(ctx context.Context, in *MsgCancelPendingRole, opts ...grpc.CallOption) (*MsgCancelPendingRoleResponse, error)
+	AddMinterToController(ctx context.Context, in *MsgAddMinterToController, opts ...grpc.CallOption) (*MsgAddMinterToControllerResponse, error)
+	RemoveMinterFromController(ctx context.Context, in *MsgRemoveMinterFromController, opts ...grpc.CallOption) (*MsgRemoveMinterFromControllerResponse, error)
+	IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error)
+	SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error)
+	SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error)
+	BlacklistBatch(ctx context.Context, in *MsgBlacklistBatch, opts ...grpc.CallOption) (*MsgBlacklistBatchResponse, error)
+	UnblacklistBatch(ctx context.Context, in *MsgUnblacklistBatch, opts ...grpc.CallOption) (*MsgUnblacklistBatchResponse, error)
+	UpdateWiper(ctx context.Context, in *MsgUpdateWiper, opts ...grpc.CallOption) (*MsgUpdateWiperResponse, error)
+	AcceptWiper(ctx context.Context, in *MsgAcceptWiper, opts ...grpc.CallOption) (*MsgAcceptWiperResponse, error)
+	WipeBlacklistedBalance(ctx context.Context, in *MsgWipeBlacklistedBalance, opts ...grpc.CallOption) (*MsgWipeBlacklistedBalanceResponse, error)
+	Freeze(ctx context.Context, in *MsgFreeze, opts ...grpc.CallOption) (*MsgFreezeResponse, error)
+	Unfreeze(ctx context.Context, in *MsgUnfreeze, opts ...grpc.CallOption) (*MsgUnfreezeResponse, error)
+	ReleaseQuarantinedRefund(ctx context.Context, in *MsgReleaseQuarantinedRefund, opts ...grpc.CallOption) (*MsgReleaseQuarantinedRefundResponse, error)
+	AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error)
+	SetChannelAllowlist(ctx context.Context, in *MsgSetChannelAllowlist, opts ...grpc.CallOption) (*MsgSetChannelAllowlistResponse, error)
+	SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error)
+	AuthorityUpdateRole(ctx context.Context, in *MsgAuthorityUpdateRole, opts ...grpc.CallOption) (*MsgAuthorityUpdateRoleResponse, error)
+	AuthorityPause(ctx context.Context, in *MsgAuthorityPause, opts ...grpc.CallOption) (*MsgAuthorityPauseResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -200,6 +252,240 @@ func (c *msgClient) RemoveMinterController(ctx context.Context, in *MsgRemoveMin
 	return out, nil
 }
 
+func (c *msgClient) RegisterFiatToken(ctx context.Context, in *MsgRegisterFiatToken, opts ...grpc.CallOption) (*MsgRegisterFiatTokenResponse, error) {
+	out := new(MsgRegisterFiatTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterFiatToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptMasterMinter(ctx context.Context, in *MsgAcceptMasterMinter, opts ...grpc.CallOption) (*MsgAcceptMasterMinterResponse, error) {
+	out := new(MsgAcceptMasterMinterResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptMasterMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error) {
+	out := new(MsgAcceptPauserResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptPauser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptBlacklister(ctx context.Context, in *MsgAcceptBlacklister, opts ...grpc.CallOption) (*MsgAcceptBlacklisterResponse, error) {
+	out := new(MsgAcceptBlacklisterResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptBlacklister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPendingRole(ctx context.Context, in *MsgCancelPendingRole, opts ...grpc.CallOption) (*MsgCancelPendingRoleResponse, error) {
+	out := new(MsgCancelPendingRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPendingRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMinterToController(ctx context.Context, in *MsgAddMinterToController, opts ...grpc.CallOption) (*MsgAddMinterToControllerResponse, error) {
+	out := new(MsgAddMinterToControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_AddMinterToController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinterFromController(ctx context.Context, in *MsgRemoveMinterFromController, opts ...grpc.CallOption) (*MsgRemoveMinterFromControllerResponse, error) {
+	out := new(MsgRemoveMinterFromControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMinterFromController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreaseMinterAllowance(ctx context.Context, in *MsgIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgIncreaseMinterAllowanceResponse, error) {
+	out := new(MsgIncreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_IncreaseMinterAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseMinterAllowance(ctx context.Context, in *MsgDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgDecreaseMinterAllowanceResponse, error) {
+	out := new(MsgDecreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DecreaseMinterAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error) {
+	out := new(MsgSetMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error) {
+	out := new(MsgSetMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaxSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlacklistBatch(ctx context.Context, in *MsgBlacklistBatch, opts ...grpc.CallOption) (*MsgBlacklistBatchResponse, error) {
+	out := new(MsgBlacklistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_BlacklistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblacklistBatch(ctx context.Context, in *MsgUnblacklistBatch, opts ...grpc.CallOption) (*MsgUnblacklistBatchResponse, error) {
+	out := new(MsgUnblacklistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_UnblacklistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWiper(ctx context.Context, in *MsgUpdateWiper, opts ...grpc.CallOption) (*MsgUpdateWiperResponse, error) {
+	out := new(MsgUpdateWiperResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateWiper_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptWiper(ctx context.Context, in *MsgAcceptWiper, opts ...grpc.CallOption) (*MsgAcceptWiperResponse, error) {
+	out := new(MsgAcceptWiperResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptWiper_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WipeBlacklistedBalance(ctx context.Context, in *MsgWipeBlacklistedBalance, opts ...grpc.CallOption) (*MsgWipeBlacklistedBalanceResponse, error) {
+	out := new(MsgWipeBlacklistedBalanceResponse)
+	err := c.cc.Invoke(ctx, Msg_WipeBlacklistedBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Freeze(ctx context.Context, in *MsgFreeze, opts ...grpc.CallOption) (*MsgFreezeResponse, error) {
+	out := new(MsgFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_Freeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unfreeze(ctx context.Context, in *MsgUnfreeze, opts ...grpc.CallOption) (*MsgUnfreezeResponse, error) {
+	out := new(MsgUnfreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_Unfreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseQuarantinedRefund(ctx context.Context, in *MsgReleaseQuarantinedRefund, opts ...grpc.CallOption) (*MsgReleaseQuarantinedRefundResponse, error) {
+	out := new(MsgReleaseQuarantinedRefundResponse)
+	err := c.cc.Invoke(ctx, Msg_ReleaseQuarantinedRefund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedChannel(ctx context.Context, in *MsgAddAllowedChannel, opts ...grpc.CallOption) (*MsgAddAllowedChannelResponse, error) {
+	out := new(MsgAddAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAllowedChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedChannel(ctx context.Context, in *MsgRemoveAllowedChannel, opts ...grpc.CallOption) (*MsgRemoveAllowedChannelResponse, error) {
+	out := new(MsgRemoveAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAllowedChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelAllowlist(ctx context.Context, in *MsgSetChannelAllowlist, opts ...grpc.CallOption) (*MsgSetChannelAllowlistResponse, error) {
+	out := new(MsgSetChannelAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error) {
+	out := new(MsgSetChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AuthorityUpdateRole(ctx context.Context, in *MsgAuthorityUpdateRole, opts ...grpc.CallOption) (*MsgAuthorityUpdateRoleResponse, error) {
+	out := new(MsgAuthorityUpdateRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_AuthorityUpdateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AuthorityPause(ctx context.Context, in *MsgAuthorityPause, opts ...grpc.CallOption) (*MsgAuthorityPauseResponse, error) {
+	out := new(MsgAuthorityPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_AuthorityPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -219,6 +505,32 @@ type MsgServer interface {
 	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
 	ConfigureMinterController(context.Context, *MsgConfigureMinterController) (*MsgConfigureMinterControllerResponse, error)
 	RemoveMinterController(context.Context, *MsgRemoveMinterController) (*MsgRemoveMinterControllerResponse, error)
+	RegisterFiatToken(context.Context, *MsgRegisterFiatToken) (*MsgRegisterFiatTokenResponse, error)
+	AcceptMasterMinter(context.Context, *MsgAcceptMasterMinter) (*MsgAcceptMasterMinterResponse, error)
+	AcceptPauser(context.Context, *MsgAcceptPauser) (*MsgAcceptPauserResponse, error)
+	AcceptBlacklister(context.Context, *MsgAcceptBlacklister) (*MsgAcceptBlacklisterResponse, error)
+	CancelPendingRole(context.Context, *MsgCancelPendingRole) (*MsgCancelPendingRoleResponse, error)
+	AddMinterToController(context.Context, *MsgAddMinterToController) (*MsgAddMinterToControllerResponse, error)
+	RemoveMinterFromController(context.Context, *MsgRemoveMinterFromController) (*MsgRemoveMinterFromControllerResponse, error)
+	IncreaseMinterAllowance(context.Context, *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(context.Context, *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error)
+	SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error)
+	SetMaxSupply(context.Context, *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error)
+	BlacklistBatch(context.Context, *MsgBlacklistBatch) (*MsgBlacklistBatchResponse, error)
+	UnblacklistBatch(context.Context, *MsgUnblacklistBatch) (*MsgUnblacklistBatchResponse, error)
+	UpdateWiper(context.Context, *MsgUpdateWiper) (*MsgUpdateWiperResponse, error)
+	AcceptWiper(context.Context, *MsgAcceptWiper) (*MsgAcceptWiperResponse, error)
+	WipeBlacklistedBalance(context.Context, *MsgWipeBlacklistedBalance) (*MsgWipeBlacklistedBalanceResponse, error)
+	Freeze(context.Context, *MsgFreeze) (*MsgFreezeResponse, error)
+	Unfreeze(context.Context, *MsgUnfreeze) (*MsgUnfreezeResponse, error)
+	ReleaseQuarantinedRefund(context.Context, *MsgReleaseQuarantinedRefund) (*MsgReleaseQuarantinedRefundResponse, error)
+	AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error)
+	RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error)
+	SetChannelAllowlist(context.Context, *MsgSetChannelAllowlist) (*MsgSetChannelAllowlistResponse, error)
+	SetChannelRateLimit(context.Context, *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error)
+	AuthorityUpdateRole(context.Context, *MsgAuthorityUpdateRole) (*MsgAuthorityUpdateRoleResponse, error)
+	AuthorityPause(context.Context, *MsgAuthorityPause) (*MsgAuthorityPauseResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -271,6 +583,84 @@ func (UnimplementedMsgServer) ConfigureMinterController(context.Context, *MsgCon
 func (UnimplementedMsgServer) RemoveMinterController(context.Context, *MsgRemoveMinterController) (*MsgRemoveMinterControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinterController not implemented")
 }
+func (UnimplementedMsgServer) RegisterFiatToken(context.Context, *MsgRegisterFiatToken) (*MsgRegisterFiatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFiatToken not implemented")
+}
+func (UnimplementedMsgServer) AcceptMasterMinter(context.Context, *MsgAcceptMasterMinter) (*MsgAcceptMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptMasterMinter not implemented")
+}
+func (UnimplementedMsgServer) AcceptPauser(context.Context, *MsgAcceptPauser) (*MsgAcceptPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPauser not implemented")
+}
+func (UnimplementedMsgServer) AcceptBlacklister(context.Context, *MsgAcceptBlacklister) (*MsgAcceptBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptBlacklister not implemented")
+}
+func (UnimplementedMsgServer) CancelPendingRole(context.Context, *MsgCancelPendingRole) (*MsgCancelPendingRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingRole not implemented")
+}
+func (UnimplementedMsgServer) AddMinterToController(context.Context, *MsgAddMinterToController) (*MsgAddMinterToControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMinterToController not implemented")
+}
+func (UnimplementedMsgServer) RemoveMinterFromController(context.Context, *MsgRemoveMinterFromController) (*MsgRemoveMinterFromControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinterFromController not implemented")
+}
+func (UnimplementedMsgServer) IncreaseMinterAllowance(context.Context, *MsgIncreaseMinterAllowance) (*MsgIncreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) DecreaseMinterAllowance(context.Context, *MsgDecreaseMinterAllowance) (*MsgDecreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetMaxSupply(context.Context, *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxSupply not implemented")
+}
+func (UnimplementedMsgServer) BlacklistBatch(context.Context, *MsgBlacklistBatch) (*MsgBlacklistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistBatch not implemented")
+}
+func (UnimplementedMsgServer) UnblacklistBatch(context.Context, *MsgUnblacklistBatch) (*MsgUnblacklistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblacklistBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdateWiper(context.Context, *MsgUpdateWiper) (*MsgUpdateWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWiper not implemented")
+}
+func (UnimplementedMsgServer) AcceptWiper(context.Context, *MsgAcceptWiper) (*MsgAcceptWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWiper not implemented")
+}
+func (UnimplementedMsgServer) WipeBlacklistedBalance(context.Context, *MsgWipeBlacklistedBalance) (*MsgWipeBlacklistedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WipeBlacklistedBalance not implemented")
+}
+func (UnimplementedMsgServer) Freeze(context.Context, *MsgFreeze) (*MsgFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Freeze not implemented")
+}
+func (UnimplementedMsgServer) Unfreeze(context.Context, *MsgUnfreeze) (*MsgUnfreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfreeze not implemented")
+}
+func (UnimplementedMsgServer) ReleaseQuarantinedRefund(context.Context, *MsgReleaseQuarantinedRefund) (*MsgReleaseQuarantinedRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuarantinedRefund not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedChannel(context.Context, *MsgAddAllowedChannel) (*MsgAddAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedChannel not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedChannel(context.Context, *MsgRemoveAllowedChannel) (*MsgRemoveAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedChannel not implemented")
+}
+func (UnimplementedMsgServer) SetChannelAllowlist(context.Context, *MsgSetChannelAllowlist) (*MsgSetChannelAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelAllowlist not implemented")
+}
+func (UnimplementedMsgServer) SetChannelRateLimit(context.Context, *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelRateLimit not implemented")
+}
+func (UnimplementedMsgServer) AuthorityUpdateRole(context.Context, *MsgAuthorityUpdateRole) (*MsgAuthorityUpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorityUpdateRole not implemented")
+}
+func (UnimplementedMsgServer) AuthorityPause(context.Context, *MsgAuthorityPause) (*MsgAuthorityPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorityPause not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -554,72 +944,644 @@ func _Msg_RemoveMinterController_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "circle.fiattokenfactory.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateMasterMinter",
-			Handler:    _Msg_UpdateMasterMinter_Handler,
-		},
-		{
-			MethodName: "UpdatePauser",
-			Handler:    _Msg_UpdatePauser_Handler,
-		},
-		{
-			MethodName: "UpdateBlacklister",
-			Handler:    _Msg_UpdateBlacklister_Handler,
-		},
-		{
-			MethodName: "UpdateOwner",
-			Handler:    _Msg_UpdateOwner_Handler,
-		},
-		{
-			MethodName: "AcceptOwner",
-			Handler:    _Msg_AcceptOwner_Handler,
-		},
-		{
-			MethodName: "ConfigureMinter",
-			Handler:    _Msg_ConfigureMinter_Handler,
-		},
-		{
-			MethodName: "RemoveMinter",
-			Handler:    _Msg_RemoveMinter_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "Blacklist",
-			Handler:    _Msg_Blacklist_Handler,
-		},
-		{
-			MethodName: "Unblacklist",
-			Handler:    _Msg_Unblacklist_Handler,
-		},
-		{
-			MethodName: "Pause",
-			Handler:    _Msg_Pause_Handler,
-		},
-		{
-			MethodName: "Unpause",
-			Handler:    _Msg_Unpause_Handler,
-		},
-		{
-			MethodName: "ConfigureMinterController",
-			Handler:    _Msg_ConfigureMinterController_Handler,
-		},
-		{
-			MethodName: "RemoveMinterController",
-			Handler:    _Msg_RemoveMinterController_Handler,
+func _Msg_RegisterFiatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFiatToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFiatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterFiatToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFiatToken(ctx, req.(*MsgRegisterFiatToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptMasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptMasterMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptMasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptMasterMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptMasterMinter(ctx, req.(*MsgAcceptMasterMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptPauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPauser(ctx, req.(*MsgAcceptPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptBlacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptBlacklister)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptBlacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptBlacklister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptBlacklister(ctx, req.(*MsgAcceptBlacklister))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPendingRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPendingRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingRole(ctx, req.(*MsgCancelPendingRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMinterToController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMinterToController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMinterToController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddMinterToController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMinterToController(ctx, req.(*MsgAddMinterToController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinterFromController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinterFromController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinterFromController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMinterFromController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinterFromController(ctx, req.(*MsgRemoveMinterFromController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IncreaseMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, req.(*MsgIncreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecreaseMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, req.(*MsgDecreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintRateLimit(ctx, req.(*MsgSetMintRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxSupply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaxSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxSupply(ctx, req.(*MsgSetMaxSupply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlacklistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlacklistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlacklistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlacklistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlacklistBatch(ctx, req.(*MsgBlacklistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblacklistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblacklistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblacklistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnblacklistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblacklistBatch(ctx, req.(*MsgUnblacklistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWiper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateWiper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWiper(ctx, req.(*MsgUpdateWiper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptWiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptWiper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptWiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptWiper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptWiper(ctx, req.(*MsgAcceptWiper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WipeBlacklistedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWipeBlacklistedBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WipeBlacklistedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WipeBlacklistedBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WipeBlacklistedBalance(ctx, req.(*MsgWipeBlacklistedBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Freeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Freeze(ctx, req.(*MsgFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unfreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unfreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unfreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unfreeze(ctx, req.(*MsgUnfreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseQuarantinedRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseQuarantinedRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseQuarantinedRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReleaseQuarantinedRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseQuarantinedRefund(ctx, req.(*MsgReleaseQuarantinedRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAllowedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedChannel(ctx, req.(*MsgAddAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAllowedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedChannel(ctx, req.(*MsgRemoveAllowedChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelAllowlist(ctx, req.(*MsgSetChannelAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, req.(*MsgSetChannelRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AuthorityUpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorityUpdateRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorityUpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AuthorityUpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorityUpdateRole(ctx, req.(*MsgAuthorityUpdateRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AuthorityPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorityPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorityPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AuthorityPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorityPause(ctx, req.(*MsgAuthorityPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "circle.fiattokenfactory.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateMasterMinter",
+			Handler:    _Msg_UpdateMasterMinter_Handler,
+		},
+		{
+			MethodName: "UpdatePauser",
+			Handler:    _Msg_UpdatePauser_Handler,
+		},
+		{
+			MethodName: "UpdateBlacklister",
+			Handler:    _Msg_UpdateBlacklister_Handler,
+		},
+		{
+			MethodName: "UpdateOwner",
+			Handler:    _Msg_UpdateOwner_Handler,
+		},
+		{
+			MethodName: "AcceptOwner",
+			Handler:    _Msg_AcceptOwner_Handler,
+		},
+		{
+			MethodName: "ConfigureMinter",
+			Handler:    _Msg_ConfigureMinter_Handler,
+		},
+		{
+			MethodName: "RemoveMinter",
+			Handler:    _Msg_RemoveMinter_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "Blacklist",
+			Handler:    _Msg_Blacklist_Handler,
+		},
+		{
+			MethodName: "Unblacklist",
+			Handler:    _Msg_Unblacklist_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "ConfigureMinterController",
+			Handler:    _Msg_ConfigureMinterController_Handler,
+		},
+		{
+			MethodName: "RemoveMinterController",
+			Handler:    _Msg_RemoveMinterController_Handler,
+		},
+		{
+			MethodName: "RegisterFiatToken",
+			Handler:    _Msg_RegisterFiatToken_Handler,
+		},
+		{
+			MethodName: "AcceptMasterMinter",
+			Handler:    _Msg_AcceptMasterMinter_Handler,
+		},
+		{
+			MethodName: "AcceptPauser",
+			Handler:    _Msg_AcceptPauser_Handler,
+		},
+		{
+			MethodName: "AcceptBlacklister",
+			Handler:    _Msg_AcceptBlacklister_Handler,
+		},
+		{
+			MethodName: "CancelPendingRole",
+			Handler:    _Msg_CancelPendingRole_Handler,
+		},
+		{
+			MethodName: "AddMinterToController",
+			Handler:    _Msg_AddMinterToController_Handler,
+		},
+		{
+			MethodName: "RemoveMinterFromController",
+			Handler:    _Msg_RemoveMinterFromController_Handler,
+		},
+		{
+			MethodName: "IncreaseMinterAllowance",
+			Handler:    _Msg_IncreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseMinterAllowance",
+			Handler:    _Msg_DecreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "SetMintRateLimit",
+			Handler:    _Msg_SetMintRateLimit_Handler,
+		},
+		{
+			MethodName: "SetMaxSupply",
+			Handler:    _Msg_SetMaxSupply_Handler,
+		},
+		{
+			MethodName: "BlacklistBatch",
+			Handler:    _Msg_BlacklistBatch_Handler,
+		},
+		{
+			MethodName: "UnblacklistBatch",
+			Handler:    _Msg_UnblacklistBatch_Handler,
+		},
+		{
+			MethodName: "UpdateWiper",
+			Handler:    _Msg_UpdateWiper_Handler,
+		},
+		{
+			MethodName: "AcceptWiper",
+			Handler:    _Msg_AcceptWiper_Handler,
+		},
+		{
+			MethodName: "WipeBlacklistedBalance",
+			Handler:    _Msg_WipeBlacklistedBalance_Handler,
+		},
+		{
+			MethodName: "Freeze",
+			Handler:    _Msg_Freeze_Handler,
+		},
+		{
+			MethodName: "Unfreeze",
+			Handler:    _Msg_Unfreeze_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantinedRefund",
+			Handler:    _Msg_ReleaseQuarantinedRefund_Handler,
+		},
+		{
+			MethodName: "AddAllowedChannel",
+			Handler:    _Msg_AddAllowedChannel_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedChannel",
+			Handler:    _Msg_RemoveAllowedChannel_Handler,
+		},
+		{
+			MethodName: "SetChannelAllowlist",
+			Handler:    _Msg_SetChannelAllowlist_Handler,
+		},
+		{
+			MethodName: "SetChannelRateLimit",
+			Handler:    _Msg_SetChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "AuthorityUpdateRole",
+			Handler:    _Msg_AuthorityUpdateRole_Handler,
+		},
+		{
+			MethodName: "AuthorityPause",
+			Handler:    _Msg_AuthorityPause_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
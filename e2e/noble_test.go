@@ -151,6 +151,11 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	)
 	require.NoError(t, err, "failed to execute update master minter tx")
 
+	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
+		tokenfactoryModName, "accept-master-minter",
+	)
+	require.NoError(t, err, "failed to execute tx to accept master minter role")
+
 	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
 		tokenfactoryModName, "configure-minter-controller", roles.MinterController.FormattedAddress(), roles.Minter.FormattedAddress(),
 	)
@@ -179,6 +184,11 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	)
 	require.NoError(t, err, "failed to set blacklister")
 
+	_, err = nobleValidator.ExecTx(ctx, roles.Blacklister.KeyName(),
+		tokenfactoryModName, "accept-blacklister",
+	)
+	require.NoError(t, err, "failed to accept blacklister role")
+
 	_, err = nobleValidator.ExecTx(ctx, roles.Blacklister.KeyName(),
 		tokenfactoryModName, "blacklist", extraWallets.User.FormattedAddress(),
 	)
@@ -295,6 +305,11 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	)
 	require.NoError(t, err, "failed to update pauser")
 
+	_, err = nobleValidator.ExecTx(ctx, roles.Pauser.KeyName(),
+		tokenfactoryModName, "accept-pauser",
+	)
+	require.NoError(t, err, "failed to accept pauser role")
+
 	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
 		tokenfactoryModName, "configure-minter-controller", roles.MinterController2.FormattedAddress(), extraWallets.User.FormattedAddress())
 	require.NoError(t, err, "failed to execute configure minter controller tx")
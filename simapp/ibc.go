@@ -83,7 +83,11 @@ func (app *SimApp) RegisterIBCModules() error {
 	)
 	app.ScopedTransferKeeper = scopedTransferKeeper
 
-	ibcRouter := porttypes.NewRouter().AddRoute(transfertypes.ModuleName, blockibc.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.FiatTokenFactoryKeeper))
+	// outbound transfers are sent through the blockibc middleware, so it can police packets sent by other modules
+	transferStack := blockibc.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, app.FiatTokenFactoryKeeper)
+	app.TransferKeeper.WithICS4Wrapper(transferStack)
+
+	ibcRouter := porttypes.NewRouter().AddRoute(transfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	if err := app.RegisterModules(
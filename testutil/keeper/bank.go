@@ -35,8 +35,8 @@ type MockBankKeeper struct {
 
 var _ types.BankKeeper = MockBankKeeper{}
 
-func (MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
-	return nil
+func (k MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return k.Balances[addr.String()]
 }
 
 func (k MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
@@ -81,12 +81,20 @@ func (k MockBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress,
 	return nil
 }
 
+func (k MockBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	supply := sdk.NewInt64Coin(denom, 0)
+	for _, balance := range k.Balances {
+		supply = supply.AddAmount(balance.AmountOf(denom))
+	}
+	return supply
+}
+
 func (MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool) {
-	if denom == "uusdc" {
+	if denom == "uusdc" || denom == "ueurc" {
 		return banktypes.Metadata{
 			DenomUnits: []*banktypes.DenomUnit{
 				{
-					Denom: "uusdc",
+					Denom: denom,
 				},
 			},
 		}, true
@@ -29,8 +29,10 @@ import (
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	portkeeper "github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -64,13 +66,31 @@ func BlockIBC() (blockibc.IBCMiddleware, *fiattokenfactorykeeper.Keeper, sdk.Con
 	}
 
 	ftfKeeper := fiattokenfactorykeeper.NewKeeper(
-		cdc, nil, runtime.NewKVStoreService(keys[fiattokenfactorytypes.StoreKey]), MockBankKeeper{},
+		cdc, nil, runtime.NewKVStoreService(keys[fiattokenfactorytypes.StoreKey]), Authority, false, MockBankKeeper{},
 	)
 	ftfKeeper.SetMintingDenom(ctx, fiattokenfactorytypes.MintingDenom{Denom: "uusdc"})
-	ftfKeeper.SetPaused(ctx, fiattokenfactorytypes.Paused{Paused: false})
+	ftfKeeper.SetPaused(ctx, fiattokenfactorytypes.Paused{})
 
 	return blockibc.NewIBCMiddleware(
 		transferIBCModule,
+		MockICS4Wrapper{},
 		ftfKeeper,
 	), ftfKeeper, ctx
 }
+
+// MockICS4Wrapper is an ICS4Wrapper that accepts every packet without a channel.
+type MockICS4Wrapper struct{}
+
+var _ porttypes.ICS4Wrapper = MockICS4Wrapper{}
+
+func (MockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _ string, _ string, _ clienttypes.Height, _ uint64, _ []byte) (uint64, error) {
+	return 1, nil
+}
+
+func (MockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI, _ exported.Acknowledgement) error {
+	return nil
+}
+
+func (MockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return transfertypes.Version, true
+}
@@ -29,9 +29,38 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// Authority is the module authority of the test keepers, the governance module
+// account as in a default app.
+var Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func FiatTokenfactoryKeeper() (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := FiatTokenfactoryKeeperWithStoreKey()
+	return k, ctx
+}
+
+// FiatTokenfactoryKeeperWithStoreKey also returns the store key, so tests can
+// write raw fixtures into the module store.
+func FiatTokenfactoryKeeperWithStoreKey() (*keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
+	return newFiatTokenfactoryKeeper(MockBankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	})
+}
+
+// FiatTokenfactoryKeeperWithBank also returns the mock bank keeper, so tests can
+// fund accounts directly.
+func FiatTokenfactoryKeeperWithBank() (*keeper.Keeper, sdk.Context, MockBankKeeper) {
+	bank := MockBankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, ctx, _ := newFiatTokenfactoryKeeper(bank)
+	return k, ctx, bank
+}
+
+func newFiatTokenfactoryKeeper(bank MockBankKeeper) (*keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
 	logger := log.NewNopLogger()
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -43,8 +72,8 @@ func FiatTokenfactoryKeeper() (*keeper.Keeper, sdk.Context) {
 		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
 		logger,
 		runtime.NewKVStoreService(key),
-		MockBankKeeper{
-			Balances: make(map[string]sdk.Coins),
-		},
-	), sdk.NewContext(state, cmtproto.Header{}, false, logger)
+		Authority,
+		false,
+		bank,
+	), sdk.NewContext(state, cmtproto.Header{}, false, logger), key
 }
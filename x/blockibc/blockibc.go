@@ -18,29 +18,35 @@ package blockibc
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ porttypes.IBCModule = &IBCMiddleware{}
+var _ porttypes.Middleware = &IBCMiddleware{}
 
 // IBCMiddleware implements the tokenfactory keeper in order to check against blacklisted addresses.
 type IBCMiddleware struct {
-	app    porttypes.IBCModule
-	keeper *keeper.Keeper
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      *keeper.Keeper
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
-func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, underlying application and the ICS4Wrapper
+// packets are sent through, usually the channel keeper.
+func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k *keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
-		app:    app,
-		keeper: k,
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
 	}
 }
 
@@ -97,8 +103,8 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 }
 
 // OnRecvPacket intercepts the packet data and checks the sender and receiver address against
-// the blacklisted addresses held in the tokenfactory keeper. If the address is found in the blacklist, an
-// acknowledgment error is returned.
+// the blacklisted addresses held in the tokenfactory keeper. If the address is found in the blacklist, or
+// the sender is frozen, an acknowledgment error is returned. See checkTransfer.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -113,51 +119,304 @@ func (im IBCMiddleware) OnRecvPacket(
 
 	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
 
-	mintingDenom := im.keeper.GetMintingDenom(ctx)
-	if denomTrace.BaseDenom != mintingDenom.Denom {
-		return im.app.OnRecvPacket(ctx, packet, relayer)
-	}
-
-	if im.keeper.GetPaused(ctx).Paused {
-		return channeltypes.NewErrorAcknowledgement(types.ErrPaused)
-	}
-
-	_, addressBz, err := keeper.DecodeNoLimitToBase256(data.Receiver)
+	token, found, err := im.keeper.ManagedDenom(ctx, denomTrace.BaseDenom)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
 
-	_, found := im.keeper.GetBlacklisted(ctx, addressBz)
-	if found {
-		ackErr = errors.Wrapf(types.ErrUnauthorized, "receiver address is blacklisted")
-		return channeltypes.NewErrorAcknowledgement(ackErr)
+	if err := checkChannel(ctx, token, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	_, addressBz, err = keeper.DecodeNoLimitToBase256(data.Sender)
-	if err != nil {
+	if err := checkTransfer(ctx, token, data); err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	_, found = im.keeper.GetBlacklisted(ctx, addressBz)
-	if found {
-		ackErr = errors.Wrapf(types.ErrUnauthorized, "sender address is blacklisted")
-		return channeltypes.NewErrorAcknowledgement(ackErr)
+	// the inflow is only committed together with a successful acknowledgement
+	if amount, ok := math.NewIntFromString(data.Amount); ok {
+		if err := token.ConsumeChannelInflow(ctx, packet.GetDestPort(), packet.GetDestChannel(), amount); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
 	}
 
 	return im.app.OnRecvPacket(ctx, packet, relayer)
 }
 
-// OnAcknowledgementPacket implements the IBCModule interface.
+// OnAcknowledgementPacket intercepts error acknowledgements of outbound transfers, returning their amount to the
+// outflow of the channel and quarantining the refund if it can not be returned to the sender. See settleOutflow
+// and quarantineRefund.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	var ack channeltypes.Acknowledgement
+	failed := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && !ack.Success()
+
+	if err := im.settleOutflow(ctx, packet, failed); err != nil {
+		return err
+	}
+
+	if failed {
+		quarantined, err := im.quarantineRefund(ctx, packet)
+		if err != nil {
+			return err
+		}
+		packet = quarantined
+	}
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
-// OnTimeoutPacket implements the IBCModule interface.
+// OnTimeoutPacket intercepts timed out outbound transfers, returning their amount to the outflow of the channel
+// and quarantining the refund if it can not be returned to the sender. See settleOutflow and quarantineRefund.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.settleOutflow(ctx, packet, true); err != nil {
+		return err
+	}
+
+	packet, err := im.quarantineRefund(ctx, packet)
+	if err != nil {
+		return err
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
+
+// settleOutflow clears the pending outflow of an outbound transfer of a managed denom once it is acknowledged
+// or timed out. If the transfer failed, its amount is returned to the outflow of the channel so that refunded
+// transfers do not count against the rate limit. See keeper.RevertChannelOutflow.
+func (im IBCMiddleware) settleOutflow(ctx sdk.Context, packet channeltypes.Packet, failed bool) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
+
+	token, found, err := im.keeper.ManagedDenom(ctx, denomTrace.BaseDenom)
+	if err != nil || !found {
+		return err
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !failed || !ok {
+		return token.RemovePendingOutflow(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	return token.RevertChannelOutflow(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), amount)
+}
+
+// quarantineRefund decides who receives the refund of an outbound transfer of a managed denom. The refund is
+// routed to the module account instead of the sender if transfers of the token are paused or the sender is
+// blacklisted, since the send restrictions would otherwise reject the refund and the packet could never be
+// acknowledged or timed out. The amount is recorded as a quarantined refund of the sender, which is returned by
+// MsgReleaseQuarantinedRefund once the sender can receive it again, or wiped together with the balance of a
+// blacklisted sender. A pause of IBC transfers alone or a frozen sender does not stop the refund, so it is
+// returned as usual. Only refunds of the native token are quarantined, a voucher of the token that is refunded is
+// minted or unescrowed as an IBC denom, which the module could never release as the native token. Packets that can
+// not be decoded are passed through unchanged for the underlying application to reject.
+func (im IBCMiddleware) quarantineRefund(ctx sdk.Context, packet channeltypes.Packet) (channeltypes.Packet, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return packet, nil
+	}
+
+	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
+	if !denomTrace.IsNativeDenom() {
+		return packet, nil
+	}
+
+	token, found, err := im.keeper.ManagedDenom(ctx, denomTrace.BaseDenom)
+	if err != nil || !found {
+		return packet, err
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return packet, nil
+	}
+
+	_, addressBz, err := keeper.DecodeNoLimitToBase256(data.Sender)
+	if err != nil {
+		return packet, nil
+	}
+
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return packet, err
+	}
+
+	_, blacklisted, err := token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return packet, err
+	}
+
+	var reason string
+	if paused.IsPaused(types.PAUSE_SCOPE_TRANSFER) {
+		reason = "paused"
+	} else if blacklisted {
+		reason = "blacklisted"
+	}
+
+	if reason == "" {
+		return packet, nil
+	}
+
+	sender := data.Sender
+	if err := token.QuarantineRefund(ctx, sdk.AccAddress(addressBz).String(), amount); err != nil {
+		return packet, err
+	}
+
+	data.Sender = authtypes.NewModuleAddress(types.ModuleName).String()
+	packet.Data = data.GetBytes()
+
+	return packet, ctx.EventManager().EmitTypedEvent(&types.RefundQuarantined{
+		Denom:         data.Denom,
+		Amount:        data.Amount,
+		Sender:        sender,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Reason:        reason,
+	})
+}
+
+// SendPacket implements the ICS4Wrapper interface. Outbound transfers of a managed denom are checked
+// at the channel layer, so that transfers started programmatically by other modules (e.g. ICA host,
+// packet-forward or wasm contracts) are held to the same rules as a top-level MsgTransfer.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	denomTrace := transfertypes.ParseDenomTrace(packetData.Denom)
+
+	token, found, err := im.keeper.ManagedDenom(ctx, denomTrace.BaseDenom)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	if err := checkChannel(ctx, token, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
+
+	if err := checkTransfer(ctx, token, packetData); err != nil {
+		return 0, err
+	}
+
+	if amount, ok := math.NewIntFromString(packetData.Amount); ok {
+		if err := token.ConsumeChannelOutflow(ctx, sourcePort, sourceChannel, amount); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := token.TrackChannelOutflow(ctx, sourcePort, sourceChannel, sequence); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// checkChannel checks that the fiat token may be transferred over a channel. See keeper.IsChannelAllowed.
+func checkChannel(ctx sdk.Context, token *keeper.Keeper, portID, channelID string) error {
+	allowed, err := token.IsChannelAllowed(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		mintingDenom, err := token.GetMintingDenom(ctx)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(types.ErrChannelNotAllowed, "%s can not be transferred over %s/%s", mintingDenom.Denom, portID, channelID)
+	}
+
+	return nil
+}
+
+// checkTransfer checks an ICS-20 transfer of a managed denom in either direction against the paused state of the
+// token, the blacklisted sender and receiver addresses and the frozen sender addresses.
+func checkTransfer(ctx sdk.Context, token *keeper.Keeper, data transfertypes.FungibleTokenPacketData) error {
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return err
+	}
+
+	if paused.IsPaused(types.PAUSE_SCOPE_IBC) {
+		return types.ErrPaused
+	}
+
+	_, addressBz, err := keeper.DecodeNoLimitToBase256(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	_, found, err := token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errors.Wrapf(types.ErrUnauthorized, "receiver address is blacklisted")
+	}
+
+	_, addressBz, err = keeper.DecodeNoLimitToBase256(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	_, found, err = token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errors.Wrapf(types.ErrUnauthorized, "sender address is blacklisted")
+	}
+
+	// a frozen address can still receive, but not send
+	_, found, err = token.GetFrozen(ctx, addressBz)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errors.Wrapf(types.ErrUnauthorized, "sender address is frozen")
+	}
+
+	return nil
+}
@@ -19,13 +19,18 @@ package blockibc_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/blockibc"
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +44,7 @@ func TestBlockIBC(t *testing.T) {
 	// ARRANGE: Organize table driven test cases.
 	testCases := map[string]struct {
 		toBlacklist         *sample.Account
+		toFreeze            *sample.Account
 		setPaused           bool
 		packet              channeltypes.Packet
 		expectSuccessfulAck bool
@@ -102,6 +108,24 @@ func TestBlockIBC(t *testing.T) {
 			packet:              mockPacket(sender.Address, receiverBech32mAddress),
 			expectSuccessfulAck: false,
 		},
+		"frozen bech32 sender": {
+			toFreeze:            &sender,
+			setPaused:           false,
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"frozen bech32m sender": {
+			toFreeze:            &senderBech32m,
+			setPaused:           false,
+			packet:              mockPacket(senderBech32m.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"frozen receiver": {
+			toFreeze:            &receiver,
+			setPaused:           false,
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -112,13 +136,20 @@ func TestBlockIBC(t *testing.T) {
 			// ARRANGE: Mock middleware stack.
 			middleware, ftf, ctx := keeper.BlockIBC()
 
-			// ACT: Set paused and blacklisted state based on test case.
-			ftf.SetPaused(ctx, fiattokenfactorytypes.Paused{Paused: tc.setPaused})
+			// ACT: Set paused, blacklisted and frozen state based on test case.
+			paused := fiattokenfactorytypes.Paused{}
+			paused.Set(tc.setPaused, fiattokenfactorytypes.PAUSE_SCOPE_IBC)
+			ftf.SetPaused(ctx, paused)
 			if tc.toBlacklist != nil {
 				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{
 					AddressBz: tc.toBlacklist.AddressBz,
 				})
 			}
+			if tc.toFreeze != nil {
+				ftf.SetFrozen(ctx, fiattokenfactorytypes.Frozen{
+					AddressBz: tc.toFreeze.AddressBz,
+				})
+			}
 
 			// ACT: Receive transfer packet in middleware.
 			ack := middleware.OnRecvPacket(ctx, tc.packet, nil)
@@ -135,6 +166,357 @@ func TestBlockIBC(t *testing.T) {
 	}
 }
 
+func TestBlockIBC_FiatToken(t *testing.T) {
+	// ARRANGE: Mock sender, receiver and middleware stack with a registered fiat token.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	middleware, ftf, ctx := keeper.BlockIBC()
+	ftf.SetFiatToken(ctx, fiattokenfactorytypes.FiatToken{Denom: "ueurc"})
+	token, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, fiattokenfactorytypes.Paused{})
+	token.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{AddressBz: sender.AddressBz})
+
+	packet := mockPacket(sender.Address, receiverAddress)
+	packet.Data = transfertypes.NewFungibleTokenPacketData(
+		"transfer/channel-0/ueurc", "1000000", sender.Address, receiverAddress, "",
+	).GetBytes()
+
+	// ACT & ASSERT: The minting denom is unaffected by the fiat token's blacklist.
+	ack := middleware.OnRecvPacket(ctx, mockPacket(sender.Address, receiverAddress), nil)
+	require.True(t, ack.Success())
+
+	// ACT & ASSERT: The fiat token enforces its own blacklist.
+	ack = middleware.OnRecvPacket(ctx, packet, nil)
+	require.False(t, ack.Success())
+
+	// ACT & ASSERT: The fiat token enforces its own pause state.
+	token.SetPaused(ctx, fiattokenfactorytypes.NewPaused())
+	packet.Data = transfertypes.NewFungibleTokenPacketData(
+		"ueurc", "1000000", receiverAddress, sender.Address, "",
+	).GetBytes()
+	ack = middleware.OnRecvPacket(ctx, packet, nil)
+	require.False(t, ack.Success())
+}
+
+func TestBlockIBC_SendPacket(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+
+	// ARRANGE: Organize table driven test cases.
+	testCases := map[string]struct {
+		data          []byte
+		toBlacklist   *sample.Account
+		toFreeze      *sample.Account
+		setPaused     bool
+		expectedError error
+	}{
+		"happy path": {
+			data: transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+		},
+		"non ICS-20 packet data": {
+			data:      []byte("custom packet data"),
+			setPaused: true,
+		},
+		"uncontrolled denom": {
+			data:        transfertypes.NewFungibleTokenPacketData("ustake", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			toBlacklist: &sender,
+			setPaused:   true,
+		},
+		"tokenfactory paused": {
+			data:          transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			setPaused:     true,
+			expectedError: fiattokenfactorytypes.ErrPaused,
+		},
+		"blacklisted sender": {
+			data:          transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			toBlacklist:   &sender,
+			expectedError: fiattokenfactorytypes.ErrUnauthorized,
+		},
+		"blacklisted receiver": {
+			data:          transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			toBlacklist:   &receiver,
+			expectedError: fiattokenfactorytypes.ErrUnauthorized,
+		},
+		"frozen sender": {
+			data:          transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			toFreeze:      &sender,
+			expectedError: fiattokenfactorytypes.ErrUnauthorized,
+		},
+		"frozen receiver": {
+			data:     transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+			toFreeze: &receiver,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: Mock middleware stack.
+			middleware, ftf, ctx := keeper.BlockIBC()
+
+			// ARRANGE: Set paused, blacklisted and frozen state based on test case.
+			if tc.setPaused {
+				ftf.SetPaused(ctx, fiattokenfactorytypes.NewPaused(fiattokenfactorytypes.PAUSE_SCOPE_IBC))
+			}
+			if tc.toBlacklist != nil {
+				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{AddressBz: tc.toBlacklist.AddressBz})
+			}
+			if tc.toFreeze != nil {
+				ftf.SetFrozen(ctx, fiattokenfactorytypes.Frozen{AddressBz: tc.toFreeze.AddressBz})
+			}
+
+			// ACT: Send the packet through the middleware.
+			_, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, tc.data)
+
+			// ASSERT: Assert the packet is only rejected for the expected reason.
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlockIBC_AllowedChannels(t *testing.T) {
+	// ARRANGE: Mock sender, receiver and middleware stack allowing only channel-1.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	middleware, ftf, ctx := keeper.BlockIBC()
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes()
+
+	// ACT & ASSERT: Every channel is allowed while the allowlist is disabled.
+	require.True(t, middleware.OnRecvPacket(ctx, mockPacket(sender.Address, receiverAddress), nil).Success())
+	_, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, data)
+	require.NoError(t, err)
+
+	ftf.SetAllowedChannel(ctx, fiattokenfactorytypes.AllowedChannel{PortId: transfertypes.PortID, ChannelId: "channel-1"})
+	ftf.SetChannelAllowlist(ctx, fiattokenfactorytypes.ChannelAllowlist{Enabled: true})
+
+	// ACT & ASSERT: Packets received on a channel that is not allowed are acknowledged with an error.
+	packet := mockPacket(sender.Address, receiverAddress)
+	require.False(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+	packet.DestinationChannel = "channel-1"
+	require.True(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+
+	// ACT & ASSERT: Packets can only be sent over an allowed channel.
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, data)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrChannelNotAllowed)
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-1", clienttypes.Height{}, 1234, data)
+	require.NoError(t, err)
+
+	// ACT & ASSERT: Other denoms are unaffected by the allowlist.
+	ustake := transfertypes.NewFungibleTokenPacketData("ustake", "1000000", sender.Address, receiverAddress, "").GetBytes()
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, ustake)
+	require.NoError(t, err)
+}
+
+func TestBlockIBC_ChannelRateLimits(t *testing.T) {
+	// ARRANGE: Mock sender, receiver and middleware stack limiting channel-0 to 1.5 USDC in either direction.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	recv, ftf, ctx := keeper.BlockIBC()
+	middleware := blockibc.NewIBCMiddleware(&refundRecorder{}, keeper.MockICS4Wrapper{}, ftf)
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.Address, receiverAddress, "").GetBytes()
+	packet := mockPacket(sender.Address, receiverAddress)
+
+	ftf.SetChannelRateLimit(ctx, fiattokenfactorytypes.ChannelRateLimit{
+		PortId:     transfertypes.PortID,
+		ChannelId:  "channel-0",
+		MaxInflow:  math.NewInt(1_500_000),
+		MaxOutflow: math.NewInt(1_500_000),
+		Window:     10,
+		Unit:       fiattokenfactorytypes.WINDOW_UNIT_BLOCKS,
+	})
+
+	// ACT & ASSERT: Packets received beyond the inflow quota are acknowledged with an error.
+	require.True(t, recv.OnRecvPacket(ctx, packet, nil).Success())
+	require.False(t, recv.OnRecvPacket(ctx, packet, nil).Success())
+
+	// ACT & ASSERT: Packets sent beyond the outflow quota are rejected.
+	_, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, data)
+	require.NoError(t, err)
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, data)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrChannelRateLimited)
+
+	// ACT & ASSERT: A timed out packet is returned to the outflow, so it can be sent again.
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+	flow, _, err := ftf.GetChannelFlow(ctx, transfertypes.PortID, "channel-0")
+	require.NoError(t, err)
+	require.True(t, flow.Outflow().IsZero())
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 1234, data)
+	require.NoError(t, err)
+
+	// ACT & ASSERT: A successfully acknowledged packet keeps counting against the outflow.
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(), nil))
+	flow, _, err = ftf.GetChannelFlow(ctx, transfertypes.PortID, "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000_000), flow.Outflow())
+	pendingOutflows, err := ftf.GetAllPendingOutflows(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingOutflows)
+
+	// ACT & ASSERT: Other channels are not limited.
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-1", clienttypes.Height{}, 1234, data)
+	require.NoError(t, err)
+}
+
+func TestBlockIBC_Refunds(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	moduleAddress := authtypes.NewModuleAddress(fiattokenfactorytypes.ModuleName).String()
+
+	// ARRANGE: Organize table driven test cases.
+	testCases := map[string]struct {
+		packet           channeltypes.Packet
+		toBlacklist      *sample.Account
+		toFreeze         *sample.Account
+		pauseScope       fiattokenfactorytypes.PauseScope
+		expectedSender   string
+		expectQuarantine bool
+	}{
+		"happy path": {
+			packet:         mockPacket(sender.Address, receiverAddress),
+			expectedSender: sender.Address,
+		},
+		"uncontrolled denom": {
+			packet: func() channeltypes.Packet {
+				packet := mockPacket(sender.Address, receiverAddress)
+				packet.Data = transfertypes.NewFungibleTokenPacketData(
+					"ustake", "1000000", sender.Address, receiverAddress, "",
+				).GetBytes()
+				return packet
+			}(),
+			toBlacklist:    &sender,
+			expectedSender: sender.Address,
+		},
+		"voucher denom": {
+			packet: func() channeltypes.Packet {
+				packet := mockPacket(sender.Address, receiverAddress)
+				packet.Data = transfertypes.NewFungibleTokenPacketData(
+					"transfer/channel-0/uusdc", "1000000", sender.Address, receiverAddress, "",
+				).GetBytes()
+				return packet
+			}(),
+			toBlacklist:    &sender,
+			expectedSender: sender.Address,
+		},
+		"frozen sender": {
+			packet:         mockPacket(sender.Address, receiverAddress),
+			toFreeze:       &sender,
+			expectedSender: sender.Address,
+		},
+		"blacklisted sender": {
+			packet:           mockPacket(sender.Address, receiverAddress),
+			toBlacklist:      &sender,
+			expectedSender:   moduleAddress,
+			expectQuarantine: true,
+		},
+		"transfers paused": {
+			packet:           mockPacket(sender.Address, receiverAddress),
+			pauseScope:       fiattokenfactorytypes.PAUSE_SCOPE_TRANSFER,
+			expectedSender:   moduleAddress,
+			expectQuarantine: true,
+		},
+		"ibc paused": {
+			packet:         mockPacket(sender.Address, receiverAddress),
+			pauseScope:     fiattokenfactorytypes.PAUSE_SCOPE_IBC,
+			expectedSender: sender.Address,
+		},
+	}
+
+	errorAck := channeltypes.NewErrorAcknowledgement(fiattokenfactorytypes.ErrUnauthorized).Acknowledgement()
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: Mock middleware stack recording the packets passed to the underlying application.
+			_, ftf, ctx := keeper.BlockIBC()
+			app := &refundRecorder{}
+			middleware := blockibc.NewIBCMiddleware(app, keeper.MockICS4Wrapper{}, ftf)
+
+			// ARRANGE: Set paused, blacklisted and frozen state based on test case.
+			if tc.pauseScope != fiattokenfactorytypes.PAUSE_SCOPE_UNSPECIFIED {
+				ftf.SetPaused(ctx, fiattokenfactorytypes.NewPaused(tc.pauseScope))
+			}
+			if tc.toBlacklist != nil {
+				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{AddressBz: tc.toBlacklist.AddressBz})
+			}
+			if tc.toFreeze != nil {
+				ftf.SetFrozen(ctx, fiattokenfactorytypes.Frozen{AddressBz: tc.toFreeze.AddressBz})
+			}
+
+			// ACT: Acknowledge the packet with an error and time it out.
+			require.NoError(t, middleware.OnAcknowledgementPacket(ctx, tc.packet, errorAck, nil))
+			require.NoError(t, middleware.OnTimeoutPacket(ctx, tc.packet, nil))
+
+			// ASSERT: The refund is sent to the expected recipient in both cases.
+			require.Len(t, app.packets, 2)
+			for _, packet := range app.packets {
+				var data transfertypes.FungibleTokenPacketData
+				require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+				require.Equal(t, tc.expectedSender, data.Sender)
+			}
+
+			// ASSERT: A quarantine event is emitted for every quarantined refund.
+			var quarantined int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == "circle.fiattokenfactory.v1.RefundQuarantined" {
+					quarantined++
+				}
+			}
+			// ASSERT: Both quarantined refunds are recorded for the sender.
+			refund, found, err := ftf.GetQuarantinedRefund(ctx, sender.Address)
+			require.NoError(t, err)
+			if tc.expectQuarantine {
+				require.Equal(t, 2, quarantined)
+				require.True(t, found)
+				require.Equal(t, math.NewInt(2_000_000), refund.Amount)
+			} else {
+				require.Zero(t, quarantined)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestBlockIBC_SuccessfulAckNotQuarantined(t *testing.T) {
+	// ARRANGE: Mock middleware stack and blacklist the sender.
+	sender := sample.TestAccount()
+	_, ftf, ctx := keeper.BlockIBC()
+	app := &refundRecorder{}
+	middleware := blockibc.NewIBCMiddleware(app, keeper.MockICS4Wrapper{}, ftf)
+	ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{AddressBz: sender.AddressBz})
+	packet := mockPacket(sender.Address, sample.AccAddress())
+
+	// ACT: Acknowledge the packet successfully.
+	err := middleware.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(), nil)
+
+	// ASSERT: Nothing is refunded, so the packet is passed through unchanged.
+	require.NoError(t, err)
+	require.Equal(t, []channeltypes.Packet{packet}, app.packets)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+// refundRecorder is an underlying application recording the packets of acknowledgements and timeouts.
+type refundRecorder struct {
+	porttypes.IBCModule
+	packets []channeltypes.Packet
+}
+
+func (r *refundRecorder) OnAcknowledgementPacket(_ sdk.Context, packet channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	r.packets = append(r.packets, packet)
+	return nil
+}
+
+func (r *refundRecorder) OnTimeoutPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	r.packets = append(r.packets, packet)
+	return nil
+}
+
 func mockPacket(sender, receiver string) channeltypes.Packet {
 	return channeltypes.NewPacket(
 		transfertypes.NewFungibleTokenPacketData(
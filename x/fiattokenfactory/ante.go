@@ -25,11 +25,46 @@ import (
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
+// MaxAuthzDepth is the maximum number of authz.MsgExec the ante decorators descend through. Transactions
+// nesting messages any deeper are rejected, since their inner messages would otherwise go unchecked.
+const MaxAuthzDepth = 5
+
+// walkMessages calls fn for every message in msgs, descending into the messages of every authz.MsgExec
+// at any position, up to MaxAuthzDepth levels below depth.
+func walkMessages(msgs []sdk.Msg, depth int, fn func(msg sdk.Msg) error) error {
+	for _, msg := range msgs {
+		if err := fn(msg); err != nil {
+			return err
+		}
+
+		execMsg, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+
+		if depth >= MaxAuthzDepth {
+			return sdkerrors.Wrapf(fiattokenfactorytypes.ErrInvalidNesting, "authz messages can not be nested more than %d levels deep", MaxAuthzDepth)
+		}
+
+		nestedMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		if err := walkMessages(nestedMsgs, depth+1, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type IsPausedDecorator struct {
 	cdc              codec.Codec
 	fiatTokenFactory *fiattokenfactorykeeper.Keeper
@@ -53,17 +88,10 @@ func (ad IsPausedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 	return next(ctx, tx, simulate)
 }
 
+// CheckMessages checks every message of a transaction, including those nested in authz.MsgExec, against
+// the paused state of the fiat tokens they move.
 func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			nestedMsgs, err := execMsg.GetMessages()
-			if err != nil {
-				return err
-			}
-
-			return ad.CheckMessages(ctx, nestedMsgs)
-		}
-
+	return walkMessages(msgs, 0, func(msg sdk.Msg) error {
 		switch m := msg.(type) {
 		case *authz.MsgGrant:
 			var authorization authz.Authorization
@@ -72,31 +100,108 @@ func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error
 				return err
 			}
 
-			if grant, ok := authorization.(*banktypes.SendAuthorization); ok {
-				for _, coin := range grant.SpendLimit {
-					paused, err := checkPausedStatebyTokenFactory(ctx, coin, ad.fiatTokenFactory)
-					if paused {
-						return sdkerrors.Wrapf(err, "can not perform token authorizations")
+			for _, transfer := range authorizedTransfers(authorization) {
+				tokens, err := transfer.tokens(ctx, ad.fiatTokenFactory)
+				if err != nil {
+					return err
+				}
+
+				for _, token := range tokens {
+					paused, err := token.GetPaused(ctx)
+					if err != nil {
+						return err
+					}
+					if paused.IsPaused(transfer.scope) {
+						return sdkerrors.Wrapf(fiattokenfactorytypes.ErrPaused, "can not perform token authorizations")
 					}
 				}
 			}
-		default:
-			continue
+		case *transfertypes.MsgTransfer:
+			paused, err := checkPausedStatebyTokenFactory(ctx, m.Token, fiattokenfactorytypes.PAUSE_SCOPE_IBC, ad.fiatTokenFactory)
+			if err != nil {
+				return err
+			}
+			if paused {
+				return sdkerrors.Wrapf(fiattokenfactorytypes.ErrPaused, "can not perform ibc transfers")
+			}
+		}
+
+		return nil
+	})
+}
+
+// authorizedTransfer describes the tokens an authorization lets a grantee move on behalf of its granter.
+type authorizedTransfer struct {
+	// anyDenom is set if the authorization is not limited to denoms
+	anyDenom bool
+	denoms   []string
+	// scope is the pause scope governing the moves
+	scope fiattokenfactorytypes.PauseScope
+}
+
+// authorizedTransfers returns the token moves an authorization grants. Bank sends are governed by the transfer
+// scope and IBC transfers by the IBC scope. Authorizations that can not move tokens return nothing.
+func authorizedTransfers(authorization authz.Authorization) []authorizedTransfer {
+	switch a := authorization.(type) {
+	case *banktypes.SendAuthorization:
+		return []authorizedTransfer{{denoms: coinDenoms(a.SpendLimit), scope: fiattokenfactorytypes.PAUSE_SCOPE_TRANSFER}}
+	case *transfertypes.TransferAuthorization:
+		var denoms []string
+		for _, allocation := range a.Allocations {
+			denoms = append(denoms, coinDenoms(allocation.SpendLimit)...)
+		}
+		return []authorizedTransfer{{denoms: denoms, scope: fiattokenfactorytypes.PAUSE_SCOPE_IBC}}
+	case *authz.GenericAuthorization:
+		switch a.Msg {
+		case sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+			return []authorizedTransfer{{anyDenom: true, scope: fiattokenfactorytypes.PAUSE_SCOPE_TRANSFER}}
+		case sdk.MsgTypeURL(&transfertypes.MsgTransfer{}):
+			return []authorizedTransfer{{anyDenom: true, scope: fiattokenfactorytypes.PAUSE_SCOPE_IBC}}
 		}
 	}
 
 	return nil
 }
 
-func checkPausedStatebyTokenFactory(ctx sdk.Context, c sdk.Coin, ctf *fiattokenfactorykeeper.Keeper) (bool, *sdkerrors.Error) {
-	ctfMintingDenom := ctf.GetMintingDenom(ctx)
-	if c.Denom == ctfMintingDenom.Denom {
-		paused := ctf.GetPaused(ctx)
-		if paused.Paused {
-			return true, fiattokenfactorytypes.ErrPaused
+// tokens returns the keepers of the fiat tokens managed by ctf that the transfer can move.
+func (t authorizedTransfer) tokens(ctx sdk.Context, ctf *fiattokenfactorykeeper.Keeper) ([]*fiattokenfactorykeeper.Keeper, error) {
+	if t.anyDenom {
+		return ctf.ManagedTokens(ctx)
+	}
+
+	var tokens []*fiattokenfactorykeeper.Keeper
+	for _, denom := range t.denoms {
+		token, found, err := ctf.ManagedDenom(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			tokens = append(tokens, token)
 		}
 	}
-	return false, nil
+
+	return tokens, nil
+}
+
+func coinDenoms(coins sdk.Coins) []string {
+	denoms := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		denoms = append(denoms, coin.Denom)
+	}
+
+	return denoms
+}
+
+func checkPausedStatebyTokenFactory(ctx sdk.Context, c sdk.Coin, scope fiattokenfactorytypes.PauseScope, ctf *fiattokenfactorykeeper.Keeper) (bool, error) {
+	token, found, err := ctf.ManagedDenom(ctx, c.Denom)
+	if err != nil || !found {
+		return false, err
+	}
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return false, err
+	}
+	return paused.IsPaused(scope), nil
 }
 
 type IsBlacklistedDecorator struct {
@@ -112,7 +217,7 @@ func NewIsBlacklistedDecorator(ctf *fiattokenfactorykeeper.Keeper) IsBlacklisted
 func (ad IsBlacklistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	msgs := tx.GetMsgs()
 
-	err = ad.CheckMessages(ctx, msgs, nil)
+	err = ad.CheckMessages(ctx, msgs)
 	if err != nil {
 		return ctx, err
 	}
@@ -120,41 +225,82 @@ func (ad IsBlacklistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ad.AddGranteeToContextIfPresent(ctx, msgs), tx, simulate)
 }
 
+// AddGranteeToContextIfPresent stores the grantees of every authz.MsgExec in the transaction, at any
+// depth, in the context so that the send restrictions can check them. See keeper.SendRestrictionFn.
 func (ad IsBlacklistedDecorator) AddGranteeToContextIfPresent(ctx sdk.Context, msgs []sdk.Msg) sdk.Context {
 	var grantees []string
-	for _, msg := range msgs {
+	// messages nested beyond MaxAuthzDepth are rejected by CheckMessages, so the error can be ignored here
+	_ = walkMessages(msgs, 0, func(msg sdk.Msg) error {
 		if execMsg, ok := msg.(*authz.MsgExec); ok {
 			grantees = append(grantees, execMsg.Grantee)
 		}
-	}
+		return nil
+	})
 	if len(grantees) > 0 {
 		return ctx.WithValue(types.GranteeKey, grantees)
 	}
 	return ctx
 }
 
-func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg, grantee *string) error {
-	for _, msg := range msgs {
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			nestedMsgs, err := execMsg.GetMessages()
+// CheckMessages checks every message of a transaction, including those nested in authz.MsgExec, against
+// the blacklisted and frozen addresses of the fiat tokens they move.
+func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	return walkMessages(msgs, 0, func(msg sdk.Msg) error {
+		switch m := msg.(type) {
+		case *authz.MsgGrant:
+			authorization, err := m.Grant.GetAuthorization()
 			if err != nil {
 				return err
 			}
 
-			return ad.CheckMessages(ctx, nestedMsgs, &execMsg.Grantee)
-		}
+			for _, transfer := range authorizedTransfers(authorization) {
+				tokens, err := transfer.tokens(ctx, ad.fiattokenfactory)
+				if err != nil {
+					return err
+				}
 
-		switch m := msg.(type) {
+				for _, token := range tokens {
+					if err := checkGrantParties(ctx, token, m.Granter, m.Grantee); err != nil {
+						return err
+					}
+				}
+			}
 		case *transfertypes.MsgTransfer:
 			// since the Transfer receiver is not on Noble, it is not checked by send restrictions and needs to be checked here
 			err := checkForBlacklistedAddressByTokenFactory(ctx, m.Receiver, m.Token, ad.fiattokenfactory)
 			if errors.Is(err, fiattokenfactorytypes.ErrUnauthorized) {
 				return sdkerrors.Wrapf(err, "an address (%s) is blacklisted and can not receive tokens", m.Receiver)
 			} else if err != nil {
-				return sdkerrors.Wrapf(err, "error decoding address (%s)", m.Receiver)
+				return err
+			}
+
+			err = checkForFrozenAddressByTokenFactory(ctx, m.Sender, m.Token, ad.fiattokenfactory)
+			if errors.Is(err, fiattokenfactorytypes.ErrUnauthorized) {
+				return sdkerrors.Wrapf(err, "an address (%s) is frozen and can not send tokens", m.Sender)
+			} else if err != nil {
+				return err
 			}
-		default:
-			continue
+		}
+
+		return nil
+	})
+}
+
+// checkGrantParties rejects authorizations to move a fiat token that are granted by or to an address
+// blacklisted by that token.
+func checkGrantParties(ctx sdk.Context, token *fiattokenfactorykeeper.Keeper, granter, grantee string) error {
+	for _, address := range []string{granter, grantee} {
+		_, addressBz, err := fiattokenfactorykeeper.DecodeNoLimitToBase256(address)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "error decoding address (%s)", address)
+		}
+
+		_, found, err := token.GetBlacklisted(ctx, addressBz)
+		if err != nil {
+			return err
+		}
+		if found {
+			return sdkerrors.Wrapf(fiattokenfactorytypes.ErrUnauthorized, "an address (%s) is blacklisted and can not be party to token authorizations", address)
 		}
 	}
 
@@ -164,16 +310,114 @@ func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg,
 // checkForBlacklistedAddressByTokenFactory first checks if the denom being transacted is a mintable asset from a TokenFactory,
 // if it is, it checks if the address involved in the tx is blacklisted by that specific TokenFactory.
 func checkForBlacklistedAddressByTokenFactory(ctx sdk.Context, address string, c sdk.Coin, ctf *fiattokenfactorykeeper.Keeper) error {
-	ctfMintingDenom := ctf.GetMintingDenom(ctx)
-	if c.Denom == ctfMintingDenom.Denom {
-		_, addressBz, err := fiattokenfactorykeeper.DecodeNoLimitToBase256(address)
+	token, found, err := ctf.ManagedDenom(ctx, c.Denom)
+	if err != nil || !found {
+		return err
+	}
+	_, addressBz, err := fiattokenfactorykeeper.DecodeNoLimitToBase256(address)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "error decoding address (%s)", address)
+	}
+	_, found, err = token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fiattokenfactorytypes.ErrUnauthorized
+	}
+	return nil
+}
+
+// checkForFrozenAddressByTokenFactory first checks if the denom being transacted is a mintable asset from a TokenFactory,
+// if it is, it checks if the address involved in the tx is frozen by that specific TokenFactory.
+func checkForFrozenAddressByTokenFactory(ctx sdk.Context, address string, c sdk.Coin, ctf *fiattokenfactorykeeper.Keeper) error {
+	token, found, err := ctf.ManagedDenom(ctx, c.Denom)
+	if err != nil || !found {
+		return err
+	}
+	_, addressBz, err := fiattokenfactorykeeper.DecodeNoLimitToBase256(address)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "error decoding address (%s)", address)
+	}
+	_, found, err = token.GetFrozen(ctx, addressBz)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fiattokenfactorytypes.ErrUnauthorized
+	}
+	return nil
+}
+
+// FeeDecorator checks the fees of a transaction against the fiat tokens managed by the module. Fees are
+// deducted after the message checks, so a transaction paying fees in a fiat token is rejected up front if
+// the token is paused, or if its fee payer or fee granter is blacklisted or frozen. It must run before
+// ante.DeductFeeDecorator.
+type FeeDecorator struct {
+	fiatTokenFactory *fiattokenfactorykeeper.Keeper
+}
+
+func NewFeeDecorator(ftf *fiattokenfactorykeeper.Keeper) FeeDecorator {
+	return FeeDecorator{
+		fiatTokenFactory: ftf,
+	}
+}
+
+func (ad FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	err = ad.CheckFee(ctx, feeTx.GetFee(), feeTx.FeePayer(), feeTx.FeeGranter())
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckFee checks a fee paid by payer, or by granter if it is set, against the fiat tokens the fee consists of.
+func (ad FeeDecorator) CheckFee(ctx sdk.Context, fee sdk.Coins, payer, granter sdk.AccAddress) error {
+	for _, coin := range fee {
+		token, found, err := ad.fiatTokenFactory.ManagedDenom(ctx, coin.Denom)
 		if err != nil {
 			return err
 		}
-		_, found := ctf.GetBlacklisted(ctx, addressBz)
-		if found {
-			return fiattokenfactorytypes.ErrUnauthorized
+		if !found {
+			continue
+		}
+
+		paused, err := token.GetPaused(ctx)
+		if err != nil {
+			return err
+		}
+		if paused.IsPaused(fiattokenfactorytypes.PAUSE_SCOPE_TRANSFER) {
+			return sdkerrors.Wrapf(fiattokenfactorytypes.ErrPaused, "can not pay fees in %s", coin.Denom)
+		}
+
+		for _, address := range []sdk.AccAddress{payer, granter} {
+			if address.Empty() {
+				continue
+			}
+
+			_, found, err := token.GetBlacklisted(ctx, address)
+			if err != nil {
+				return err
+			}
+			if found {
+				return sdkerrors.Wrapf(fiattokenfactorytypes.ErrUnauthorized, "an address (%s) is blacklisted and can not pay fees", address)
+			}
+
+			_, found, err = token.GetFrozen(ctx, address)
+			if err != nil {
+				return err
+			}
+			if found {
+				return sdkerrors.Wrapf(fiattokenfactorytypes.ErrUnauthorized, "an address (%s) is frozen and can not pay fees", address)
+			}
 		}
 	}
+
 	return nil
 }
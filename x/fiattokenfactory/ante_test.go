@@ -17,6 +17,7 @@
 package fiattokenfactory_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
+	fiattokenfactorykeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -77,6 +79,40 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 				return msg
 			}(),
 		},
+		"msgGrant uncontrolled denom": {
+			expectedFailOnPause: false,
+			message:             newMsgGrant(t, "mock", "mock", banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("ustake", 1)), nil)),
+		},
+		"msgGrant generic msgSend": {
+			expectedFailOnPause: true,
+			message:             newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))),
+		},
+		"msgGrant generic msgMultiSend": {
+			expectedFailOnPause: true,
+			message:             newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))),
+		},
+		"msgGrant generic msgTransfer": {
+			expectedFailOnPause: true,
+			message:             newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&transfertypes.MsgTransfer{}))),
+		},
+		"msgGrant generic irrelevant msg": {
+			expectedFailOnPause: false,
+			message:             newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&testdata.MsgCreateDog{}))),
+		},
+		"msgGrant transferAuthorization": {
+			expectedFailOnPause: true,
+			message:             newMsgGrant(t, "mock", "mock", newTransferAuthorization("ustake", "uusdc")),
+		},
+		"msgGrant transferAuthorization uncontrolled denom": {
+			expectedFailOnPause: false,
+			message:             newMsgGrant(t, "mock", "mock", newTransferAuthorization("ustake")),
+		},
+		"msgExec msgGrant": {
+			expectedFailOnPause: true,
+			message: newMsgExec(t, testAccount1.Address,
+				newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))),
+			),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -85,7 +121,7 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isPaused decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{})
 			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 			ad := fiattokenfactory.NewIsPausedDecorator(cdc, ftf)
 
@@ -105,7 +141,7 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 			require.NoError(t, err)
 
 			// ARRANGE: Pause tokenfactory
-			ftf.SetPaused(ctx, types.Paused{Paused: true})
+			ftf.SetPaused(ctx, types.NewPaused())
 
 			// ACT: Run transaction through ante handler while chain IS paused.
 			_, err = ad.AnteHandle(ctx, tx, true, mockNext)
@@ -120,6 +156,47 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerIsPaused_FiatToken(t *testing.T) {
+	// ARRANGE: setup tokenfactory with a registered fiat token and isPaused decorator
+	ftf, ctx := keeper.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.Paused{})
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	token, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, types.NewPaused())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ad := fiattokenfactory.NewIsPausedDecorator(cdc, ftf)
+
+	newGrantTx := func(denom string) sdk.Tx {
+		mockTime := time.Date(1, 1, 1, 1, 1, 1, 1, time.UTC)
+		mockExpires := mockTime.Add(time.Hour)
+		sendAuthz := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))), nil)
+		sendGrant, err := authz.NewGrant(mockTime, sendAuthz, &mockExpires)
+		require.NoError(t, err)
+
+		builder, err := newMockTxBuilder(cdc)
+		require.NoError(t, err)
+		require.NoError(t, builder.SetMsgs(&authz.MsgGrant{Granter: "mock", Grantee: "mock", Grant: sendGrant}))
+		return builder.GetTx()
+	}
+
+	// ACT & ASSERT: Grants of the minting denom are allowed, as it is not paused.
+	_, err = ad.AnteHandle(ctx, newGrantTx("uusdc"), true, mockNext)
+	require.NoError(t, err)
+
+	// ACT & ASSERT: Grants of the paused fiat token are rejected.
+	_, err = ad.AnteHandle(ctx, newGrantTx("ueurc"), true, mockNext)
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ACT & ASSERT: Generic send grants are rejected, as they could move the paused fiat token.
+	builder, err := newMockTxBuilder(cdc)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetMsgs(newMsgGrant(t, "mock", "mock", authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})))))
+	_, err = ad.AnteHandle(ctx, builder.GetTx(), true, mockNext)
+	require.ErrorIs(t, err, types.ErrPaused)
+}
+
 func TestAnteHandlerIsBlacklisted(t *testing.T) {
 	// ARRANGE: Arrange table driven test cases
 	testCases := map[string]struct {
@@ -190,7 +267,7 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isBlacklisted decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{})
 			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
 
@@ -230,6 +307,209 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerIsBlacklisted_Grants(t *testing.T) {
+	sendAuthorization := banktypes.NewSendAuthorization(uusdcCoins, nil)
+	genericSend := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	genericTransfer := authz.NewGenericAuthorization(sdk.MsgTypeURL(&transfertypes.MsgTransfer{}))
+
+	// ARRANGE: Arrange table driven test cases, where testAccount2 is blacklisted.
+	testCases := map[string]struct {
+		message       sdk.Msg
+		expectedError error
+	}{
+		"sendAuthorization from blacklisted granter": {
+			message:       newMsgGrant(t, testAccount2.Address, testAccount1.Address, sendAuthorization),
+			expectedError: types.ErrUnauthorized,
+		},
+		"sendAuthorization to blacklisted grantee": {
+			message:       newMsgGrant(t, testAccount1.Address, testAccount2.Address, sendAuthorization),
+			expectedError: types.ErrUnauthorized,
+		},
+		"sendAuthorization uncontrolled denom": {
+			message: newMsgGrant(t, testAccount2.Address, testAccount1.Address, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("ustake", 1)), nil)),
+		},
+		"sendAuthorization between allowed accounts": {
+			message: newMsgGrant(t, testAccount1.Address, sample.AccAddress(), sendAuthorization),
+		},
+		"generic msgSend to blacklisted grantee": {
+			message:       newMsgGrant(t, testAccount1.Address, testAccount2.Address, genericSend),
+			expectedError: types.ErrUnauthorized,
+		},
+		"generic msgTransfer from blacklisted granter": {
+			message:       newMsgGrant(t, testAccount2.Address, testAccount1.Address, genericTransfer),
+			expectedError: types.ErrUnauthorized,
+		},
+		"generic irrelevant msg from blacklisted granter": {
+			message: newMsgGrant(t, testAccount2.Address, testAccount1.Address, authz.NewGenericAuthorization(sdk.MsgTypeURL(&testdata.MsgCreateDog{}))),
+		},
+		"transferAuthorization to blacklisted grantee": {
+			message:       newMsgGrant(t, testAccount1.Address, testAccount2.Address, newTransferAuthorization("uusdc")),
+			expectedError: types.ErrUnauthorized,
+		},
+		"transferAuthorization uncontrolled denom": {
+			message: newMsgGrant(t, testAccount1.Address, testAccount2.Address, newTransferAuthorization("ustake")),
+		},
+		"msgExec msgGrant to blacklisted grantee": {
+			message:       newMsgExec(t, sample.AccAddress(), newMsgGrant(t, testAccount1.Address, testAccount2.Address, genericSend)),
+			expectedError: types.ErrUnauthorized,
+		},
+		"invalid grantee": {
+			message:       newMsgGrant(t, testAccount1.Address, "invalid address", genericSend),
+			expectedError: bech32.ErrInvalidCharacter(32),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: setup tokenfactory and isBlacklisted decorator with a blacklisted account
+			ftf, ctx := keeper.FiatTokenfactoryKeeper()
+			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+			ftf.SetPaused(ctx, types.Paused{})
+			ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: testAccount2.AddressBz})
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
+
+			// ARRANGE: Build transaction with specific test case message
+			builder, err := newMockTxBuilder(cdc)
+			require.NoError(t, err)
+			require.NoError(t, builder.SetMsgs(tc.message))
+
+			// ACT: Run transaction through ante handler
+			_, err = ad.AnteHandle(ctx, builder.GetTx(), true, mockNext)
+
+			// ASSERT: Grants involving a blacklisted account are rejected
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAnteHandlerIsFrozen(t *testing.T) {
+	// ARRANGE: setup tokenfactory and isBlacklisted decorator with a frozen account
+	ftf, ctx := keeper.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.Paused{})
+	ftf.SetFrozen(ctx, types.Frozen{AddressBz: testAccount1.AddressBz})
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
+
+	for name, tc := range map[string]struct {
+		sender        string
+		receiver      string
+		expectedError error
+	}{
+		"frozen sender":   {sender: testAccount1.Address, receiver: testAccount2.Address, expectedError: types.ErrUnauthorized},
+		"frozen receiver": {sender: testAccount2.Address, receiver: testAccount1.Address},
+		"invalid sender":  {sender: "invalid address", receiver: testAccount2.Address, expectedError: bech32.ErrInvalidCharacter(32)},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: Build transaction with an IBC transfer
+			builder, err := newMockTxBuilder(cdc)
+			require.NoError(t, err)
+			err = builder.SetMsgs(&transfertypes.MsgTransfer{
+				Sender:   tc.sender,
+				Receiver: tc.receiver,
+				Token:    uusdcCoin,
+			})
+			require.NoError(t, err)
+
+			// ACT: Run transaction through ante handler
+			_, err = ad.AnteHandle(ctx, builder.GetTx(), true, mockNext)
+
+			// ASSERT: A frozen account can receive, but not send
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeDecorator(t *testing.T) {
+	// ARRANGE: Arrange table driven test cases, where testAccount2 is blacklisted and TestAccountBech32m is frozen.
+	testCases := map[string]struct {
+		fee           sdk.Coins
+		payer         sdk.AccAddress
+		granter       sdk.AccAddress
+		paused        bool
+		expectedError error
+	}{
+		"no fee": {
+			payer: testAccount2.AddressBz,
+		},
+		"allowed payer": {
+			fee:   uusdcCoins,
+			payer: testAccount1.AddressBz,
+		},
+		"blacklisted payer": {
+			fee:           uusdcCoins,
+			payer:         testAccount2.AddressBz,
+			expectedError: types.ErrUnauthorized,
+		},
+		"frozen payer": {
+			fee:           uusdcCoins,
+			payer:         TestAccountBech32m.AddressBz,
+			expectedError: types.ErrUnauthorized,
+		},
+		"blacklisted granter": {
+			fee:           uusdcCoins,
+			payer:         testAccount1.AddressBz,
+			granter:       testAccount2.AddressBz,
+			expectedError: types.ErrUnauthorized,
+		},
+		"blacklisted payer with uncontrolled denom": {
+			fee:   sdk.NewCoins(sdk.NewInt64Coin("ustake", 10)),
+			payer: testAccount2.AddressBz,
+		},
+		"blacklisted payer with mixed denoms": {
+			fee:           sdk.NewCoins(sdk.NewInt64Coin("ustake", 10), uusdcCoin),
+			payer:         testAccount2.AddressBz,
+			expectedError: types.ErrUnauthorized,
+		},
+		"paused": {
+			fee:           uusdcCoins,
+			payer:         testAccount1.AddressBz,
+			paused:        true,
+			expectedError: types.ErrPaused,
+		},
+		"paused with uncontrolled denom": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("ustake", 10)),
+			payer:  testAccount1.AddressBz,
+			paused: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: setup tokenfactory and fee decorator
+			ftf, ctx := keeper.FiatTokenfactoryKeeper()
+			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+			ftf.SetPaused(ctx, types.Paused{Transfer: tc.paused})
+			ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: testAccount2.AddressBz})
+			ftf.SetFrozen(ctx, types.Frozen{AddressBz: TestAccountBech32m.AddressBz})
+			ad := fiattokenfactory.NewFeeDecorator(ftf)
+
+			// ACT: Run transaction paying the fee of the test case through ante handler
+			tx := mockFeeTx{fee: tc.fee, payer: tc.payer, granter: tc.granter}
+			_, err := ad.AnteHandle(ctx, tx, true, mockNext)
+
+			// ASSERT: Assert the expected error for the fee payer, granter and paused state
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAddGranteeToContextIfPresent(t *testing.T) {
 	// ARRANGE: Arrange table driven test cases
 	testCases := map[string]struct {
@@ -277,7 +557,7 @@ func TestAddGranteeToContextIfPresent(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isBlacklisted decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{})
 			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
 
 			// ACT: Run transaction through ante handler without blacklisting
@@ -295,6 +575,163 @@ func TestAddGranteeToContextIfPresent(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerNestedAuthz(t *testing.T) {
+	// ARRANGE: Arrange the transactions, each containing the same IBC transfer at a different position or depth.
+	transfer := &transfertypes.MsgTransfer{
+		Sender:   testAccount1.Address,
+		Receiver: testAccount2.Address,
+		Token:    uusdcCoin,
+	}
+	dog := &testdata.MsgCreateDog{}
+	grantee1, grantee2, grantee3 := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	shapes := map[string]struct {
+		messages []sdk.Msg
+		// whether the transfer is reached, and so rejected once the uusdc token is restricted
+		expectChecked    bool
+		expectedError    error
+		expectedGrantees []string
+	}{
+		"top level": {
+			messages:      []sdk.Msg{transfer},
+			expectChecked: true,
+		},
+		"after msgExec": {
+			messages:         []sdk.Msg{newMsgExec(t, grantee1, dog), transfer},
+			expectChecked:    true,
+			expectedGrantees: []string{grantee1},
+		},
+		"in sibling msgExec": {
+			messages:         []sdk.Msg{newMsgExec(t, grantee1, dog), newMsgExec(t, grantee2, dog, transfer)},
+			expectChecked:    true,
+			expectedGrantees: []string{grantee1, grantee2},
+		},
+		"after nested msgExec": {
+			messages:         []sdk.Msg{newMsgExec(t, grantee1, newMsgExec(t, grantee2, dog), transfer)},
+			expectChecked:    true,
+			expectedGrantees: []string{grantee1, grantee2},
+		},
+		"nested msgExec chain": {
+			messages:         []sdk.Msg{newMsgExec(t, grantee1, newMsgExec(t, grantee2, newMsgExec(t, grantee3, transfer)))},
+			expectChecked:    true,
+			expectedGrantees: []string{grantee1, grantee2, grantee3},
+		},
+		"at max depth": {
+			messages:      []sdk.Msg{nestMsgExec(t, fiattokenfactory.MaxAuthzDepth, transfer)},
+			expectChecked: true,
+			expectedGrantees: []string{
+				testAccount1.Address, testAccount1.Address, testAccount1.Address, testAccount1.Address, testAccount1.Address,
+			},
+		},
+		"beyond max depth": {
+			messages:      []sdk.Msg{nestMsgExec(t, fiattokenfactory.MaxAuthzDepth+1, dog)},
+			expectedError: types.ErrInvalidNesting,
+		},
+		"beyond max depth after msgExec": {
+			messages:      []sdk.Msg{newMsgExec(t, grantee1, dog), nestMsgExec(t, fiattokenfactory.MaxAuthzDepth+1, dog)},
+			expectedError: types.ErrInvalidNesting,
+		},
+		"unrestricted messages only": {
+			messages:         []sdk.Msg{newMsgExec(t, grantee1, dog), dog},
+			expectedGrantees: []string{grantee1},
+		},
+	}
+
+	decorators := map[string]struct {
+		newDecorator  func(cdc codec.Codec, ftf *fiattokenfactorykeeper.Keeper) sdk.AnteDecorator
+		restrict      func(ctx sdk.Context, ftf *fiattokenfactorykeeper.Keeper)
+		expectedError error
+	}{
+		"paused": {
+			newDecorator: func(cdc codec.Codec, ftf *fiattokenfactorykeeper.Keeper) sdk.AnteDecorator {
+				return fiattokenfactory.NewIsPausedDecorator(cdc, ftf)
+			},
+			restrict: func(ctx sdk.Context, ftf *fiattokenfactorykeeper.Keeper) {
+				ftf.SetPaused(ctx, types.NewPaused())
+			},
+			expectedError: types.ErrPaused,
+		},
+		"blacklisted receiver": {
+			newDecorator: func(_ codec.Codec, ftf *fiattokenfactorykeeper.Keeper) sdk.AnteDecorator {
+				return fiattokenfactory.NewIsBlacklistedDecorator(ftf)
+			},
+			restrict: func(ctx sdk.Context, ftf *fiattokenfactorykeeper.Keeper) {
+				ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: testAccount2.AddressBz})
+			},
+			expectedError: types.ErrUnauthorized,
+		},
+		"frozen sender": {
+			newDecorator: func(_ codec.Codec, ftf *fiattokenfactorykeeper.Keeper) sdk.AnteDecorator {
+				return fiattokenfactory.NewIsBlacklistedDecorator(ftf)
+			},
+			restrict: func(ctx sdk.Context, ftf *fiattokenfactorykeeper.Keeper) {
+				ftf.SetFrozen(ctx, types.Frozen{AddressBz: testAccount1.AddressBz})
+			},
+			expectedError: types.ErrUnauthorized,
+		},
+	}
+
+	for shapeName, shape := range shapes {
+		for decoratorName, decorator := range decorators {
+			shape, decorator := shape, decorator
+			t.Run(shapeName+"/"+decoratorName, func(t *testing.T) {
+				// ARRANGE: setup tokenfactory and decorator
+				ftf, ctx := keeper.FiatTokenfactoryKeeper()
+				ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+				ftf.SetPaused(ctx, types.Paused{})
+				cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+				ad := decorator.newDecorator(cdc, ftf)
+
+				// ARRANGE: Build transaction with the messages of the test case
+				builder, err := newMockTxBuilder(cdc)
+				require.NoError(t, err)
+				require.NoError(t, builder.SetMsgs(shape.messages...))
+				tx := builder.GetTx()
+
+				// ACT & ASSERT: Only the nesting is rejected while the token is not restricted.
+				_, err = ad.AnteHandle(ctx, tx, true, mockNext)
+				if shape.expectedError != nil {
+					require.ErrorIs(t, err, shape.expectedError)
+				} else {
+					require.NoError(t, err)
+				}
+
+				// ACT & ASSERT: The transfer is rejected wherever it is in the transaction.
+				decorator.restrict(ctx, ftf)
+				_, err = ad.AnteHandle(ctx, tx, true, mockNext)
+				switch {
+				case shape.expectedError != nil:
+					require.ErrorIs(t, err, shape.expectedError)
+				case shape.expectChecked:
+					require.ErrorIs(t, err, decorator.expectedError)
+				default:
+					require.NoError(t, err)
+				}
+			})
+		}
+
+		shape := shape
+		t.Run(shapeName+"/grantees", func(t *testing.T) {
+			// ARRANGE: setup tokenfactory and isBlacklisted decorator
+			ftf, ctx := keeper.FiatTokenfactoryKeeper()
+			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
+
+			// ACT: Collect the grantees of the messages
+			grantees := ad.AddGranteeToContextIfPresent(ctx, shape.messages).Value(types.GranteeKey)
+
+			// ASSERT: The grantees of every msgExec are collected, whatever their depth. Transactions nested
+			// too deeply are rejected before their grantees are used.
+			switch {
+			case shape.expectedError != nil:
+			case shape.expectedGrantees == nil:
+				require.Nil(t, grantees)
+			default:
+				require.ElementsMatch(t, shape.expectedGrantees, grantees)
+			}
+		})
+	}
+}
+
 func constructMsgExec(t *testing.T, granteeAddress string) sdk.Msg {
 	mgsSend := &banktypes.MsgSend{
 		FromAddress: testAccount2.Address,
@@ -310,6 +747,55 @@ func constructMsgExec(t *testing.T, granteeAddress string) sdk.Msg {
 	return msg
 }
 
+func newMsgExec(t *testing.T, grantee string, msgs ...sdk.Msg) *authz.MsgExec {
+	anys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys = append(anys, msgAny)
+	}
+	return &authz.MsgExec{Grantee: grantee, Msgs: anys}
+}
+
+func newMsgGrant(t *testing.T, granter, grantee string, authorization authz.Authorization) *authz.MsgGrant {
+	expiration := time.Date(1, 1, 1, 2, 1, 1, 1, time.UTC)
+	grant, err := authz.NewGrant(time.Date(1, 1, 1, 1, 1, 1, 1, time.UTC), authorization, &expiration)
+	require.NoError(t, err)
+	return &authz.MsgGrant{Granter: granter, Grantee: grantee, Grant: grant}
+}
+
+func newTransferAuthorization(denoms ...string) *transfertypes.TransferAuthorization {
+	allocations := make([]transfertypes.Allocation, 0, len(denoms))
+	for i, denom := range denoms {
+		allocations = append(allocations, transfertypes.Allocation{
+			SourcePort:    transfertypes.PortID,
+			SourceChannel: fmt.Sprintf("channel-%d", i),
+			SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin(denom, 1)),
+		})
+	}
+	return transfertypes.NewTransferAuthorization(allocations...)
+}
+
+// nestMsgExec wraps msg in depth levels of msgExec.
+func nestMsgExec(t *testing.T, depth int, msg sdk.Msg) sdk.Msg {
+	for i := 0; i < depth; i++ {
+		msg = newMsgExec(t, testAccount1.Address, msg)
+	}
+	return msg
+}
+
+// mockFeeTx is a transaction that only pays fees.
+type mockFeeTx struct {
+	sdk.Tx
+	fee            sdk.Coins
+	payer, granter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetGas() uint64     { return 0 }
+func (tx mockFeeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx mockFeeTx) FeePayer() []byte   { return tx.payer }
+func (tx mockFeeTx) FeeGranter() []byte { return tx.granter }
+
 func mockNext(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
 	return ctx, nil
 }
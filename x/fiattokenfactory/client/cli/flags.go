@@ -0,0 +1,74 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDenom  = "denom"
+	FlagDelay  = "delay"
+	FlagReason = "reason"
+	FlagScopes = "scopes"
+
+	FlagExpiryHeight = "expiry-height"
+	FlagExpiryTime   = "expiry-time"
+
+	FlagMaxInflow     = "max-inflow"
+	FlagMaxOutflow    = "max-outflow"
+	FlagMaxInflowBps  = "max-inflow-bps"
+	FlagMaxOutflowBps = "max-outflow-bps"
+)
+
+// AddDenomFlagToCmd adds the flag selecting the fiat token a command operates on.
+func AddDenomFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDenom, "", "Denom of the fiat token, defaults to the minting denom")
+}
+
+// AddDelayFlagToCmd adds the flag setting the number of blocks before a nominee can accept a role.
+func AddDelayFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagDelay, 0, "Number of blocks before the nominee can accept the role")
+}
+
+// AddScopesFlagToCmd adds the flag selecting the pause scopes a command operates on.
+func AddScopesFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagScopes, nil, "Comma separated pause scopes (mint, burn, transfer, ibc), defaults to all scopes")
+}
+
+// GetScopesFlag parses the pause scopes set with AddScopesFlagToCmd.
+func GetScopesFlag(cmd *cobra.Command) ([]types.PauseScope, error) {
+	names, err := cmd.Flags().GetStringSlice(FlagScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]types.PauseScope, 0, len(names))
+	for _, name := range names {
+		scope, ok := types.PauseScope_value["PAUSE_SCOPE_"+strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("invalid pause scope %s", name)
+		}
+		scopes = append(scopes, types.PauseScope(scope))
+	}
+
+	return scopes, nil
+}
@@ -37,6 +37,14 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdListBlacklisted())
 	cmd.AddCommand(CmdShowBlacklisted())
+	cmd.AddCommand(CmdListFrozen())
+	cmd.AddCommand(CmdShowFrozen())
+	cmd.AddCommand(CmdListQuarantinedRefund())
+	cmd.AddCommand(CmdShowQuarantinedRefund())
+	cmd.AddCommand(CmdListAllowedChannel())
+	cmd.AddCommand(CmdShowAllowedChannel())
+	cmd.AddCommand(CmdListChannelRateLimit())
+	cmd.AddCommand(CmdShowChannelRateLimit())
 	cmd.AddCommand(CmdShowPaused())
 	cmd.AddCommand(CmdShowMasterMinter())
 	cmd.AddCommand(CmdListMinters())
@@ -44,9 +52,22 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdShowPauser())
 	cmd.AddCommand(CmdShowBlacklister())
 	cmd.AddCommand(CmdShowOwner())
+	cmd.AddCommand(CmdShowPendingOwner())
 	cmd.AddCommand(CmdListMinterController())
 	cmd.AddCommand(CmdShowMinterController())
+	cmd.AddCommand(CmdListMintersOfController())
+	cmd.AddCommand(CmdListControllersOfMinter())
 	cmd.AddCommand(CmdShowMintingDenom())
+	cmd.AddCommand(CmdListFiatToken())
+	cmd.AddCommand(CmdShowFiatToken())
+	cmd.AddCommand(CmdShowPendingMasterMinter())
+	cmd.AddCommand(CmdShowPendingPauser())
+	cmd.AddCommand(CmdShowPendingBlacklister())
+	cmd.AddCommand(CmdShowMintRateLimit())
+	cmd.AddCommand(CmdShowMaxSupply())
+	cmd.AddCommand(CmdShowWiper())
+	cmd.AddCommand(CmdShowPendingWiper())
+	cmd.AddCommand(CmdShowParams())
 
 	return cmd
 }
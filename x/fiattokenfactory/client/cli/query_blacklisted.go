@@ -39,8 +39,14 @@ func CmdListBlacklisted() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryAllBlacklistedRequest{
 				Pagination: pageReq,
+				Denom:      denom,
 			}
 
 			res, err := queryClient.BlacklistedAll(context.Background(), params)
@@ -54,6 +60,7 @@ func CmdListBlacklisted() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -70,8 +77,14 @@ func CmdShowBlacklisted() *cobra.Command {
 
 			argAddress := args[0]
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetBlacklistedRequest{
 				Address: argAddress,
+				Denom:   denom,
 			}
 
 			res, err := queryClient.Blacklisted(context.Background(), params)
@@ -84,6 +97,7 @@ func CmdShowBlacklisted() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -35,7 +35,14 @@ func CmdShowBlacklister() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetBlacklisterRequest{}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetBlacklisterRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.Blacklister(context.Background(), params)
 			if err != nil {
@@ -47,6 +54,7 @@ func CmdShowBlacklister() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
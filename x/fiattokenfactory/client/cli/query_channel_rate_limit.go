@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-channel-rate-limit",
+		Short: "list all channel rate limits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllChannelRateLimitRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			}
+
+			res, err := queryClient.ChannelRateLimitAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-channel-rate-limit [port-id] [channel-id]",
+		Short: "shows the rate limit of a channel and its usage in the rolling window",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPortID := args[0]
+			argChannelID := args[1]
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryChannelRateLimitRequest{
+				PortId:    argPortID,
+				ChannelId: argChannelID,
+				Denom:     denom,
+			}
+
+			res, err := queryClient.ChannelRateLimit(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+
+	return cmd
+}
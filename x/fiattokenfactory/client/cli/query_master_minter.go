@@ -35,7 +35,14 @@ func CmdShowMasterMinter() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetMasterMinterRequest{}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetMasterMinterRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.MasterMinter(context.Background(), params)
 			if err != nil {
@@ -47,6 +54,7 @@ func CmdShowMasterMinter() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
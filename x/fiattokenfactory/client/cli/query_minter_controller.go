@@ -40,8 +40,14 @@ func CmdListMinterController() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryAllMinterControllerRequest{
 				Pagination: pageReq,
+				Denom:      denom,
 			}
 
 			res, err := queryClient.MinterControllerAll(context.Background(), params)
@@ -55,6 +61,7 @@ func CmdListMinterController() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -71,8 +78,14 @@ func CmdShowMinterController() *cobra.Command {
 
 			argControllerAddress := args[0]
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetMinterControllerRequest{
 				ControllerAddress: argControllerAddress,
+				Denom:             denom,
 			}
 
 			res, err := queryClient.MinterController(context.Background(), params)
@@ -85,6 +98,7 @@ func CmdShowMinterController() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -39,8 +39,14 @@ func CmdListMinters() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryAllMintersRequest{
 				Pagination: pageReq,
+				Denom:      denom,
 			}
 
 			res, err := queryClient.MintersAll(context.Background(), params)
@@ -54,6 +60,7 @@ func CmdListMinters() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -70,8 +77,14 @@ func CmdShowMinters() *cobra.Command {
 
 			argAddress := args[0]
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetMintersRequest{
 				Address: argAddress,
+				Denom:   denom,
 			}
 
 			res, err := queryClient.Minters(context.Background(), params)
@@ -84,6 +97,7 @@ func CmdShowMinters() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -35,7 +35,14 @@ func CmdShowOwner() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetOwnerRequest{}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetOwnerRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.Owner(context.Background(), params)
 			if err != nil {
@@ -47,6 +54,7 @@ func CmdShowOwner() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
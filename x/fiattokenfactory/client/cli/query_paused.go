@@ -35,7 +35,14 @@ func CmdShowPaused() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPausedRequest{}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPausedRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.Paused(context.Background(), params)
 			if err != nil {
@@ -47,6 +54,7 @@ func CmdShowPaused() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -35,7 +35,14 @@ func CmdShowPauser() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPauserRequest{}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPauserRequest{
+				Denom: denom,
+			}
 
 			res, err := queryClient.Pauser(context.Background(), params)
 			if err != nil {
@@ -47,6 +54,7 @@ func CmdShowPauser() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -49,6 +49,28 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnpause())
 	cmd.AddCommand(CmdConfigureMinterController())
 	cmd.AddCommand(CmdRemoveMinterController())
+	cmd.AddCommand(CmdAcceptMasterMinter())
+	cmd.AddCommand(CmdAcceptPauser())
+	cmd.AddCommand(CmdAcceptBlacklister())
+	cmd.AddCommand(CmdCancelPendingRole())
+	cmd.AddCommand(CmdAddMinterToController())
+	cmd.AddCommand(CmdRemoveMinterFromController())
+	cmd.AddCommand(CmdIncreaseMinterAllowance())
+	cmd.AddCommand(CmdDecreaseMinterAllowance())
+	cmd.AddCommand(CmdSetMintRateLimit())
+	cmd.AddCommand(CmdSetMaxSupply())
+	cmd.AddCommand(CmdBlacklistBatch())
+	cmd.AddCommand(CmdUnblacklistBatch())
+	cmd.AddCommand(CmdUpdateWiper())
+	cmd.AddCommand(CmdAcceptWiper())
+	cmd.AddCommand(CmdWipeBlacklistedBalance())
+	cmd.AddCommand(CmdFreeze())
+	cmd.AddCommand(CmdUnfreeze())
+	cmd.AddCommand(CmdReleaseQuarantinedRefund())
+	cmd.AddCommand(CmdAddAllowedChannel())
+	cmd.AddCommand(CmdRemoveAllowedChannel())
+	cmd.AddCommand(CmdSetChannelAllowlist())
+	cmd.AddCommand(CmdSetChannelRateLimit())
 
 	return cmd
 }
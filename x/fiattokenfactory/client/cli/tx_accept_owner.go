@@ -39,8 +39,14 @@ func CmdAcceptOwner() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAcceptOwner{
-				From: clientCtx.GetFromAddress().String(),
+				From:  clientCtx.GetFromAddress().String(),
+				Denom: denom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -48,6 +54,7 @@ func CmdAcceptOwner() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -41,9 +41,21 @@ func CmdBlacklist() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgBlacklist{
 				From:    clientCtx.GetFromAddress().String(),
 				Address: argAddress,
+				Denom:   denom,
+				Reason:  reason,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -51,6 +63,8 @@ func CmdBlacklist() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+	cmd.Flags().String(FlagReason, "", "Optional reason or external reference recorded with the blacklist entry")
 
 	return cmd
 }
@@ -0,0 +1,82 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelPendingRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-pending-role [role]",
+		Short:   "Broadcast message cancel-pending-role",
+		Long:    "Cancel a pending role handover. Role is one of owner, master-minter, pauser, blacklister or wiper.",
+		Example: "cancel-pending-role master-minter",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRole, err := parseRole(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelPendingRole{
+				From:  clientCtx.GetFromAddress().String(),
+				Role:  argRole,
+				Denom: denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+
+	return cmd
+}
+
+// parseRole accepts either the enum name (ROLE_MASTER_MINTER) or its short form (master-minter).
+func parseRole(arg string) (types.Role, error) {
+	name := strings.ToUpper(strings.ReplaceAll(arg, "-", "_"))
+	if !strings.HasPrefix(name, "ROLE_") {
+		name = "ROLE_" + name
+	}
+
+	role, ok := types.Role_value[name]
+	if !ok || types.Role(role) == types.ROLE_UNSPECIFIED {
+		return types.ROLE_UNSPECIFIED, fmt.Errorf("invalid role %s", arg)
+	}
+
+	return types.Role(role), nil
+}
@@ -42,10 +42,16 @@ func CmdConfigureMinterController() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgConfigureMinterController{
 				From:       clientCtx.GetFromAddress().String(),
 				Controller: argController,
 				Minter:     argMinter,
+				Denom:      denom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -53,6 +59,7 @@ func CmdConfigureMinterController() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
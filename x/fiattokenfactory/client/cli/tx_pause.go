@@ -39,8 +39,32 @@ func CmdPause() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			scopes, err := GetScopesFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			expiryTime, err := cmd.Flags().GetInt64(FlagExpiryTime)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgPause{
-				From: clientCtx.GetFromAddress().String(),
+				From:         clientCtx.GetFromAddress().String(),
+				Denom:        denom,
+				Scopes:       scopes,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -48,6 +72,10 @@ func CmdPause() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+	AddScopesFlagToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Optional block height at which the pause is lifted")
+	cmd.Flags().Int64(FlagExpiryTime, 0, "Optional unix time in seconds at which the pause is lifted")
 
 	return cmd
 }
@@ -42,9 +42,15 @@ func CmdRemoveMinterController() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRemoveMinterController{
 				From:       clientCtx.GetFromAddress().String(),
 				Controller: argAddress,
+				Denom:      denom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -52,6 +58,7 @@ func CmdRemoveMinterController() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -0,0 +1,119 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-rate-limit [port-id] [channel-id] [window] [blocks|seconds]",
+		Short: "Broadcast message set-channel-rate-limit",
+		Long: "Limits the amount received and sent over a channel per [window] blocks or seconds. Each direction is limited " +
+			"by the lower of its absolute quota and its share of the total supply in basis points, an unset quota does not " +
+			"limit the direction. A window of 0 removes the limit.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPortID := args[0]
+			argChannelID := args[1]
+			argWindow, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argUnit, err := parseWindowUnit(args[3])
+			if err != nil {
+				return err
+			}
+
+			maxInflow, err := getIntFlag(cmd, FlagMaxInflow)
+			if err != nil {
+				return err
+			}
+			maxOutflow, err := getIntFlag(cmd, FlagMaxOutflow)
+			if err != nil {
+				return err
+			}
+			maxInflowBps, err := cmd.Flags().GetUint32(FlagMaxInflowBps)
+			if err != nil {
+				return err
+			}
+			maxOutflowBps, err := cmd.Flags().GetUint32(FlagMaxOutflowBps)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetChannelRateLimit{
+				From:          clientCtx.GetFromAddress().String(),
+				PortId:        argPortID,
+				ChannelId:     argChannelID,
+				MaxInflow:     maxInflow,
+				MaxOutflow:    maxOutflow,
+				MaxInflowBps:  maxInflowBps,
+				MaxOutflowBps: maxOutflowBps,
+				Window:        argWindow,
+				Unit:          argUnit,
+				Denom:         denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+	cmd.Flags().String(FlagMaxInflow, "0", "Maximum amount received over the channel per window")
+	cmd.Flags().String(FlagMaxOutflow, "0", "Maximum amount sent over the channel per window")
+	cmd.Flags().Uint32(FlagMaxInflowBps, 0, "Maximum amount received over the channel per window, in basis points of the total supply")
+	cmd.Flags().Uint32(FlagMaxOutflowBps, 0, "Maximum amount sent over the channel per window, in basis points of the total supply")
+
+	return cmd
+}
+
+func getIntFlag(cmd *cobra.Command, flag string) (math.Int, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	amount, ok := math.NewIntFromString(value)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid %s %s", flag, value)
+	}
+
+	return amount, nil
+}
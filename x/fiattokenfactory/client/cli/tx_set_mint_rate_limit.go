@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-rate-limit [address] [limit] [window] [blocks|seconds]",
+		Short: "Broadcast message set-mint-rate-limit",
+		Long:  "Limits a minter to minting at most [limit] tokens per [window] blocks or seconds. A window of 0 removes the limit.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddress := args[0]
+			argLimit, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid limit %s", args[1])
+			}
+			argWindow, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argUnit, err := parseWindowUnit(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetMintRateLimit{
+				From:    clientCtx.GetFromAddress().String(),
+				Address: argAddress,
+				Limit:   argLimit,
+				Window:  argWindow,
+				Unit:    argUnit,
+				Denom:   denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+
+	return cmd
+}
+
+// parseWindowUnit accepts either the enum name (WINDOW_UNIT_BLOCKS) or its short form (blocks).
+func parseWindowUnit(arg string) (types.WindowUnit, error) {
+	name := strings.ToUpper(arg)
+	if !strings.HasPrefix(name, "WINDOW_UNIT_") {
+		name = "WINDOW_UNIT_" + name
+	}
+
+	unit, ok := types.WindowUnit_value[name]
+	if !ok || types.WindowUnit(unit) == types.WINDOW_UNIT_UNSPECIFIED {
+		return types.WINDOW_UNIT_UNSPECIFIED, fmt.Errorf("invalid window unit %s", arg)
+	}
+
+	return types.WindowUnit(unit), nil
+}
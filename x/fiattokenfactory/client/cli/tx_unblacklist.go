@@ -41,9 +41,15 @@ func CmdUnblacklist() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUnblacklist{
 				From:    clientCtx.GetFromAddress().String(),
 				Address: argAddress,
+				Denom:   denom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -51,6 +57,7 @@ func CmdUnblacklist() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
 
 	return cmd
 }
@@ -39,8 +39,20 @@ func CmdUnpause() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			scopes, err := GetScopesFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUnpause{
-				From: clientCtx.GetFromAddress().String(),
+				From:   clientCtx.GetFromAddress().String(),
+				Denom:  denom,
+				Scopes: scopes,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -48,6 +60,8 @@ func CmdUnpause() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+	AddScopesFlagToCmd(cmd)
 
 	return cmd
 }
@@ -41,9 +41,21 @@ func CmdUpdatePauser() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			delay, err := cmd.Flags().GetUint64(FlagDelay)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdatePauser{
 				From:    clientCtx.GetFromAddress().String(),
 				Address: argAddress,
+				Denom:   denom,
+				Delay:   delay,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -51,6 +63,8 @@ func CmdUpdatePauser() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddDenomFlagToCmd(cmd)
+	AddDelayFlagToCmd(cmd)
 
 	return cmd
 }
@@ -27,80 +27,407 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, genState types.GenesisState) {
+	if err := initGenesis(ctx, k, bankKeeper, genState); err != nil {
+		panic(err)
+	}
+}
+
+func initGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, genState types.GenesisState) error {
+	if genState.Params != nil {
+		if err := k.SetParams(ctx, *genState.Params); err != nil {
+			return err
+		}
+	}
+
+	if err := initFiatTokenGenesis(ctx, k, genState); err != nil {
+		return err
+	}
+
+	if genState.MintingDenom != nil {
+		_, found := bankKeeper.GetDenomMetaData(ctx, genState.MintingDenom.Denom)
+		if !found {
+			return errors.Wrapf(types.ErrDenomNotRegistered, "fiattokenfactory minting denom %s is not registered in bank module denom_metadata", genState.MintingDenom.Denom)
+		}
+		if err := k.SetMintingDenom(ctx, *genState.MintingDenom); err != nil {
+			return err
+		}
+	}
+
+	for _, fiatToken := range genState.FiatTokens {
+		denom := fiatToken.MintingDenom.Denom
+
+		_, found := bankKeeper.GetDenomMetaData(ctx, denom)
+		if !found {
+			return errors.Wrapf(types.ErrDenomNotRegistered, "fiattokenfactory fiat token %s is not registered in bank module denom_metadata", denom)
+		}
+		if err := k.SetFiatToken(ctx, types.FiatToken{Denom: denom}); err != nil {
+			return err
+		}
+
+		token, err := k.ForDenom(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		if fiatToken.Paused == nil {
+			fiatToken.Paused = &types.Paused{}
+		}
+
+		if err := initFiatTokenGenesis(ctx, token, fiatToken); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initFiatTokenGenesis initializes the roles, minters and state of a single fiat token.
+func initFiatTokenGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState) error {
 	for _, elem := range genState.BlacklistedList {
-		k.SetBlacklisted(ctx, elem)
+		if err := k.SetBlacklisted(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.FrozenList {
+		if err := k.SetFrozen(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.AllowedChannelList {
+		if err := k.SetAllowedChannel(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	if genState.ChannelAllowlist != nil {
+		if err := k.SetChannelAllowlist(ctx, *genState.ChannelAllowlist); err != nil {
+			return err
+		}
 	}
 
 	if genState.Paused != nil {
-		k.SetPaused(ctx, *genState.Paused)
+		// genesis files exported before per-scope pausing carry the single legacy flag
+		if err := k.SetPaused(ctx, genState.Paused.Migrate()); err != nil {
+			return err
+		}
 	}
 
 	if genState.MasterMinter != nil {
-		k.SetMasterMinter(ctx, *genState.MasterMinter)
+		if err := k.SetMasterMinter(ctx, *genState.MasterMinter); err != nil {
+			return err
+		}
 	}
 
 	for _, elem := range genState.MintersList {
-		k.SetMinters(ctx, elem)
+		if err := k.SetMinters(ctx, elem); err != nil {
+			return err
+		}
 	}
 
 	if genState.Pauser != nil {
-		k.SetPauser(ctx, *genState.Pauser)
+		if err := k.SetPauser(ctx, *genState.Pauser); err != nil {
+			return err
+		}
 	}
 
 	if genState.Blacklister != nil {
-		k.SetBlacklister(ctx, *genState.Blacklister)
+		if err := k.SetBlacklister(ctx, *genState.Blacklister); err != nil {
+			return err
+		}
 	}
 
 	if genState.Owner != nil {
-		k.SetOwner(ctx, *genState.Owner)
+		if err := k.SetOwner(ctx, *genState.Owner); err != nil {
+			return err
+		}
 	}
 
 	for _, elem := range genState.MinterControllerList {
-		k.SetMinterController(ctx, elem)
+		if err := k.SetMinterController(ctx, elem); err != nil {
+			return err
+		}
+		if err := k.SetMinterControllerLink(ctx, elem); err != nil {
+			return err
+		}
 	}
 
-	if genState.MintingDenom != nil {
-		_, found := bankKeeper.GetDenomMetaData(ctx, genState.MintingDenom.Denom)
-		if !found {
-			panic(errors.Wrapf(types.ErrDenomNotRegistered, "fiattokenfactory minting denom %s is not registered in bank module denom_metadata", genState.MintingDenom.Denom))
+	for _, elem := range genState.MinterControllerLinks {
+		if err := k.SetMinterControllerLink(ctx, elem); err != nil {
+			return err
 		}
-		k.SetMintingDenom(ctx, *genState.MintingDenom)
 	}
+
+	for _, elem := range genState.MintRateLimits {
+		if err := k.SetMintRateLimit(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.MintWindows {
+		if err := k.SetMintWindow(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ChannelRateLimits {
+		if err := k.SetChannelRateLimit(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ChannelFlows {
+		if err := k.SetChannelFlow(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.PendingOutflows {
+		if err := k.SetPendingOutflow(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.QuarantinedRefunds {
+		if err := k.SetQuarantinedRefund(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	if genState.PendingMasterMinter != nil {
+		if err := k.SetPendingMasterMinter(ctx, *genState.PendingMasterMinter); err != nil {
+			return err
+		}
+	}
+
+	if genState.PendingPauser != nil {
+		if err := k.SetPendingPauser(ctx, *genState.PendingPauser); err != nil {
+			return err
+		}
+	}
+
+	if genState.PendingBlacklister != nil {
+		if err := k.SetPendingBlacklister(ctx, *genState.PendingBlacklister); err != nil {
+			return err
+		}
+	}
+
+	if genState.PendingOwner != nil {
+		if err := k.SetPendingOwner(ctx, *genState.PendingOwner); err != nil {
+			return err
+		}
+	}
+
+	if genState.MaxSupply != nil {
+		if err := k.SetMaxSupply(ctx, *genState.MaxSupply); err != nil {
+			return err
+		}
+	}
+
+	if genState.Wiper != nil {
+		if err := k.SetWiper(ctx, *genState.Wiper); err != nil {
+			return err
+		}
+	}
+
+	if genState.PendingWiper != nil {
+		if err := k.SetPendingWiper(ctx, *genState.PendingWiper); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported GenesisState
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	genesis, err := exportGenesis(ctx, k)
+	if err != nil {
+		panic(err)
+	}
+
+	return genesis
+}
+
+func exportGenesis(ctx sdk.Context, k *keeper.Keeper) (*types.GenesisState, error) {
 	genesis := types.DefaultGenesis()
 
-	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.Params = &params
 
-	paused := k.GetPaused(ctx)
+	if err := exportFiatTokenGenesis(ctx, k, genesis); err != nil {
+		return nil, err
+	}
+
+	fiatTokens, err := k.GetAllFiatTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fiatToken := range fiatTokens {
+		token, err := k.ForDenom(ctx, fiatToken.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		var tokenGenesis types.GenesisState
+		if err := exportFiatTokenGenesis(ctx, token, &tokenGenesis); err != nil {
+			return nil, err
+		}
+		genesis.FiatTokens = append(genesis.FiatTokens, tokenGenesis)
+	}
+
+	return genesis, nil
+}
+
+// exportFiatTokenGenesis exports the roles, minters and state of a single fiat token.
+func exportFiatTokenGenesis(ctx sdk.Context, k *keeper.Keeper, genesis *types.GenesisState) (err error) {
+	if genesis.BlacklistedList, err = k.GetAllBlacklisted(ctx); err != nil {
+		return err
+	}
+	if genesis.FrozenList, err = k.GetAllFrozen(ctx); err != nil {
+		return err
+	}
+	if genesis.AllowedChannelList, err = k.GetAllAllowedChannels(ctx); err != nil {
+		return err
+	}
+
+	allowlist, err := k.GetChannelAllowlist(ctx)
+	if err != nil {
+		return err
+	}
+	genesis.ChannelAllowlist = &allowlist
+
+	paused, err := k.GetPaused(ctx)
+	if err != nil {
+		return err
+	}
 	genesis.Paused = &paused
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found, err := k.GetMasterMinter(ctx)
+	if err != nil {
+		return err
+	}
 	if found {
 		genesis.MasterMinter = &masterMinter
 	}
-	genesis.MintersList = k.GetAllMinters(ctx)
+	if genesis.MintersList, err = k.GetAllMinters(ctx); err != nil {
+		return err
+	}
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found, err := k.GetPauser(ctx)
+	if err != nil {
+		return err
+	}
 	if found {
 		genesis.Pauser = &pauser
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found, err := k.GetBlacklister(ctx)
+	if err != nil {
+		return err
+	}
 	if found {
 		genesis.Blacklister = &blacklister
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found, err := k.GetOwner(ctx)
+	if err != nil {
+		return err
+	}
 	if found {
 		genesis.Owner = &owner
 	}
-	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
+	if genesis.MinterControllerList, err = k.GetAllMinterControllers(ctx); err != nil {
+		return err
+	}
+	if genesis.MinterControllerLinks, err = k.GetAllMinterControllerLinks(ctx); err != nil {
+		return err
+	}
+	if genesis.MintRateLimits, err = k.GetAllMintRateLimits(ctx); err != nil {
+		return err
+	}
+	if genesis.MintWindows, err = k.GetAllMintWindows(ctx); err != nil {
+		return err
+	}
+	if genesis.ChannelRateLimits, err = k.GetAllChannelRateLimits(ctx); err != nil {
+		return err
+	}
+	if genesis.ChannelFlows, err = k.GetAllChannelFlows(ctx); err != nil {
+		return err
+	}
+	if genesis.PendingOutflows, err = k.GetAllPendingOutflows(ctx); err != nil {
+		return err
+	}
+	if genesis.QuarantinedRefunds, err = k.GetAllQuarantinedRefunds(ctx); err != nil {
+		return err
+	}
+
+	pendingMasterMinter, found, err := k.GetPendingMasterMinter(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.PendingMasterMinter = &pendingMasterMinter
+	}
+
+	pendingPauser, found, err := k.GetPendingPauser(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.PendingPauser = &pendingPauser
+	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	pendingBlacklister, found, err := k.GetPendingBlacklister(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.PendingBlacklister = &pendingBlacklister
+	}
+
+	pendingOwner, found, err := k.GetPendingOwner(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.PendingOwner = &pendingOwner
+	}
+
+	maxSupply, found, err := k.GetMaxSupply(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.MaxSupply = &maxSupply
+	}
+
+	wiper, found, err := k.GetWiper(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.Wiper = &wiper
+	}
+
+	pendingWiper, found, err := k.GetPendingWiper(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		genesis.PendingWiper = &pendingWiper
+	}
+
+	mintingDenom, err := k.GetMintingDenom(ctx)
+	if err != nil {
+		return err
+	}
 	genesis.MintingDenom = &mintingDenom
 
-	return genesis
+	return nil
 }
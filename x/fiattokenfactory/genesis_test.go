@@ -19,6 +19,9 @@ package fiattokenfactory_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
 	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -75,21 +78,42 @@ func TestInitGenesis_MissingFields(t *testing.T) {
 			k, ctx := keepertest.FiatTokenfactoryKeeper()
 			fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, tc.genState)
 
-			_, found := k.GetOwner(ctx)
+			_, found, err := k.GetOwner(ctx)
+			require.NoError(t, err)
 			require.False(t, found)
-			_, found = k.GetMasterMinter(ctx)
+			_, found, err = k.GetMasterMinter(ctx)
+			require.NoError(t, err)
 			require.False(t, found)
-			_, found = k.GetPauser(ctx)
+			_, found, err = k.GetPauser(ctx)
+			require.NoError(t, err)
 			require.False(t, found)
-			_, found = k.GetBlacklister(ctx)
+			_, found, err = k.GetBlacklister(ctx)
+			require.NoError(t, err)
 			require.False(t, found)
 
-			require.Panics(t, func() { k.GetMintingDenom(ctx) })
-			require.Panics(t, func() { k.GetPaused(ctx) })
+			_, err = k.GetMintingDenom(ctx)
+			require.ErrorIs(t, err, collections.ErrNotFound)
+			_, err = k.GetPaused(ctx)
+			require.ErrorIs(t, err, collections.ErrNotFound)
 
-			require.Empty(t, k.GetAllBlacklisted(ctx))
-			require.Empty(t, k.GetAllMinters(ctx))
-			require.Empty(t, k.GetAllMinterControllers(ctx))
+			blacklisted, err := k.GetAllBlacklisted(ctx)
+			require.NoError(t, err)
+			require.Empty(t, blacklisted)
+			frozen, err := k.GetAllFrozen(ctx)
+			require.NoError(t, err)
+			require.Empty(t, frozen)
+			allowedChannels, err := k.GetAllAllowedChannels(ctx)
+			require.NoError(t, err)
+			require.Empty(t, allowedChannels)
+			minters, err := k.GetAllMinters(ctx)
+			require.NoError(t, err)
+			require.Empty(t, minters)
+			minterControllers, err := k.GetAllMinterControllers(ctx)
+			require.NoError(t, err)
+			require.Empty(t, minterControllers)
+			params, err := k.GetParams(ctx)
+			require.NoError(t, err)
+			require.Equal(t, types.DefaultParams(), params)
 		})
 	}
 }
@@ -100,33 +124,72 @@ func TestInitGenesis_allSpecifiedPasses(t *testing.T) {
 	k, ctx := keepertest.FiatTokenfactoryKeeper()
 	fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found, err := k.GetOwner(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t, genesisState.Owner, &owner)
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found, err := k.GetMasterMinter(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t, genesisState.MasterMinter, &masterMinter)
-	pauser, found := k.GetPauser(ctx)
+	pauser, found, err := k.GetPauser(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t, genesisState.Pauser, &pauser)
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found, err := k.GetBlacklister(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t, genesisState.Blacklister, &blacklister)
+	wiper, found, err := k.GetWiper(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, genesisState.Wiper, &wiper)
+	pendingWiper, found, err := k.GetPendingWiper(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, genesisState.PendingWiper, &pendingWiper)
 
-	paused := k.GetPaused(ctx)
+	paused, err := k.GetPaused(ctx)
+	require.NoError(t, err)
 	require.Equal(t, genesisState.Paused, &paused)
-	denom := k.GetMintingDenom(ctx)
+	denom, err := k.GetMintingDenom(ctx)
+	require.NoError(t, err)
 	require.Equal(t, genesisState.MintingDenom, &denom)
 
+	blacklisted, err := k.GetAllBlacklisted(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(genesisState.BlacklistedList),
-		nullify.Fill(k.GetAllBlacklisted(ctx)))
+		nullify.Fill(blacklisted))
+	frozen, err := k.GetAllFrozen(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t,
+		nullify.Fill(genesisState.FrozenList),
+		nullify.Fill(frozen))
+	allowedChannels, err := k.GetAllAllowedChannels(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, genesisState.AllowedChannelList, allowedChannels)
+	minters, err := k.GetAllMinters(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(genesisState.MintersList),
-		nullify.Fill(k.GetAllMinters(ctx)))
+		nullify.Fill(minters))
+	minterControllers, err := k.GetAllMinterControllers(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(genesisState.MinterControllerList),
-		nullify.Fill(k.GetAllMinterControllers(ctx)))
+		nullify.Fill(minterControllers))
+
+	// every primary minter is linked to its controller
+	for _, elem := range genesisState.MinterControllerList {
+		found, err := k.HasMinterControllerLink(ctx, elem.Controller, elem.Minter)
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, *genesisState.Params, params)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -142,6 +205,8 @@ func TestExportGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.BlacklistedList, got.BlacklistedList)
+	require.ElementsMatch(t, genesisState.FrozenList, got.FrozenList)
+	require.ElementsMatch(t, genesisState.AllowedChannelList, got.AllowedChannelList)
 	require.Equal(t, genesisState.Paused, got.Paused)
 	require.Equal(t, genesisState.MasterMinter, got.MasterMinter)
 	require.ElementsMatch(t, genesisState.MintersList, got.MintersList)
@@ -149,11 +214,91 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Blacklister, got.Blacklister)
 	require.Equal(t, genesisState.Owner, got.Owner)
 	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerList)
+	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerLinks)
+	require.ElementsMatch(t, genesisState.MintRateLimits, got.MintRateLimits)
+	require.ElementsMatch(t, genesisState.MintWindows, got.MintWindows)
+	require.ElementsMatch(t, genesisState.ChannelRateLimits, got.ChannelRateLimits)
+	require.ElementsMatch(t, genesisState.ChannelFlows, got.ChannelFlows)
+	require.ElementsMatch(t, genesisState.PendingOutflows, got.PendingOutflows)
+	require.ElementsMatch(t, genesisState.QuarantinedRefunds, got.QuarantinedRefunds)
+	require.Equal(t, genesisState.ChannelAllowlist, got.ChannelAllowlist)
+	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
+	require.Equal(t, genesisState.PendingMasterMinter, got.PendingMasterMinter)
+	require.Equal(t, genesisState.PendingPauser, got.PendingPauser)
+	require.Equal(t, genesisState.PendingBlacklister, got.PendingBlacklister)
+	require.Equal(t, genesisState.PendingOwner, got.PendingOwner)
+	require.Equal(t, genesisState.MaxSupply, got.MaxSupply)
+	require.Equal(t, genesisState.Wiper, got.Wiper)
+	require.Equal(t, genesisState.PendingWiper, got.PendingWiper)
+	require.Equal(t, genesisState.Params, got.Params)
+}
+
+func TestInitGenesis_fiatTokenWithoutMetadata(t *testing.T) {
+	genesisState := createCompleteValidGenesis()
+	fiatToken := createCompleteValidGenesis()
+	fiatToken.MintingDenom = &types.MintingDenom{Denom: "notadenom"}
+	genesisState.FiatTokens = []types.GenesisState{fiatToken}
+
+	k, ctx := keepertest.FiatTokenfactoryKeeper()
+	require.Panics(t, func() { fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState) })
+}
+
+func TestExportGenesis_FiatTokens(t *testing.T) {
+	genesisState := createCompleteValidGenesis()
+	fiatToken := createCompleteValidGenesis()
+	fiatToken.MintingDenom = &types.MintingDenom{Denom: "ueurc"}
+	fiatToken.Paused = nil
+	fiatToken.Params = nil
+	genesisState.FiatTokens = []types.GenesisState{fiatToken}
+
+	k, ctx := keepertest.FiatTokenfactoryKeeper()
+	fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState)
+
+	token, err := k.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	paused, err := token.GetPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+	paused, err = k.GetPaused(ctx)
+	require.NoError(t, err)
+	require.True(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+
+	got := fiattokenfactory.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+	require.Len(t, got.FiatTokens, 1)
+
+	fiatToken.Paused = &types.Paused{}
+	nullify.Fill(&fiatToken)
+	exported := got.FiatTokens[0]
+	nullify.Fill(&exported)
+
+	require.ElementsMatch(t, fiatToken.BlacklistedList, exported.BlacklistedList)
+	require.ElementsMatch(t, fiatToken.FrozenList, exported.FrozenList)
+	require.ElementsMatch(t, fiatToken.AllowedChannelList, exported.AllowedChannelList)
+	require.Equal(t, fiatToken.Paused, exported.Paused)
+	require.Equal(t, fiatToken.MasterMinter, exported.MasterMinter)
+	require.ElementsMatch(t, fiatToken.MintersList, exported.MintersList)
+	require.Equal(t, fiatToken.Pauser, exported.Pauser)
+	require.Equal(t, fiatToken.Blacklister, exported.Blacklister)
+	require.Equal(t, fiatToken.Owner, exported.Owner)
+	require.ElementsMatch(t, fiatToken.MinterControllerList, exported.MinterControllerList)
+	require.Equal(t, fiatToken.MintingDenom, exported.MintingDenom)
+	require.Empty(t, exported.FiatTokens)
+	require.Nil(t, exported.Params)
+
+	require.Equal(t, genesisState.Owner, got.Owner)
 	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
 }
 
 func createCompleteValidGenesis() types.GenesisState {
+	rateLimitedMinter := sample.AccAddress()
+
 	return types.GenesisState{
+		Params: &types.Params{
+			MaxBatchSize:            100,
+			MaxMinterAllowance:      math.NewInt(1_000_000),
+			RequireChannelAllowlist: true,
+		},
 		BlacklistedList: []types.Blacklisted{
 			{
 				AddressBz: sample.AddressBz(),
@@ -162,8 +307,19 @@ func createCompleteValidGenesis() types.GenesisState {
 				AddressBz: sample.AddressBz(),
 			},
 		},
+		FrozenList: []types.Frozen{
+			{
+				AddressBz: sample.AddressBz(),
+			},
+		},
+		AllowedChannelList: []types.AllowedChannel{
+			{PortId: "transfer", ChannelId: "channel-0"},
+		},
 		Paused: &types.Paused{
-			Paused: true,
+			Mint:     true,
+			Burn:     true,
+			Transfer: true,
+			Ibc:      true,
 		},
 		MasterMinter: &types.MasterMinter{
 			Address: sample.AccAddress(),
@@ -198,5 +354,74 @@ func createCompleteValidGenesis() types.GenesisState {
 		MintingDenom: &types.MintingDenom{
 			Denom: "uusdc",
 		},
+		PendingMasterMinter: &types.PendingRole{
+			Address:          sample.AccAddress(),
+			ActivationHeight: 10,
+		},
+		PendingPauser: &types.PendingRole{
+			Address:          sample.AccAddress(),
+			ActivationHeight: 10,
+		},
+		PendingBlacklister: &types.PendingRole{
+			Address:          sample.AccAddress(),
+			ActivationHeight: 10,
+		},
+		PendingOwner: &types.PendingRole{
+			Address:          sample.AccAddress(),
+			ActivationHeight: 10,
+		},
+		MintRateLimits: []types.MintRateLimit{
+			{
+				Minter: rateLimitedMinter,
+				Limit:  math.NewInt(1000),
+				Window: 100,
+				Unit:   types.WINDOW_UNIT_BLOCKS,
+			},
+		},
+		MintWindows: []types.MintWindow{
+			{
+				Minter:  rateLimitedMinter,
+				Buckets: []types.MintBucket{{Start: 1, Minted: math.NewInt(10)}},
+			},
+		},
+		ChannelRateLimits: []types.ChannelRateLimit{
+			{
+				PortId:     "transfer",
+				ChannelId:  "channel-0",
+				MaxInflow:  math.NewInt(1000),
+				MaxOutflow: math.NewInt(1000),
+				Window:     100,
+				Unit:       types.WINDOW_UNIT_BLOCKS,
+			},
+		},
+		ChannelFlows: []types.ChannelFlow{
+			{
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+				Buckets:   []types.FlowBucket{{Start: 1, Inflow: math.NewInt(10), Outflow: math.NewInt(20)}},
+			},
+		},
+		PendingOutflows: []types.PendingOutflow{
+			{
+				PortId:      "transfer",
+				ChannelId:   "channel-0",
+				Sequence:    1,
+				BucketStart: 1,
+			},
+		},
+		ChannelAllowlist: &types.ChannelAllowlist{Enabled: true},
+		QuarantinedRefunds: []types.QuarantinedRefund{
+			{Address: sample.AccAddress(), Amount: math.NewInt(1_000_000)},
+		},
+		MaxSupply: &types.MaxSupply{
+			Amount: math.NewInt(1_000_000),
+		},
+		Wiper: &types.Wiper{
+			Address: sample.AccAddress(),
+		},
+		PendingWiper: &types.PendingRole{
+			Address:          sample.AccAddress(),
+			ActivationHeight: 10,
+		},
 	}
 }
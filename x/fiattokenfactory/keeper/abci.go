@@ -0,0 +1,88 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker lifts the pause of every scope of the minting denom and of every
+// registered fiat token whose own scheduled expiry has been reached.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokens, err := k.ManagedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		mintingDenomSet, err := token.MintingDenomSet(ctx)
+		if err != nil {
+			return err
+		}
+
+		pausedSet, err := token.PausedSet(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !mintingDenomSet || !pausedSet {
+			continue
+		}
+
+		paused, err := token.GetPaused(ctx)
+		if err != nil {
+			return err
+		}
+
+		expired := paused.Expired(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		if len(expired) == 0 {
+			continue
+		}
+
+		mintingDenom, err := token.GetMintingDenom(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, expiry := range expired {
+			paused.Set(false, expiry.Scope)
+		}
+		if err := token.SetPaused(ctx, paused); err != nil {
+			return err
+		}
+
+		for _, expiry := range expired {
+			event := types.PauseExpired{
+				Denom:        mintingDenom.Denom,
+				ExpiryHeight: expiry.Height,
+				ExpiryTime:   expiry.Time,
+				Scope:        expiry.Scope,
+			}
+			if err := sdkCtx.EventManager().EmitTypedEvent(&event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
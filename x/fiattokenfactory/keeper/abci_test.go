@@ -0,0 +1,92 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestEndBlocker_NoMintingDenom(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	require.NoError(t, ftf.EndBlocker(ctx))
+}
+
+func TestEndBlocker_LiftsExpiredPause(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	paused := types.Paused{}
+	paused.Pause(11, 0, types.PAUSE_SCOPE_IBC)
+	ftf.SetPaused(ctx, paused)
+
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	eurc, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	eurcPaused := types.Paused{}
+	eurcPaused.Pause(0, 1100)
+	eurc.SetPaused(ctx, eurcPaused)
+
+	// neither expiry has been reached
+	require.NoError(t, ftf.EndBlocker(ctx))
+	got, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paused, got)
+	got, err = eurc.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eurcPaused, got)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the expiry height of the minting denom is reached
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1050, 0))
+	require.NoError(t, ftf.EndBlocker(ctx))
+	got, err = ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{}, got)
+	got, err = eurc.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eurcPaused, got)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "circle.fiattokenfactory.v1.PauseExpired", ctx.EventManager().Events()[0].Type)
+
+	// the expiry time of the fiat token is reached
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(1100, 0))
+	require.NoError(t, ftf.EndBlocker(ctx))
+	got, err = eurc.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{}, got)
+	require.Len(t, ctx.EventManager().Events(), 1+len(types.PauseScopes))
+}
+
+func TestEndBlocker_IndefinitePause(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(1000).WithBlockTime(time.Unix(1000, 0))
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.NewPaused())
+
+	require.NoError(t, ftf.EndBlocker(ctx))
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPaused(), paused)
+}
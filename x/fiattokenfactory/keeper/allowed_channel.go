@@ -0,0 +1,80 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetAllowedChannel set a specific allowedChannel in the store from its index
+func (k Keeper) SetAllowedChannel(ctx context.Context, allowedChannel types.AllowedChannel) error {
+	return k.allowedChannels.Set(ctx, collections.Join(allowedChannel.PortId, allowedChannel.ChannelId), allowedChannel)
+}
+
+// GetAllowedChannel returns an allowedChannel from its index
+func (k Keeper) GetAllowedChannel(ctx context.Context, portID, channelID string) (val types.AllowedChannel, found bool, err error) {
+	val, err = k.allowedChannels.Get(ctx, collections.Join(portID, channelID))
+	found, err = exists(err)
+	return val, found, err
+}
+
+// RemoveAllowedChannel removes an allowedChannel from the store
+func (k Keeper) RemoveAllowedChannel(ctx context.Context, portID, channelID string) error {
+	return k.allowedChannels.Remove(ctx, collections.Join(portID, channelID))
+}
+
+// GetAllAllowedChannels returns all allowedChannel
+func (k Keeper) GetAllAllowedChannels(ctx context.Context) (list []types.AllowedChannel, err error) {
+	return values(ctx, k.allowedChannels, nil)
+}
+
+// SetChannelAllowlist set channelAllowlist in the store
+func (k Keeper) SetChannelAllowlist(ctx context.Context, allowlist types.ChannelAllowlist) error {
+	return k.channelAllowlist.Set(ctx, allowlist)
+}
+
+// GetChannelAllowlist returns channelAllowlist, which is disabled until it is set
+func (k Keeper) GetChannelAllowlist(ctx context.Context) (val types.ChannelAllowlist, err error) {
+	val, err = k.channelAllowlist.Get(ctx)
+	_, err = exists(err)
+	return val, err
+}
+
+// IsChannelAllowed returns whether the fiat token may be transferred over a channel. While the allowlist of the
+// token is disabled every channel is, so that chains upgrading to the allowlist keep working until it is
+// populated, unless the require_channel_allowlist param is set. An enabled allowlist without any allowed
+// channel blocks every channel.
+func (k Keeper) IsChannelAllowed(ctx context.Context, portID, channelID string) (bool, error) {
+	allowlist, err := k.GetChannelAllowlist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !allowlist.Enabled && !params.RequireChannelAllowlist {
+		return true, nil
+	}
+
+	return k.allowedChannels.Has(ctx, collections.Join(portID, channelID))
+}
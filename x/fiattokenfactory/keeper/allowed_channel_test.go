@@ -0,0 +1,111 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedChannelGetSetRemove(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetAllowedChannel(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+	allowedChannels, err := keeper.GetAllAllowedChannels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, allowedChannels)
+
+	channel0 := types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"}
+	channel1 := types.AllowedChannel{PortId: "transfer", ChannelId: "channel-1"}
+	keeper.SetAllowedChannel(ctx, channel0)
+	keeper.SetAllowedChannel(ctx, channel1)
+
+	got, found, err := keeper.GetAllowedChannel(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, channel0, got)
+	allowedChannels, err = keeper.GetAllAllowedChannels(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.AllowedChannel{channel0, channel1}, allowedChannels)
+
+	keeper.RemoveAllowedChannel(ctx, "transfer", "channel-0")
+	_, found, err = keeper.GetAllowedChannel(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+	allowedChannels, err = keeper.GetAllAllowedChannels(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.AllowedChannel{channel1}, allowedChannels)
+}
+
+func TestIsChannelAllowed(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	// every channel is allowed while the allowlist is disabled
+	allowed, err := keeper.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	keeper.SetAllowedChannel(ctx, types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"})
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	keeper.SetChannelAllowlist(ctx, types.ChannelAllowlist{Enabled: true})
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = keeper.IsChannelAllowed(ctx, "other", "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// an enabled allowlist without allowed channels blocks every channel
+	keeper.RemoveAllowedChannel(ctx, "transfer", "channel-0")
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestIsChannelAllowed_RequireChannelAllowlist(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	params := types.DefaultParams()
+	params.RequireChannelAllowlist = true
+	keeper.SetParams(ctx, params)
+
+	// the allowlist is enforced even though it is disabled
+	allowed, err := keeper.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	keeper.SetAllowedChannel(ctx, types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"})
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = keeper.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
@@ -149,7 +149,8 @@ func TestBlacklistedRemove_Multiple(t *testing.T) {
 func TestBlacklistedGetAll_EmptyBlacklist(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	blacklisted := keeper.GetAllBlacklisted(ctx)
+	blacklisted, err := keeper.GetAllBlacklisted(ctx)
+	require.NoError(t, err)
 	require.Empty(t, blacklisted)
 }
 
@@ -161,9 +162,11 @@ func TestBlacklistedGetAll_NonEmptyBlacklist(t *testing.T) {
 	for i, item := range items {
 		blacklisted[i] = item.bl
 	}
+	blacklisted, err := keeper.GetAllBlacklisted(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(blacklisted),
-		nullify.Fill(keeper.GetAllBlacklisted(ctx)),
+		nullify.Fill(blacklisted),
 	)
 }
 
@@ -172,7 +175,8 @@ func assertAccountIsBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Cont
 }
 
 func assertAddressIsBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Blacklisted) {
-	rst, found := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	rst, found, err := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&bl),
@@ -185,6 +189,7 @@ func assertAccountIsNotBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.C
 }
 
 func assertAddressIsNotBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Blacklisted) {
-	_, found := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	_, found, err := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	require.NoError(t, err)
 	require.False(t, found)
 }
@@ -20,26 +20,33 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetBlacklister set blacklister in the store
-func (k Keeper) SetBlacklister(ctx context.Context, blacklister types.Blacklister) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&blacklister)
-	store.Set(types.KeyPrefix(types.BlacklisterKey), b)
+func (k Keeper) SetBlacklister(ctx context.Context, blacklister types.Blacklister) error {
+	return k.blacklister.Set(ctx, blacklister)
 }
 
 // GetBlacklister returns blacklister
-func (k Keeper) GetBlacklister(ctx context.Context) (val types.Blacklister, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+func (k Keeper) GetBlacklister(ctx context.Context) (val types.Blacklister, found bool, err error) {
+	val, err = k.blacklister.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
 
-	b := store.Get(types.KeyPrefix(types.BlacklisterKey))
-	if b == nil {
-		return val, false
-	}
+// SetPendingBlacklister set pending blacklister in the store
+func (k Keeper) SetPendingBlacklister(ctx context.Context, pendingBlacklister types.PendingRole) error {
+	return k.pendingBlacklister.Set(ctx, pendingBlacklister)
+}
+
+// DeletePendingBlacklister deletes the pending blacklister in the store
+func (k Keeper) DeletePendingBlacklister(ctx context.Context) error {
+	return k.pendingBlacklister.Remove(ctx)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetPendingBlacklister returns pending blacklister
+func (k Keeper) GetPendingBlacklister(ctx context.Context) (val types.PendingRole, found bool, err error) {
+	val, err = k.pendingBlacklister.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
 }
@@ -58,12 +58,46 @@ func TestBlacklisterSet_Overwrite(t *testing.T) {
 func TestBlacklisterGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetBlacklister(ctx)
+	_, found, err := keeper.GetBlacklister(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingBlacklisterGetAndSet_Success(t *testing.T) {
+	pendingBlacklister := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPendingBlacklister(ctx, types.PendingRole{Address: pendingBlacklister.Address, ActivationHeight: 5})
+
+	rst, found, err := keeper.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: pendingBlacklister.Address, ActivationHeight: 5}, rst)
+}
+
+func TestPendingBlacklisterGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingBlacklisterDelete_Success(t *testing.T) {
+	pendingBlacklister := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetPendingBlacklister(ctx, types.PendingRole{Address: pendingBlacklister.Address})
+
+	keeper.DeletePendingBlacklister(ctx)
+
+	_, found, err := keeper.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
 func assertBlacklister(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedBlacklister sample.Account) {
-	rst, found := keeper.GetBlacklister(ctx)
+	rst, found, err := keeper.GetBlacklister(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.Blacklister{Address: expectedBlacklister.Address}),
@@ -0,0 +1,243 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// SetChannelRateLimit set a specific channelRateLimit in the store from its index
+func (k Keeper) SetChannelRateLimit(ctx context.Context, rateLimit types.ChannelRateLimit) error {
+	return k.channelRateLimits.Set(ctx, collections.Join(rateLimit.PortId, rateLimit.ChannelId), rateLimit)
+}
+
+// GetChannelRateLimit returns a channelRateLimit from its index
+func (k Keeper) GetChannelRateLimit(ctx context.Context, portID, channelID string) (val types.ChannelRateLimit, found bool, err error) {
+	val, err = k.channelRateLimits.Get(ctx, collections.Join(portID, channelID))
+	found, err = exists(err)
+	return val, found, err
+}
+
+// DeleteChannelRateLimit removes the channelRateLimit of a channel together with its flow
+func (k Keeper) DeleteChannelRateLimit(ctx context.Context, portID, channelID string) error {
+	if err := k.channelRateLimits.Remove(ctx, collections.Join(portID, channelID)); err != nil {
+		return err
+	}
+
+	return k.DeleteChannelFlow(ctx, portID, channelID)
+}
+
+// GetAllChannelRateLimits returns all channelRateLimit
+func (k Keeper) GetAllChannelRateLimits(ctx context.Context) (list []types.ChannelRateLimit, err error) {
+	return values(ctx, k.channelRateLimits, nil)
+}
+
+// SetChannelFlow set a specific channelFlow in the store from its index
+func (k Keeper) SetChannelFlow(ctx context.Context, flow types.ChannelFlow) error {
+	return k.channelFlows.Set(ctx, collections.Join(flow.PortId, flow.ChannelId), flow)
+}
+
+// GetChannelFlow returns a channelFlow from its index
+func (k Keeper) GetChannelFlow(ctx context.Context, portID, channelID string) (val types.ChannelFlow, found bool, err error) {
+	val, err = k.channelFlows.Get(ctx, collections.Join(portID, channelID))
+	found, err = exists(err)
+	return val, found, err
+}
+
+// DeleteChannelFlow removes a channelFlow from the store
+func (k Keeper) DeleteChannelFlow(ctx context.Context, portID, channelID string) error {
+	return k.channelFlows.Remove(ctx, collections.Join(portID, channelID))
+}
+
+// GetAllChannelFlows returns all channelFlow
+func (k Keeper) GetAllChannelFlows(ctx context.Context) (list []types.ChannelFlow, err error) {
+	return values(ctx, k.channelFlows, nil)
+}
+
+// SetPendingOutflow set a specific pendingOutflow in the store from its index
+func (k Keeper) SetPendingOutflow(ctx context.Context, pending types.PendingOutflow) error {
+	return k.pendingOutflows.Set(ctx, collections.Join3(pending.PortId, pending.ChannelId, pending.Sequence), pending)
+}
+
+// GetPendingOutflow returns a pendingOutflow from its index
+func (k Keeper) GetPendingOutflow(ctx context.Context, portID, channelID string, sequence uint64) (val types.PendingOutflow, found bool, err error) {
+	val, err = k.pendingOutflows.Get(ctx, collections.Join3(portID, channelID, sequence))
+	found, err = exists(err)
+	return val, found, err
+}
+
+// RemovePendingOutflow removes a pendingOutflow from the store
+func (k Keeper) RemovePendingOutflow(ctx context.Context, portID, channelID string, sequence uint64) error {
+	return k.pendingOutflows.Remove(ctx, collections.Join3(portID, channelID, sequence))
+}
+
+// GetAllPendingOutflows returns all pendingOutflow
+func (k Keeper) GetAllPendingOutflows(ctx context.Context) (list []types.PendingOutflow, err error) {
+	return values(ctx, k.pendingOutflows, nil)
+}
+
+// CurrentChannelFlow returns the rolling flow of a rate limited channel as of the current
+// block, holding only the amounts transferred within the length of the window.
+func (k Keeper) CurrentChannelFlow(ctx context.Context, rateLimit types.ChannelRateLimit) (types.ChannelFlow, error) {
+	flow, found, err := k.GetChannelFlow(ctx, rateLimit.PortId, rateLimit.ChannelId)
+	if err != nil {
+		return flow, err
+	}
+	if !found {
+		flow = types.ChannelFlow{PortId: rateLimit.PortId, ChannelId: rateLimit.ChannelId}
+	}
+
+	return flow.Current(mintWindowPosition(ctx, rateLimit.Unit), rateLimit.Window), nil
+}
+
+// ChannelQuotas returns the inflow and outflow quota of a rate limited channel as of the current
+// block, and whether each direction is limited at all. See types.Quota.
+func (k Keeper) ChannelQuotas(ctx context.Context, rateLimit types.ChannelRateLimit) (inflow math.Int, inflowLimited bool, outflow math.Int, outflowLimited bool, err error) {
+	supply := math.ZeroInt()
+	if rateLimit.MaxInflowBps > 0 || rateLimit.MaxOutflowBps > 0 {
+		mintingDenom, err := k.GetMintingDenom(ctx)
+		if err != nil {
+			return inflow, false, outflow, false, err
+		}
+		supply = k.bankKeeper.GetSupply(ctx, mintingDenom.Denom).Amount
+	}
+
+	inflow, inflowLimited = types.Quota(rateLimit.MaxInflow, rateLimit.MaxInflowBps, supply)
+	outflow, outflowLimited = types.Quota(rateLimit.MaxOutflow, rateLimit.MaxOutflowBps, supply)
+	return
+}
+
+// ConsumeChannelInflow records amount received over a rate limited channel against its window,
+// failing if it would exceed the inflow quota of the window.
+func (k Keeper) ConsumeChannelInflow(ctx context.Context, portID, channelID string, amount math.Int) error {
+	rateLimit, found, err := k.GetChannelRateLimit(ctx, portID, channelID)
+	if err != nil || !found {
+		return err
+	}
+
+	quota, limited, _, _, err := k.ChannelQuotas(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+
+	flow, err := k.CurrentChannelFlow(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+	if limited && flow.Inflow().Add(amount).GT(quota) {
+		return sdkerrors.Wrapf(
+			types.ErrChannelRateLimited,
+			"receiving %s over %s/%s would exceed the inflow limit of %s per %d %s",
+			amount, portID, channelID, quota, rateLimit.Window, rateLimit.Unit,
+		)
+	}
+
+	bucket := flow.Bucket(mintWindowPosition(ctx, rateLimit.Unit), rateLimit.Window)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	return k.SetChannelFlow(ctx, flow)
+}
+
+// ConsumeChannelOutflow records amount sent over a rate limited channel against its window,
+// failing if it would exceed the outflow quota of the window.
+func (k Keeper) ConsumeChannelOutflow(ctx context.Context, portID, channelID string, amount math.Int) error {
+	rateLimit, found, err := k.GetChannelRateLimit(ctx, portID, channelID)
+	if err != nil || !found {
+		return err
+	}
+
+	_, _, quota, limited, err := k.ChannelQuotas(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+
+	flow, err := k.CurrentChannelFlow(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+	if limited && flow.Outflow().Add(amount).GT(quota) {
+		return sdkerrors.Wrapf(
+			types.ErrChannelRateLimited,
+			"sending %s over %s/%s would exceed the outflow limit of %s per %d %s",
+			amount, portID, channelID, quota, rateLimit.Window, rateLimit.Unit,
+		)
+	}
+
+	bucket := flow.Bucket(mintWindowPosition(ctx, rateLimit.Unit), rateLimit.Window)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	return k.SetChannelFlow(ctx, flow)
+}
+
+// TrackChannelOutflow records a packet sent over a rate limited channel as pending until it is
+// acknowledged or timed out, so that its amount can be returned to the outflow if it fails.
+func (k Keeper) TrackChannelOutflow(ctx context.Context, portID, channelID string, sequence uint64) error {
+	_, found, err := k.GetChannelRateLimit(ctx, portID, channelID)
+	if err != nil || !found {
+		return err
+	}
+
+	// the outflow of the packet was just recorded in the newest bucket
+	flow, found, err := k.GetChannelFlow(ctx, portID, channelID)
+	if err != nil || !found || len(flow.Buckets) == 0 {
+		return err
+	}
+
+	return k.SetPendingOutflow(ctx, types.PendingOutflow{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		BucketStart: flow.Buckets[len(flow.Buckets)-1].Start,
+	})
+}
+
+// RevertChannelOutflow returns the amount of a failed or timed out packet to the outflow of its channel.
+// The amount is only returned if the bucket the packet was sent in still counts towards the window of
+// the channel, since the outflow of an earlier bucket has already left the window.
+func (k Keeper) RevertChannelOutflow(ctx context.Context, portID, channelID string, sequence uint64, amount math.Int) error {
+	pending, found, err := k.GetPendingOutflow(ctx, portID, channelID, sequence)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := k.RemovePendingOutflow(ctx, portID, channelID, sequence); err != nil {
+		return err
+	}
+
+	rateLimit, found, err := k.GetChannelRateLimit(ctx, portID, channelID)
+	if err != nil || !found {
+		return err
+	}
+
+	flow, err := k.CurrentChannelFlow(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+
+	for i, bucket := range flow.Buckets {
+		if bucket.Start == pending.BucketStart {
+			flow.Buckets[i].Outflow = math.MaxInt(bucket.Outflow.Sub(amount), math.ZeroInt())
+			return k.SetChannelFlow(ctx, flow)
+		}
+	}
+
+	return nil
+}
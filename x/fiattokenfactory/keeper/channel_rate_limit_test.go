@@ -0,0 +1,172 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func channelRateLimit(maxInflow, maxOutflow int64) types.ChannelRateLimit {
+	return types.ChannelRateLimit{
+		PortId:     "transfer",
+		ChannelId:  "channel-0",
+		MaxInflow:  math.NewInt(maxInflow),
+		MaxOutflow: math.NewInt(maxOutflow),
+		Window:     5,
+		Unit:       types.WINDOW_UNIT_BLOCKS,
+	}
+}
+
+func TestChannelRateLimitGetAndSet(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetChannelRateLimit(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	rateLimit := channelRateLimit(10, 20)
+	keeper.SetChannelRateLimit(ctx, rateLimit)
+	keeper.SetChannelFlow(ctx, types.ChannelFlow{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Start: 1, Inflow: math.NewInt(3), Outflow: math.ZeroInt()}}})
+	keeper.SetPendingOutflow(ctx, types.PendingOutflow{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, BucketStart: 1})
+
+	rst, found, err := keeper.GetChannelRateLimit(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, rateLimit, rst)
+	channelRateLimits, err := keeper.GetAllChannelRateLimits(ctx)
+	require.NoError(t, err)
+	require.Len(t, channelRateLimits, 1)
+	channelFlows, err := keeper.GetAllChannelFlows(ctx)
+	require.NoError(t, err)
+	require.Len(t, channelFlows, 1)
+	pendingOutflows, err := keeper.GetAllPendingOutflows(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOutflows, 1)
+
+	keeper.DeleteChannelRateLimit(ctx, "transfer", "channel-0")
+	_, found, err = keeper.GetChannelRateLimit(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = keeper.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestConsumeChannelInflow(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ctx = ctx.WithBlockHeight(10)
+
+	// channels without a rate limit are not limited
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx, "transfer", "channel-0", math.NewInt(1_000)))
+
+	keeper.SetChannelRateLimit(ctx, channelRateLimit(10, 0))
+
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx, "transfer", "channel-0", math.NewInt(6)))
+	err := keeper.ConsumeChannelInflow(ctx.WithBlockHeight(14), "transfer", "channel-0", math.NewInt(6))
+	require.ErrorIs(t, err, types.ErrChannelRateLimited)
+
+	// the outflow is not limited by a zero quota
+	require.NoError(t, keeper.ConsumeChannelOutflow(ctx, "transfer", "channel-0", math.NewInt(1_000)))
+
+	// the amounts of block 10 leave the window
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx.WithBlockHeight(15), "transfer", "channel-0", math.NewInt(10)))
+	flow, found, err := keeper.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.ChannelFlow{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Start: 15, Inflow: math.NewInt(10), Outflow: math.ZeroInt()}}}, flow)
+}
+
+func TestConsumeChannelInflow_RollingWindow(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetChannelRateLimit(ctx, channelRateLimit(10, 0))
+
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx.WithBlockHeight(10), "transfer", "channel-0", math.NewInt(4)))
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx.WithBlockHeight(14), "transfer", "channel-0", math.NewInt(6)))
+
+	// only the amount of block 10 leaves the window at the boundary, so the quota
+	// cannot be received twice in adjacent blocks
+	err := keeper.ConsumeChannelInflow(ctx.WithBlockHeight(15), "transfer", "channel-0", math.NewInt(5))
+	require.ErrorIs(t, err, types.ErrChannelRateLimited)
+	require.NoError(t, keeper.ConsumeChannelInflow(ctx.WithBlockHeight(15), "transfer", "channel-0", math.NewInt(4)))
+}
+
+func TestConsumeChannelOutflow_SupplyQuota(t *testing.T) {
+	var (
+		minter    = sample.TestAccount()
+		allowance = sdk.Coin{Denom: "uusdc", Amount: math.NewInt(1_000)}
+	)
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, allowance)
+
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: sample.AccAddress(), Amount: allowance})
+	require.NoError(t, err)
+
+	// 5% of the supply is lower than the absolute quota
+	rateLimit := channelRateLimit(0, 100)
+	rateLimit.MaxOutflowBps = 500
+	ftf.SetChannelRateLimit(ctx, rateLimit)
+
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "transfer", "channel-0", math.NewInt(50)))
+	err = ftf.ConsumeChannelOutflow(ctx, "transfer", "channel-0", math.NewInt(1))
+	require.ErrorIs(t, err, types.ErrChannelRateLimited)
+}
+
+func TestRevertChannelOutflow(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetChannelRateLimit(ctx, channelRateLimit(0, 10))
+	ctx = ctx.WithBlockHeight(10)
+
+	require.NoError(t, keeper.ConsumeChannelOutflow(ctx, "transfer", "channel-0", math.NewInt(6)))
+	keeper.TrackChannelOutflow(ctx, "transfer", "channel-0", 1)
+	require.NoError(t, keeper.ConsumeChannelOutflow(ctx, "transfer", "channel-0", math.NewInt(4)))
+	keeper.TrackChannelOutflow(ctx, "transfer", "channel-0", 2)
+
+	// a failed packet of a bucket within the window is returned to the outflow
+	keeper.RevertChannelOutflow(ctx, "transfer", "channel-0", 1, math.NewInt(6))
+	flow, _, err := keeper.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(4), flow.Outflow())
+	_, found, err := keeper.GetPendingOutflow(ctx, "transfer", "channel-0", 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// reverting twice has no effect
+	keeper.RevertChannelOutflow(ctx, "transfer", "channel-0", 1, math.NewInt(6))
+	flow, _, err = keeper.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(4), flow.Outflow())
+
+	// a failed packet of a bucket that left the window is not returned to the outflow
+	require.NoError(t, keeper.ConsumeChannelOutflow(ctx.WithBlockHeight(15), "transfer", "channel-0", math.NewInt(3)))
+	keeper.RevertChannelOutflow(ctx.WithBlockHeight(15), "transfer", "channel-0", 2, math.NewInt(4))
+	flow, _, err = keeper.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(3), flow.Outflow())
+	pendingOutflows, err := keeper.GetAllPendingOutflows(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingOutflows)
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetFiatToken set a specific fiatToken in the store from its index
+func (k Keeper) SetFiatToken(ctx context.Context, fiatToken types.FiatToken) error {
+	return k.fiatTokens.Set(ctx, fiatToken.Denom, fiatToken)
+}
+
+// GetFiatToken returns a fiatToken from its index
+func (k Keeper) GetFiatToken(ctx context.Context, denom string) (val types.FiatToken, found bool, err error) {
+	val, err = k.fiatTokens.Get(ctx, denom)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// GetAllFiatTokens returns all fiatToken
+func (k Keeper) GetAllFiatTokens(ctx context.Context) (list []types.FiatToken, err error) {
+	return values(ctx, k.fiatTokens, nil)
+}
+
+// ForDenom returns a keeper whose state accessors operate on the given fiat token.
+// An empty denom or the minting denom resolve to the module's primary token.
+func (k *Keeper) ForDenom(ctx context.Context, denom string) (*Keeper, error) {
+	if denom == "" {
+		return k.primary(), nil
+	}
+
+	token, found, err := k.ManagedDenom(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFiatTokenNotFound, "denom %s is not managed by this module", denom)
+	}
+
+	return token, nil
+}
+
+// ManagedDenom returns a keeper scoped to the given denom if it is either the
+// minting denom or a registered fiat token.
+func (k *Keeper) ManagedDenom(ctx context.Context, denom string) (*Keeper, bool, error) {
+	if denom == "" {
+		return nil, false, nil
+	}
+
+	primary := k.primary()
+	mintingDenom, err := primary.mintingDenom.Get(ctx)
+	found, err := exists(err)
+	if err != nil {
+		return nil, false, err
+	}
+	if found && mintingDenom.Denom == denom {
+		return primary, true, nil
+	}
+
+	// checked before scoping a keeper, as every send of an unmanaged denom ends up here
+	if found, err := k.fiatTokens.Has(ctx, denom); err != nil || !found {
+		return nil, false, err
+	}
+
+	return primary.scoped(denom), true, nil
+}
+
+// ManagedTokens returns the keeper of the minting denom, if it is set, followed by
+// the keepers of every registered fiat token.
+func (k *Keeper) ManagedTokens(ctx context.Context) ([]*Keeper, error) {
+	var tokens []*Keeper
+	primary := k.primary()
+	set, err := primary.MintingDenomSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if set {
+		tokens = append(tokens, primary)
+	}
+
+	fiatTokens, err := k.GetAllFiatTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fiatToken := range fiatTokens {
+		tokens = append(tokens, primary.scoped(fiatToken.Denom))
+	}
+
+	return tokens, nil
+}
+
+// primary returns the keeper of the module's primary token.
+func (k *Keeper) primary() *Keeper {
+	if k.denom == "" {
+		return k
+	}
+
+	primary := *k
+	primary.denom = ""
+	primary.storeService = k.rootStoreService
+	primary.tokenState = k.cachedTokenState("", k.rootStoreService)
+
+	return &primary
+}
+
+// scoped returns a keeper of the given fiat token, its state is stored below the token's prefix.
+func (k *Keeper) scoped(denom string) *Keeper {
+	scoped := *k
+	scoped.denom = denom
+	scoped.storeService = fiatTokenStoreService{
+		parent: k.rootStoreService,
+		prefix: types.FiatTokenStoreKey(denom),
+	}
+	scoped.tokenState = k.cachedTokenState(denom, scoped.storeService)
+
+	return &scoped
+}
+
+// cachedTokenState returns the state of the token with the given denom, which is only built
+// the first time the token is scoped.
+func (k *Keeper) cachedTokenState(denom string, storeService store.KVStoreService) tokenState {
+	if state, ok := k.tokenStates.Load(denom); ok {
+		return state.(tokenState)
+	}
+
+	// the layout of the token state was validated when the primary schema was built
+	state, _ := k.tokenStates.LoadOrStore(denom, newTokenState(collections.NewSchemaBuilder(storeService), k.cdc))
+	return state.(tokenState)
+}
+
+// fiatTokenStoreService opens the module store restricted to a single fiat token's prefix.
+type fiatTokenStoreService struct {
+	parent store.KVStoreService
+	prefix []byte
+}
+
+func (s fiatTokenStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return fiatTokenStore{prefix.NewStore(runtime.KVStoreAdapter(s.parent.OpenKVStore(ctx)), s.prefix)}
+}
+
+// fiatTokenStore adapts a prefixed store to the core KVStore interface.
+type fiatTokenStore struct {
+	parent storetypes.KVStore
+}
+
+func (s fiatTokenStore) Get(key []byte) ([]byte, error) {
+	return s.parent.Get(key), nil
+}
+
+func (s fiatTokenStore) Has(key []byte) (bool, error) {
+	return s.parent.Has(key), nil
+}
+
+func (s fiatTokenStore) Set(key, value []byte) error {
+	s.parent.Set(key, value)
+	return nil
+}
+
+func (s fiatTokenStore) Delete(key []byte) error {
+	s.parent.Delete(key)
+	return nil
+}
+
+func (s fiatTokenStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.parent.Iterator(start, end), nil
+}
+
+func (s fiatTokenStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.parent.ReverseIterator(start, end), nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFiatTokens(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.FiatToken {
+	items := make([]types.FiatToken, n)
+	for i := range items {
+		items[i].Denom = "denom" + strconv.Itoa(i)
+
+		keeper.SetFiatToken(ctx, items[i])
+	}
+	return items
+}
+
+func TestFiatTokenGet(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNFiatTokens(keeper, ctx, 10)
+	for _, item := range items {
+		fiatToken, found, err := keeper.GetFiatToken(ctx, item.Denom)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&fiatToken),
+		)
+	}
+
+	_, found, err := keeper.GetFiatToken(ctx, "unknown")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestFiatTokenGetAll(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNFiatTokens(keeper, ctx, 10)
+	fiatTokens, err := keeper.GetAllFiatTokens(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(fiatTokens),
+	)
+}
+
+func TestForDenom_EmptyDenomIsMintingDenom(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	token, err := keeper.ForDenom(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, keeper, token)
+
+	token, err = keeper.ForDenom(ctx, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, keeper, token)
+}
+
+func TestForDenom_UnknownDenom(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := keeper.ForDenom(ctx, "ueurc")
+	require.ErrorIs(t, err, types.ErrFiatTokenNotFound)
+
+	_, found, err := keeper.ManagedDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, found, err = keeper.ManagedDenom(ctx, "")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestForDenom_IsolatesFiatTokenState(t *testing.T) {
+	usdcOwner := sample.TestAccount()
+	eurcOwner := sample.TestAccount()
+	blacklisted := sample.TestAccount()
+
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetPaused(ctx, types.Paused{})
+	keeper.SetOwner(ctx, types.Owner{Address: usdcOwner.Address})
+	keeper.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+
+	token, err := keeper.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, types.NewPaused())
+	token.SetOwner(ctx, types.Owner{Address: eurcOwner.Address})
+	token.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+
+	mintingDenom, err := token.GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ueurc", mintingDenom.Denom)
+	set, err := token.MintingDenomSet(ctx)
+	require.NoError(t, err)
+	require.True(t, set)
+	paused, err := token.GetPaused(ctx)
+	require.NoError(t, err)
+	require.True(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+	owner, found, err := token.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, eurcOwner.Address, owner.Address)
+	_, found, err = token.GetBlacklisted(ctx, blacklisted.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	mintingDenom, err = keeper.GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", mintingDenom.Denom)
+	paused, err = keeper.GetPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+	owner, found, err = keeper.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, usdcOwner.Address, owner.Address)
+	_, found, err = keeper.GetBlacklisted(ctx, blacklisted.AddressBz)
+	require.NoError(t, err)
+	require.False(t, found)
+	fiatTokens, err := keeper.GetAllFiatTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, fiatTokens, 1)
+}
+
+func TestManagedTokens(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	// the minting denom is only included once it is set
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	tokens, err := ftf.ManagedTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	tokens, err = ftf.ManagedTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	// every keeper is scoped to its own token
+	mintingDenom, err := tokens[0].GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", mintingDenom.Denom)
+	mintingDenom, err = tokens[1].GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ueurc", mintingDenom.Denom)
+}
+
+func TestManagedDenom_FromScopedKeeper(t *testing.T) {
+	usdcOwner := sample.TestAccount()
+	eurcOwner := sample.TestAccount()
+
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetOwner(ctx, types.Owner{Address: usdcOwner.Address})
+	keeper.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+
+	token, err := keeper.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetOwner(ctx, types.Owner{Address: eurcOwner.Address})
+
+	// scoping again reuses the state of the token
+	again, found, err := token.ManagedDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	require.True(t, found)
+	owner, found, err := again.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, eurcOwner.Address, owner.Address)
+
+	primary, found, err := token.ManagedDenom(ctx, "uusdc")
+	require.NoError(t, err)
+	require.True(t, found)
+	owner, found, err = primary.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, usdcOwner.Address, owner.Address)
+}
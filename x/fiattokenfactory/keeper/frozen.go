@@ -0,0 +1,45 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetFrozen set a specific frozen in the store from its index
+func (k Keeper) SetFrozen(ctx context.Context, frozen types.Frozen) error {
+	return k.frozen.Set(ctx, frozen.AddressBz, frozen)
+}
+
+// GetFrozen returns a frozen from its index
+func (k Keeper) GetFrozen(ctx context.Context, addressBz []byte) (val types.Frozen, found bool, err error) {
+	val, err = k.frozen.Get(ctx, addressBz)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// RemoveFrozen removes a frozen from the store
+func (k Keeper) RemoveFrozen(ctx context.Context, addressBz []byte) error {
+	return k.frozen.Remove(ctx, addressBz)
+}
+
+// GetAllFrozen returns all frozen
+func (k Keeper) GetAllFrozen(ctx context.Context) (list []types.Frozen, err error) {
+	return values(ctx, k.frozen, nil)
+}
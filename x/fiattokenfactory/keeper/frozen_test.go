@@ -0,0 +1,195 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+type frozenWrapper struct {
+	address string
+	bl      types.Frozen
+}
+
+func createNFrozen(keeper *keeper.Keeper, ctx sdk.Context, n int) []frozenWrapper {
+	items := make([]frozenWrapper, n)
+	for i := range items {
+		acc := sample.TestAccount()
+		items[i].address = acc.Address
+		items[i].bl.AddressBz = acc.AddressBz
+
+		keeper.SetFrozen(ctx, items[i].bl)
+	}
+	return items
+}
+
+func createNFrozenBech32m(keeper *keeper.Keeper, ctx sdk.Context, n int) []frozenWrapper {
+	items := make([]frozenWrapper, n)
+	for i := range items {
+		acc := sample.TestAccountBech32m()
+		items[i].address = acc.Address
+		items[i].bl.AddressBz = acc.AddressBz
+
+		keeper.SetFrozen(ctx, items[i].bl)
+	}
+	return items
+}
+
+func TestFrozenGetAndSet_Success(t *testing.T) {
+	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32.AddressBz})
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32M.AddressBz})
+
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32M)
+}
+
+func TestFrozenGet_AccountNotFrozen(t *testing.T) {
+	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32M)
+}
+
+func TestFrozenSet_AlreadyFrozen(t *testing.T) {
+	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32.AddressBz})
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32M.AddressBz})
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32M)
+
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32.AddressBz})
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32M.AddressBz})
+
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32M)
+}
+
+func TestFrozenSet_Multiple(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNFrozen(keeper, ctx, 10)
+	items = append(items, createNFrozenBech32m(keeper, ctx, 10)...)
+	for _, item := range items {
+		assertAddressIsFrozen(t, *keeper, ctx, item.bl)
+	}
+}
+
+func TestFrozenRemove_AccountNotFrozen(t *testing.T) {
+	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.RemoveFrozen(ctx, accBech32.AddressBz)
+	keeper.RemoveFrozen(ctx, accBech32M.AddressBz)
+
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32M)
+}
+
+func TestFrozenRemove_Success(t *testing.T) {
+	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32.AddressBz})
+	keeper.SetFrozen(ctx, types.Frozen{AddressBz: accBech32M.AddressBz})
+
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsFrozen(t, *keeper, ctx, accBech32M)
+
+	keeper.RemoveFrozen(ctx, accBech32.AddressBz)
+	keeper.RemoveFrozen(ctx, accBech32M.AddressBz)
+
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32)
+	assertAccountIsNotFrozen(t, *keeper, ctx, accBech32M)
+}
+
+func TestFrozenRemove_Multiple(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNFrozen(keeper, ctx, 10)
+	items = append(items, createNFrozenBech32m(keeper, ctx, 10)...)
+	for _, item := range items {
+		assertAddressIsFrozen(t, *keeper, ctx, item.bl)
+
+		keeper.RemoveFrozen(ctx, item.bl.AddressBz)
+
+		assertAddressIsNotFrozen(t, *keeper, ctx, item.bl)
+	}
+}
+
+func TestFrozenGetAll_EmptyFrozen(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	frozen, err := keeper.GetAllFrozen(ctx)
+	require.NoError(t, err)
+	require.Empty(t, frozen)
+}
+
+func TestFrozenGetAll_NonEmptyFrozen(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNFrozen(keeper, ctx, 10)
+	items = append(items, createNFrozenBech32m(keeper, ctx, 10)...)
+	frozen := make([]types.Frozen, len(items))
+	for i, item := range items {
+		frozen[i] = item.bl
+	}
+	frozen, err := keeper.GetAllFrozen(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t,
+		nullify.Fill(frozen),
+		nullify.Fill(frozen),
+	)
+}
+
+func assertAccountIsFrozen(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, acc sample.Account) {
+	assertAddressIsFrozen(t, keeper, ctx, types.Frozen{AddressBz: acc.AddressBz})
+}
+
+func assertAddressIsFrozen(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Frozen) {
+	rst, found, err := keeper.GetFrozen(ctx, bl.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&bl),
+		nullify.Fill(&rst),
+	)
+}
+
+func assertAccountIsNotFrozen(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, acc sample.Account) {
+	assertAddressIsNotFrozen(t, keeper, ctx, types.Frozen{AddressBz: acc.AddressBz})
+}
+
+func assertAddressIsNotFrozen(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Frozen) {
+	_, found, err := keeper.GetFrozen(ctx, bl.AddressBz)
+	require.NoError(t, err)
+	require.False(t, found)
+}
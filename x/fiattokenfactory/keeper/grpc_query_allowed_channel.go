@@ -0,0 +1,77 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllowedChannelAll(ctx context.Context, req *types.QueryAllAllowedChannelRequest) (*types.QueryAllAllowedChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	allowedChannels, pageRes, err := query.CollectionPaginate(ctx, token.allowedChannels, req.Pagination, func(_ collections.Pair[string, string], allowedChannel types.AllowedChannel) (types.AllowedChannel, error) {
+		return allowedChannel, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	allowlist, err := token.GetChannelAllowlist(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAllowedChannelResponse{
+		AllowedChannels: allowedChannels,
+		Pagination:      pageRes,
+		Enabled:         allowlist.Enabled,
+	}, nil
+}
+
+func (k Keeper) AllowedChannel(ctx context.Context, req *types.QueryGetAllowedChannelRequest) (*types.QueryGetAllowedChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, found, err := token.GetAllowedChannel(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAllowedChannelResponse{AllowedChannel: val}, nil
+}
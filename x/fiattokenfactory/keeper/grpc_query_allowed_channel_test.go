@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestAllowedChannelQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	allowed := types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"}
+	keeper.SetAllowedChannel(ctx, allowed)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetAllowedChannelRequest
+		response *types.QueryGetAllowedChannelResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryGetAllowedChannelRequest{PortId: "transfer", ChannelId: "channel-0"},
+			response: &types.QueryGetAllowedChannelResponse{AllowedChannel: allowed},
+		},
+		{
+			desc:    "NotFound",
+			request: &types.QueryGetAllowedChannelRequest{PortId: "transfer", ChannelId: "channel-1"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "FiatTokenNotFound",
+			request: &types.QueryGetAllowedChannelRequest{PortId: "transfer", ChannelId: "channel-0", Denom: "ueurc"},
+			err:     status.Error(codes.NotFound, "denom ueurc is not managed by this module: fiat token not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AllowedChannel(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestAllowedChannelQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	allowed := []types.AllowedChannel{
+		{PortId: "transfer", ChannelId: "channel-0"},
+		{PortId: "transfer", ChannelId: "channel-1"},
+		{PortId: "transfer", ChannelId: "channel-2"},
+	}
+	for _, elem := range allowed {
+		keeper.SetAllowedChannel(ctx, elem)
+	}
+	keeper.SetChannelAllowlist(ctx, types.ChannelAllowlist{Enabled: true})
+
+	resp, err := keeper.AllowedChannelAll(ctx, &types.QueryAllAllowedChannelRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.AllowedChannels, 2)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.Subset(t, allowed, resp.AllowedChannels)
+	require.True(t, resp.Enabled)
+
+	_, err = keeper.AllowedChannelAll(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
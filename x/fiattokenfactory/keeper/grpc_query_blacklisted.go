@@ -19,9 +19,7 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,19 +30,13 @@ func (k Keeper) BlacklistedAll(ctx context.Context, req *types.QueryAllBlacklist
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var blacklisteds []types.Blacklisted
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	blacklistedStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistedKeyPrefix))
-
-	pageRes, err := query.Paginate(blacklistedStore, req.Pagination, func(key []byte, value []byte) error {
-		var blacklisted types.Blacklisted
-		if err := k.cdc.Unmarshal(value, &blacklisted); err != nil {
-			return err
-		}
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 
-		blacklisteds = append(blacklisteds, blacklisted)
-		return nil
+	blacklisteds, pageRes, err := query.CollectionPaginate(ctx, token.blacklisted, req.Pagination, func(_ []byte, blacklisted types.Blacklisted) (types.Blacklisted, error) {
+		return blacklisted, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -58,12 +50,20 @@ func (k Keeper) Blacklisted(ctx context.Context, req *types.QueryGetBlacklistedR
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
 	_, addressBz, err := DecodeNoLimitToBase256(req.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	val, found := k.GetBlacklisted(ctx, addressBz)
+	val, found, err := token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -29,7 +29,15 @@ func (k Keeper) Blacklister(ctx context.Context, req *types.QueryGetBlacklisterR
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetBlacklister(ctx)
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, found, err := token.GetBlacklister(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -0,0 +1,92 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ChannelRateLimitAll(ctx context.Context, req *types.QueryAllChannelRateLimitRequest) (*types.QueryAllChannelRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	rateLimits, pageRes, err := query.CollectionPaginate(ctx, token.channelRateLimits, req.Pagination, func(_ collections.Pair[string, string], rateLimit types.ChannelRateLimit) (types.ChannelRateLimit, error) {
+		return rateLimit, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllChannelRateLimitResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChannelRateLimit(ctx context.Context, req *types.QueryChannelRateLimitRequest) (*types.QueryChannelRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	rateLimit, found, err := token.GetChannelRateLimit(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	flow, err := token.CurrentChannelFlow(ctx, rateLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryChannelRateLimitResponse{
+		RateLimit:   rateLimit,
+		Flow:        flow,
+		NextRelease: flow.NextRelease(rateLimit.Window),
+	}
+
+	inflow, inflowLimited, outflow, outflowLimited, err := token.ChannelQuotas(ctx, rateLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if inflowLimited {
+		remaining := math.MaxInt(inflow.Sub(flow.Inflow()), math.ZeroInt())
+		res.RemainingInflow = &remaining
+	}
+	if outflowLimited {
+		remaining := math.MaxInt(outflow.Sub(flow.Outflow()), math.ZeroInt())
+		res.RemainingOutflow = &remaining
+	}
+
+	return res, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestChannelRateLimitQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ctx = ctx.WithBlockHeight(10)
+
+	fresh := channelRateLimit(10, 0)
+	fresh.ChannelId = "channel-1"
+	used := channelRateLimit(10, 20)
+	keeper.SetChannelRateLimit(ctx, fresh)
+	keeper.SetChannelRateLimit(ctx, used)
+	usedFlow := types.ChannelFlow{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Start: 8, Inflow: math.NewInt(4), Outflow: math.NewInt(25)}}}
+	keeper.SetChannelFlow(ctx, usedFlow)
+
+	remaining := func(amount int64) *math.Int {
+		remaining := math.NewInt(amount)
+		return &remaining
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryChannelRateLimitRequest
+		response *types.QueryChannelRateLimitResponse
+		err      error
+	}{
+		{
+			desc:    "NoWindow",
+			request: &types.QueryChannelRateLimitRequest{PortId: "transfer", ChannelId: "channel-1"},
+			response: &types.QueryChannelRateLimitResponse{
+				RateLimit:       fresh,
+				Flow:            types.ChannelFlow{PortId: "transfer", ChannelId: "channel-1"},
+				RemainingInflow: remaining(10),
+			},
+		},
+		{
+			desc:    "OpenWindow",
+			request: &types.QueryChannelRateLimitRequest{PortId: "transfer", ChannelId: "channel-0"},
+			response: &types.QueryChannelRateLimitResponse{
+				RateLimit:        used,
+				Flow:             usedFlow,
+				RemainingInflow:  remaining(6),
+				RemainingOutflow: remaining(0),
+				NextRelease:      13,
+			},
+		},
+		{
+			desc:    "NotRateLimited",
+			request: &types.QueryChannelRateLimitRequest{PortId: "transfer", ChannelId: "channel-2"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "UnknownDenom",
+			request: &types.QueryChannelRateLimitRequest{PortId: "transfer", ChannelId: "channel-0", Denom: "ujpyc"},
+			err:     status.Error(codes.NotFound, "denom ujpyc is not managed by this module: fiat token not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ChannelRateLimit(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	response, err := keeper.ChannelRateLimitAll(ctx, &types.QueryAllChannelRateLimitRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+	require.ElementsMatch(t, nullify.Fill([]types.ChannelRateLimit{fresh, used}), nullify.Fill(response.RateLimits))
+
+	_, err = keeper.ChannelRateLimitAll(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -29,7 +29,15 @@ func (k Keeper) MasterMinter(ctx context.Context, req *types.QueryGetMasterMinte
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetMasterMinter(ctx)
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, found, err := token.GetMasterMinter(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
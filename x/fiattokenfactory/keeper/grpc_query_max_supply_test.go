@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMaxSupplyQuery_NoMaxSupply(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, err := keeper.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
+
+func TestMaxSupplyQuery(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.Paused{})
+	ftf.SetMaxSupply(ctx, types.MaxSupply{Amount: math.NewInt(100)})
+
+	minter := sample.AccAddress()
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(100))})
+	_, err := keeper.NewMsgServerImpl(ftf).Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter, Address: sample.AccAddress(), Amount: sdk.NewCoin("uusdc", math.NewInt(30))})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryMaxSupplyRequest
+		response *types.QueryMaxSupplyResponse
+		err      error
+	}{
+		{
+			desc:    "Success",
+			request: &types.QueryMaxSupplyRequest{},
+			response: &types.QueryMaxSupplyResponse{
+				MaxSupply: types.MaxSupply{Amount: math.NewInt(100)},
+				Supply:    sdk.NewCoin("uusdc", math.NewInt(30)),
+				Headroom:  math.NewInt(70),
+			},
+		},
+		{
+			desc:    "UnknownDenom",
+			request: &types.QueryMaxSupplyRequest{Denom: "ujpyc"},
+			err:     status.Error(codes.NotFound, "denom ujpyc is not managed by this module: fiat token not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := ftf.MaxSupply(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	// a cap lowered below the supply reports no headroom
+	ftf.SetMaxSupply(ctx, types.MaxSupply{Amount: math.NewInt(20)})
+	response, err := ftf.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), response.Headroom)
+}
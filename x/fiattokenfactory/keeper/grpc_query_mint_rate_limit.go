@@ -0,0 +1,61 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/math"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MintRateLimit(ctx context.Context, req *types.QueryMintRateLimitRequest) (*types.QueryMintRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	rateLimit, found, err := token.GetMintRateLimit(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	res := &types.QueryMintRateLimitResponse{
+		RateLimit: rateLimit,
+		Remaining: rateLimit.Limit,
+	}
+
+	window, err := token.CurrentMintWindow(ctx, rateLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res.Remaining = math.MaxInt(rateLimit.Limit.Sub(window.Minted()), math.ZeroInt())
+	res.NextRelease = window.NextRelease(rateLimit.Window)
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMintRateLimitQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10)
+
+	fresh, used := sample.AccAddress(), sample.AccAddress()
+	freshLimit := types.MintRateLimit{Minter: fresh, Limit: math.NewInt(10), Window: 5, Unit: types.WINDOW_UNIT_BLOCKS}
+	usedLimit := types.MintRateLimit{Minter: used, Limit: math.NewInt(10), Window: 5, Unit: types.WINDOW_UNIT_BLOCKS}
+	keeper.SetMintRateLimit(ctx, freshLimit)
+	keeper.SetMintRateLimit(ctx, usedLimit)
+	keeper.SetMintWindow(ctx, types.MintWindow{Minter: used, Buckets: []types.MintBucket{{Start: 8, Minted: math.NewInt(4)}}})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryMintRateLimitRequest
+		response *types.QueryMintRateLimitResponse
+		err      error
+	}{
+		{
+			desc:     "NoWindow",
+			request:  &types.QueryMintRateLimitRequest{Address: fresh},
+			response: &types.QueryMintRateLimitResponse{RateLimit: freshLimit, Remaining: math.NewInt(10)},
+		},
+		{
+			desc:     "OpenWindow",
+			request:  &types.QueryMintRateLimitRequest{Address: used},
+			response: &types.QueryMintRateLimitResponse{RateLimit: usedLimit, Remaining: math.NewInt(6), NextRelease: 13},
+		},
+		{
+			desc:    "NotRateLimited",
+			request: &types.QueryMintRateLimitRequest{Address: sample.AccAddress()},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "UnknownDenom",
+			request: &types.QueryMintRateLimitRequest{Address: used, Denom: "ujpyc"},
+			err:     status.Error(codes.NotFound, "denom ujpyc is not managed by this module: fiat token not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MintRateLimit(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	// once the window has elapsed since the mint the full limit is available again
+	response, err := keeper.MintRateLimit(ctx.WithBlockHeight(13), &types.QueryMintRateLimitRequest{Address: used})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10), response.Remaining)
+	require.Zero(t, response.NextRelease)
+}
@@ -21,8 +21,6 @@ import (
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,19 +31,13 @@ func (k Keeper) MinterControllerAll(ctx context.Context, req *types.QueryAllMint
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var minterControllers []types.MinterController
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
-
-	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
-		var minterController types.MinterController
-		if err := k.cdc.Unmarshal(value, &minterController); err != nil {
-			return err
-		}
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 
-		minterControllers = append(minterControllers, minterController)
-		return nil
+	minterControllers, pageRes, err := query.CollectionPaginate(ctx, token.minterControllers, req.Pagination, func(_ string, minterController types.MinterController) (types.MinterController, error) {
+		return minterController, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -59,13 +51,39 @@ func (k Keeper) MinterController(ctx context.Context, req *types.QueryGetMinterC
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetMinterController(
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, found, err := token.GetMinterController(
 		ctx,
 		req.ControllerAddress,
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
 	return &types.QueryGetMinterControllerResponse{MinterController: val}, nil
 }
+
+func (k Keeper) MinterControllerByMinter(ctx context.Context, req *types.QueryGetMinterControllerByMinterRequest) (*types.QueryGetMinterControllerByMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	minterControllers, pageRes, err := paginateMinterControllerLinks(ctx, token.minterControllerLinks, req.MinterAddress, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetMinterControllerByMinterResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
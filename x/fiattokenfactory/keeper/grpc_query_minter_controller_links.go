@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MintersOfController(ctx context.Context, req *types.QueryMintersOfControllerRequest) (*types.QueryMintersOfControllerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	minterControllers, pageRes, err := paginateMinterControllerLinks(ctx, token.controllerMinters, req.ControllerAddress, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintersOfControllerResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ControllersOfMinter(ctx context.Context, req *types.QueryControllersOfMinterRequest) (*types.QueryControllersOfMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	minterControllers, pageRes, err := paginateMinterControllerLinks(ctx, token.minterControllerLinks, req.MinterAddress, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryControllersOfMinterResponse{MinterController: minterControllers, Pagination: pageRes}, nil
+}
+
+// paginateMinterControllerLinks returns a page of the links indexed under the first part of their key.
+func paginateMinterControllerLinks(
+	ctx context.Context,
+	links collections.Map[collections.Pair[string, string], types.MinterController],
+	address string,
+	pagination *query.PageRequest,
+) ([]types.MinterController, *query.PageResponse, error) {
+	minterControllers, pageRes, err := query.CollectionPaginate(
+		ctx, links, pagination,
+		func(_ collections.Pair[string, string], minterController types.MinterController) (types.MinterController, error) {
+			return minterController, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](address),
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return minterControllers, pageRes, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMintersOfControllerQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	controller := sample.AccAddress()
+	links := make([]types.MinterController, 5)
+	for i := range links {
+		links[i] = types.MinterController{Controller: controller, Minter: sample.AccAddress()}
+		keeper.SetMinterControllerLink(ctx, links[i])
+	}
+	// a link of another controller must not be returned
+	keeper.SetMinterControllerLink(ctx, types.MinterController{Controller: sample.AccAddress(), Minter: links[0].Minter})
+
+	t.Run("ByOffset", func(t *testing.T) {
+		var got []types.MinterController
+		for i := 0; i < len(links); i += 2 {
+			resp, err := keeper.MintersOfController(ctx, &types.QueryMintersOfControllerRequest{
+				ControllerAddress: controller,
+				Pagination:        &query.PageRequest{Offset: uint64(i), Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.MinterController), 2)
+			got = append(got, resp.MinterController...)
+		}
+		require.ElementsMatch(t, nullify.Fill(links), nullify.Fill(got))
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.MintersOfController(ctx, &types.QueryMintersOfControllerRequest{
+			ControllerAddress: controller,
+			Pagination:        &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(links), int(resp.Pagination.Total))
+	})
+	t.Run("UnknownController", func(t *testing.T) {
+		resp, err := keeper.MintersOfController(ctx, &types.QueryMintersOfControllerRequest{ControllerAddress: sample.AccAddress()})
+		require.NoError(t, err)
+		require.Empty(t, resp.MinterController)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.MintersOfController(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestControllersOfMinterQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	minter := sample.AccAddress()
+	links := make([]types.MinterController, 3)
+	for i := range links {
+		links[i] = types.MinterController{Controller: sample.AccAddress(), Minter: minter}
+		keeper.SetMinterControllerLink(ctx, links[i])
+	}
+	keeper.SetMinterControllerLink(ctx, types.MinterController{Controller: links[0].Controller, Minter: sample.AccAddress()})
+
+	resp, err := keeper.ControllersOfMinter(ctx, &types.QueryControllersOfMinterRequest{
+		MinterAddress: minter,
+		Pagination:    &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, nullify.Fill(links), nullify.Fill(resp.MinterController))
+	require.Equal(t, uint64(len(links)), resp.Pagination.Total)
+
+	keeper.DeleteMinterControllerLink(ctx, links[1].Controller, minter)
+
+	resp, err = keeper.ControllersOfMinter(ctx, &types.QueryControllersOfMinterRequest{MinterAddress: minter})
+	require.NoError(t, err)
+	require.ElementsMatch(t, nullify.Fill([]types.MinterController{links[0], links[2]}), nullify.Fill(resp.MinterController))
+
+	_, err = keeper.ControllersOfMinter(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
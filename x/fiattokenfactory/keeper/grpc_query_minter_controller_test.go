@@ -84,6 +84,89 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 	}
 }
 
+func TestMinterControllerQueryByMinter(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	msgs := []types.MinterController{
+		{Controller: "0", Minter: "minter0"},
+		{Controller: "1", Minter: "minter1"},
+		{Controller: "2", Minter: "minter1"},
+	}
+	for _, msg := range msgs {
+		keeper.SetMinterController(ctx, msg)
+		keeper.SetMinterControllerLink(ctx, msg)
+	}
+
+	// controller 0 also manages minter1 through a link
+	linked := types.MinterController{Controller: "0", Minter: "minter1"}
+	keeper.SetMinterControllerLink(ctx, linked)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMinterControllerByMinterRequest
+		response *types.QueryGetMinterControllerByMinterResponse
+		err      error
+	}{
+		{
+			desc: "SingleControllerSuccess",
+			request: &types.QueryGetMinterControllerByMinterRequest{
+				MinterAddress: "minter0",
+			},
+			response: &types.QueryGetMinterControllerByMinterResponse{MinterController: msgs[:1]},
+		},
+		{
+			desc: "LinkedControllerSuccess",
+			request: &types.QueryGetMinterControllerByMinterRequest{
+				MinterAddress: "minter1",
+			},
+			response: &types.QueryGetMinterControllerByMinterResponse{MinterController: []types.MinterController{linked, msgs[1], msgs[2]}},
+		},
+		{
+			desc: "AddressIsNotMinter",
+			request: &types.QueryGetMinterControllerByMinterRequest{
+				MinterAddress: "minter2",
+			},
+			response: &types.QueryGetMinterControllerByMinterResponse{},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MinterControllerByMinter(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response.MinterController),
+					nullify.Fill(response.MinterController),
+				)
+			}
+		})
+	}
+
+	t.Run("Paginated", func(t *testing.T) {
+		var controllers []types.MinterController
+		request := &types.QueryGetMinterControllerByMinterRequest{
+			MinterAddress: "minter1",
+			Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+		}
+		for {
+			response, err := keeper.MinterControllerByMinter(ctx, request)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.MinterController), 2)
+			controllers = append(controllers, response.MinterController...)
+			if response.Pagination.NextKey == nil {
+				break
+			}
+			request.Pagination = &query.PageRequest{Key: response.Pagination.NextKey, Limit: 2}
+		}
+		require.Equal(t, []types.MinterController{linked, msgs[1], msgs[2]}, controllers)
+	})
+}
+
 func TestMinterControllerQueryPaginated_NoControllers(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
@@ -19,9 +19,7 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,19 +30,13 @@ func (k Keeper) MintersAll(ctx context.Context, req *types.QueryAllMintersReques
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var minters []types.Minters
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	mintersStore := prefix.NewStore(store, types.KeyPrefix(types.MintersKeyPrefix))
-
-	pageRes, err := query.Paginate(mintersStore, req.Pagination, func(key []byte, value []byte) error {
-		var minter types.Minters
-		if err := k.cdc.Unmarshal(value, &minter); err != nil {
-			return err
-		}
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 
-		minters = append(minters, minter)
-		return nil
+	minters, pageRes, err := query.CollectionPaginate(ctx, token.minters, req.Pagination, func(_ string, minter types.Minters) (types.Minters, error) {
+		return minter, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -58,10 +50,18 @@ func (k Keeper) Minters(ctx context.Context, req *types.QueryGetMintersRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetMinters(
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, found, err := token.GetMinters(
 		ctx,
 		req.Address,
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
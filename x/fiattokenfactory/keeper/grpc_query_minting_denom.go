@@ -21,6 +21,8 @@ import (
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,7 +32,13 @@ func (k Keeper) MintingDenom(ctx context.Context, req *types.QueryGetMintingDeno
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val := k.GetMintingDenom(ctx)
+	val, err := k.GetMintingDenom(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetMintingDenomResponse{MintingDenom: val}, nil
 }
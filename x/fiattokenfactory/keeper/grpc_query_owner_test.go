@@ -41,6 +41,12 @@ func TestOwnerQuery(t *testing.T) {
 	owner := types.Owner{Address: "test"}
 	keeper.SetOwner(ctx, owner)
 
+	keeper.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	token, err := keeper.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	eurcOwner := types.Owner{Address: "eurc"}
+	token.SetOwner(ctx, eurcOwner)
+
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetOwnerRequest
@@ -52,6 +58,16 @@ func TestOwnerQuery(t *testing.T) {
 			request:  &types.QueryGetOwnerRequest{},
 			response: &types.QueryGetOwnerResponse{Owner: owner},
 		},
+		{
+			desc:     "FiatTokenSuccess",
+			request:  &types.QueryGetOwnerRequest{Denom: "ueurc"},
+			response: &types.QueryGetOwnerResponse{Owner: eurcOwner},
+		},
+		{
+			desc:    "UnknownDenom",
+			request: &types.QueryGetOwnerRequest{Denom: "ujpyc"},
+			err:     status.Error(codes.NotFound, "denom ujpyc is not managed by this module: fiat token not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -20,7 +20,10 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,7 +33,25 @@ func (k Keeper) Paused(ctx context.Context, req *types.QueryGetPausedRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val := k.GetPaused(ctx)
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	val, err := token.GetPaused(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryGetPausedResponse{Paused: val}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, expiry := range val.Expiries {
+		res.Remaining = append(res.Remaining, expiry.Remaining(sdkCtx.BlockHeight(), sdkCtx.BlockTime()))
+	}
 
-	return &types.QueryGetPausedResponse{Paused: val}, nil
+	return res, nil
 }
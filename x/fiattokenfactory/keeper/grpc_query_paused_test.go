@@ -18,6 +18,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -75,3 +76,28 @@ func TestPausedQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestPausedQuery_Remaining(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	paused := types.NewPaused(types.PAUSE_SCOPE_TRANSFER)
+	paused.Pause(15, 0, types.PAUSE_SCOPE_MINT)
+	paused.Pause(0, 1060, types.PAUSE_SCOPE_IBC)
+	keeper.SetPaused(ctx, paused)
+
+	response, err := keeper.Paused(ctx, &types.QueryGetPausedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseRemaining{
+		{Scope: types.PAUSE_SCOPE_MINT, Blocks: 5},
+		{Scope: types.PAUSE_SCOPE_IBC, Seconds: 60},
+	}, response.Remaining)
+
+	// an expiry that has been reached but not yet lifted reports nothing remaining
+	response, err = keeper.Paused(ctx.WithBlockHeight(20).WithBlockTime(time.Unix(2000, 0)), &types.QueryGetPausedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseRemaining{
+		{Scope: types.PAUSE_SCOPE_MINT},
+		{Scope: types.PAUSE_SCOPE_IBC},
+	}, response.Remaining)
+}
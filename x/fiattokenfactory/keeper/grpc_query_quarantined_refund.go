@@ -0,0 +1,74 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) QuarantinedRefundAll(ctx context.Context, req *types.QueryAllQuarantinedRefundRequest) (*types.QueryAllQuarantinedRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	refunds, pageRes, err := query.CollectionPaginate(ctx, token.quarantinedRefunds, req.Pagination, func(_ string, refund types.QuarantinedRefund) (types.QuarantinedRefund, error) {
+		return refund, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllQuarantinedRefundResponse{QuarantinedRefunds: refunds, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QuarantinedRefund(ctx context.Context, req *types.QueryGetQuarantinedRefundRequest) (*types.QueryGetQuarantinedRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	token, err := k.ForDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// refunds are recorded under the bech32 address of the sender, whatever encoding it was sent with
+	_, addressBz, err := DecodeNoLimitToBase256(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found, err := token.GetQuarantinedRefund(ctx, sdk.AccAddress(addressBz).String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetQuarantinedRefundResponse{QuarantinedRefund: val}, nil
+}
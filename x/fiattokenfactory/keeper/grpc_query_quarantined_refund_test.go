@@ -0,0 +1,118 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestQuarantinedRefundQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	holder := sample.TestAccount()
+	require.NoError(t, keeper.QuarantineRefund(ctx, holder.Address, math.NewInt(10)))
+	refund := types.QuarantinedRefund{Address: holder.Address, Amount: math.NewInt(10)}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetQuarantinedRefundRequest
+		response *types.QueryGetQuarantinedRefundResponse
+		err      error
+	}{
+		{
+			desc:     "Success",
+			request:  &types.QueryGetQuarantinedRefundRequest{Address: holder.Address},
+			response: &types.QueryGetQuarantinedRefundResponse{QuarantinedRefund: refund},
+		},
+		{
+			desc:    "NotFound",
+			request: &types.QueryGetQuarantinedRefundRequest{Address: sample.AccAddress()},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "FiatTokenNotFound",
+			request: &types.QueryGetQuarantinedRefundRequest{Address: holder.Address, Denom: "ueurc"},
+			err:     status.Error(codes.NotFound, "denom ueurc is not managed by this module: fiat token not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc:    "MalformedAddress",
+			request: &types.QueryGetQuarantinedRefundRequest{Address: "malformed address"},
+			err:     bech32.ErrInvalidCharacter(' '),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.QuarantinedRefund(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestQuarantinedRefundQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	refunds := make([]types.QuarantinedRefund, 5)
+	for i := range refunds {
+		refunds[i] = types.QuarantinedRefund{Address: sample.AccAddress(), Amount: math.NewInt(int64(i + 1))}
+		require.NoError(t, keeper.QuarantineRefund(ctx, refunds[i].Address, refunds[i].Amount))
+	}
+
+	t.Run("LookupByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var all []types.QuarantinedRefund
+		for i := 0; i < len(refunds); i += step {
+			resp, err := keeper.QuarantinedRefundAll(ctx, &types.QueryAllQuarantinedRefundRequest{
+				Pagination: &query.PageRequest{Key: next, Limit: uint64(step)},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.QuarantinedRefunds), step)
+			all = append(all, resp.QuarantinedRefunds...)
+			next = resp.Pagination.NextKey
+		}
+		require.ElementsMatch(t, refunds, all)
+	})
+	t.Run("LookupAll", func(t *testing.T) {
+		resp, err := keeper.QuarantinedRefundAll(ctx, &types.QueryAllQuarantinedRefundRequest{
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(refunds), int(resp.Pagination.Total))
+		require.ElementsMatch(t, refunds, resp.QuarantinedRefunds)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.QuarantinedRefundAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
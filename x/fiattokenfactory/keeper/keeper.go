@@ -19,15 +19,18 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type (
@@ -36,7 +39,69 @@ type (
 		logger       log.Logger
 		storeService store.KVStoreService
 
+		// rootStoreService is the unprefixed module store, denom is set when
+		// the keeper is scoped to a registered fiat token (see ForDenom).
+		rootStoreService store.KVStoreService
+		denom            string
+
+		// authority updates the module params and may override the privileged
+		// roles of every fiat token, unless the override is disabled
+		authority       string
+		disableOverride bool
+
 		bankKeeper types.BankKeeper
+
+		// Schema describes the collections of the module store
+		Schema collections.Schema
+
+		// fiatTokens, params and mintingDenom are shared by every fiat token
+		// and always stored in the unprefixed module store
+		fiatTokens   collections.Map[string, types.FiatToken]
+		params       collections.Item[types.Params]
+		mintingDenom collections.Item[types.MintingDenom]
+
+		tokenState
+
+		// tokenStates caches the state of every token by denom, the primary token under
+		// the empty denom, so that scoping a keeper does not rebuild its collections
+		tokenStates *sync.Map
+	}
+
+	// tokenState holds the state of a single token, stored in the module
+	// store for the primary token and below its prefix for a registered fiat token.
+	tokenState struct {
+		paused              collections.Item[types.Paused]
+		masterMinter        collections.Item[types.MasterMinter]
+		pauser              collections.Item[types.Pauser]
+		blacklister         collections.Item[types.Blacklister]
+		owner               collections.Item[types.Owner]
+		wiper               collections.Item[types.Wiper]
+		pendingOwner        collections.Item[types.PendingRole]
+		pendingMasterMinter collections.Item[types.PendingRole]
+		pendingPauser       collections.Item[types.PendingRole]
+		pendingBlacklister  collections.Item[types.PendingRole]
+		pendingWiper        collections.Item[types.PendingRole]
+		maxSupply           collections.Item[types.MaxSupply]
+		channelAllowlist    collections.Item[types.ChannelAllowlist]
+
+		blacklisted       collections.Map[[]byte, types.Blacklisted]
+		frozen            collections.Map[[]byte, types.Frozen]
+		allowedChannels   collections.Map[collections.Pair[string, string], types.AllowedChannel]
+		channelRateLimits collections.Map[collections.Pair[string, string], types.ChannelRateLimit]
+		channelFlows      collections.Map[collections.Pair[string, string], types.ChannelFlow]
+		pendingOutflows   collections.Map[collections.Triple[string, string, uint64], types.PendingOutflow]
+		minters           collections.Map[string, types.Minters]
+		mintRateLimits    collections.Map[string, types.MintRateLimit]
+		mintWindows       collections.Map[string, types.MintWindow]
+
+		// quarantinedRefunds is keyed by the sender the refunds are owed to
+		quarantinedRefunds collections.Map[string, types.QuarantinedRefund]
+
+		// minterControllers is keyed by controller, the links are indexed in both
+		// directions, by controller and minter and by minter and controller
+		minterControllers     collections.Map[string, types.MinterController]
+		controllerMinters     collections.Map[collections.Pair[string, string], types.MinterController]
+		minterControllerLinks collections.Map[collections.Pair[string, string], types.MinterController]
 	}
 )
 
@@ -44,36 +109,181 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	logger log.Logger,
 	storeService store.KVStoreService,
+	authority string,
+	disableOverride bool,
 
 	bankKeeper types.BankKeeper,
 ) *Keeper {
-	return &Keeper{
-		cdc:          cdc,
-		logger:       logger,
-		storeService: storeService,
-		bankKeeper:   bankKeeper,
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:              cdc,
+		logger:           logger,
+		storeService:     storeService,
+		rootStoreService: storeService,
+		authority:        authority,
+		disableOverride:  disableOverride,
+		bankKeeper:       bankKeeper,
+
+		fiatTokens:   collections.NewMap(sb, types.FiatTokenKeyPrefix, "fiat_tokens", collections.StringKey, codec.CollValue[types.FiatToken](cdc)),
+		params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		mintingDenom: collections.NewItem(sb, types.MintingDenomKey, "minting_denom", codec.CollValue[types.MintingDenom](cdc)),
+
+		tokenState:  newTokenState(sb, cdc),
+		tokenStates: &sync.Map{},
+	}
+	k.tokenStates.Store("", k.tokenState)
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
+}
+
+func newTokenState(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) tokenState {
+	stringPair := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	return tokenState{
+		paused:              collections.NewItem(sb, types.PausedKey, "paused", codec.CollValue[types.Paused](cdc)),
+		masterMinter:        collections.NewItem(sb, types.MasterMinterKey, "master_minter", codec.CollValue[types.MasterMinter](cdc)),
+		pauser:              collections.NewItem(sb, types.PauserKey, "pauser", codec.CollValue[types.Pauser](cdc)),
+		blacklister:         collections.NewItem(sb, types.BlacklisterKey, "blacklister", codec.CollValue[types.Blacklister](cdc)),
+		owner:               collections.NewItem(sb, types.OwnerKey, "owner", codec.CollValue[types.Owner](cdc)),
+		wiper:               collections.NewItem(sb, types.WiperKey, "wiper", codec.CollValue[types.Wiper](cdc)),
+		pendingOwner:        collections.NewItem(sb, types.PendingOwnerKey, "pending_owner", codec.CollValue[types.PendingRole](cdc)),
+		pendingMasterMinter: collections.NewItem(sb, types.PendingMasterMinterKey, "pending_master_minter", codec.CollValue[types.PendingRole](cdc)),
+		pendingPauser:       collections.NewItem(sb, types.PendingPauserKey, "pending_pauser", codec.CollValue[types.PendingRole](cdc)),
+		pendingBlacklister:  collections.NewItem(sb, types.PendingBlacklisterKey, "pending_blacklister", codec.CollValue[types.PendingRole](cdc)),
+		pendingWiper:        collections.NewItem(sb, types.PendingWiperKey, "pending_wiper", codec.CollValue[types.PendingRole](cdc)),
+		maxSupply:           collections.NewItem(sb, types.MaxSupplyKey, "max_supply", codec.CollValue[types.MaxSupply](cdc)),
+		channelAllowlist:    collections.NewItem(sb, types.ChannelAllowlistKey, "channel_allowlist", codec.CollValue[types.ChannelAllowlist](cdc)),
+
+		blacklisted:       collections.NewMap(sb, types.BlacklistedKeyPrefix, "blacklisted", collections.BytesKey, codec.CollValue[types.Blacklisted](cdc)),
+		frozen:            collections.NewMap(sb, types.FrozenKeyPrefix, "frozen", collections.BytesKey, codec.CollValue[types.Frozen](cdc)),
+		allowedChannels:   collections.NewMap(sb, types.AllowedChannelKeyPrefix, "allowed_channels", stringPair, codec.CollValue[types.AllowedChannel](cdc)),
+		channelRateLimits: collections.NewMap(sb, types.ChannelRateLimitKeyPrefix, "channel_rate_limits", stringPair, codec.CollValue[types.ChannelRateLimit](cdc)),
+		channelFlows:      collections.NewMap(sb, types.ChannelFlowKeyPrefix, "channel_flows", stringPair, codec.CollValue[types.ChannelFlow](cdc)),
+		pendingOutflows: collections.NewMap(
+			sb, types.PendingOutflowKeyPrefix, "pending_outflows",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PendingOutflow](cdc),
+		),
+		minters:        collections.NewMap(sb, types.MintersKeyPrefix, "minters", collections.StringKey, codec.CollValue[types.Minters](cdc)),
+		mintRateLimits: collections.NewMap(sb, types.MintRateLimitKeyPrefix, "mint_rate_limits", collections.StringKey, codec.CollValue[types.MintRateLimit](cdc)),
+		mintWindows:    collections.NewMap(sb, types.MintWindowKeyPrefix, "mint_windows", collections.StringKey, codec.CollValue[types.MintWindow](cdc)),
+
+		quarantinedRefunds: collections.NewMap(sb, types.QuarantinedRefundKeyPrefix, "quarantined_refunds", collections.StringKey, codec.CollValue[types.QuarantinedRefund](cdc)),
+
+		minterControllers:     collections.NewMap(sb, types.MinterControllerKeyPrefix, "minter_controllers", collections.StringKey, codec.CollValue[types.MinterController](cdc)),
+		controllerMinters:     collections.NewMap(sb, types.ControllerMintersKeyPrefix, "controller_minters", stringPair, codec.CollValue[types.MinterController](cdc)),
+		minterControllerLinks: collections.NewMap(sb, types.MinterControllersKeyPrefix, "minter_controller_links", stringPair, codec.CollValue[types.MinterController](cdc)),
+	}
+}
+
+// exists reports whether a collection lookup found a value, a missing value is
+// not an error. Any other error means the value could not be read or decoded.
+func exists(err error) (bool, error) {
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// values returns every value of a collection within the range, nil includes all.
+func values[K, V any](ctx context.Context, m collections.Map[K, V], ranger collections.Ranger[K]) ([]V, error) {
+	iter, err := m.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority checks that signer is the module authority.
+func (k Keeper) ValidateAuthority(signer string) error {
+	if signer != k.authority {
+		return errors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, signer)
+	}
+
+	return nil
+}
+
+// ValidateOverrideAuthority checks that the override of the privileged roles is
+// enabled and that signer is the module authority.
+func (k Keeper) ValidateOverrideAuthority(signer string) error {
+	if k.disableOverride {
+		return errors.Wrapf(types.ErrUnauthorized, "authority override is disabled")
+	}
+
+	return k.ValidateAuthority(signer)
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// SendRestrictionFn checks every $USDC transfer executed on the Noble chain against the blocklist and paused state
+// SendRestrictionFn checks every transfer of a managed fiat token executed on the Noble chain against the blocklist, frozen
+// addresses and paused state
 func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error) {
-	mintingDenom := k.GetMintingDenom(ctx)
-	if amount := amt.AmountOf(mintingDenom.Denom); !amount.IsZero() {
-		paused := k.GetPaused(ctx)
-		if paused.Paused {
+	for _, coin := range amt {
+		if coin.Amount.IsZero() {
+			continue
+		}
+
+		token, found, err := k.ManagedDenom(ctx, coin.Denom)
+		if err != nil {
+			return toAddr, err
+		}
+		if !found {
+			continue
+		}
+
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+		// the wiper moves the balance of a blacklisted address into the module account
+		if wiped, ok := ctx.Value(types.WipeKey).(sdk.AccAddress); ok && wiped.Equals(fromAddr) && toAddr.Equals(moduleAddr) {
+			continue
+		}
+
+		// transfers into or out of the module account are mints, burns and wipes, which are governed
+		// by their own pause scopes. Chains must block the module account from receiving regular sends.
+		paused, err := token.GetPaused(ctx)
+		if err != nil {
+			return toAddr, err
+		}
+		if paused.IsPaused(types.PAUSE_SCOPE_TRANSFER) && !fromAddr.Equals(moduleAddr) && !toAddr.Equals(moduleAddr) {
 			return toAddr, errors.Wrapf(types.ErrPaused, "cannot perform token transfers")
 		}
 
-		_, found := k.GetBlacklisted(ctx, fromAddr.Bytes())
+		_, found, err = token.GetBlacklisted(ctx, fromAddr.Bytes())
+		if err != nil {
+			return toAddr, err
+		}
 		if found {
 			return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not send tokens", fromAddr.String())
 		}
 
-		_, found = k.GetBlacklisted(ctx, toAddr.Bytes())
+		_, found, err = token.GetFrozen(ctx, fromAddr.Bytes())
+		if err != nil {
+			return toAddr, err
+		}
+		if found {
+			return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is frozen and can not send tokens", fromAddr.String())
+		}
+
+		_, found, err = token.GetBlacklisted(ctx, toAddr.Bytes())
+		if err != nil {
+			return toAddr, err
+		}
 		if found {
 			return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not receive tokens", toAddr.String())
 		}
@@ -85,10 +295,20 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 				if err != nil {
 					return toAddr, err
 				}
-				_, found := k.GetBlacklisted(ctx, addressBz)
+				_, found, err := token.GetBlacklisted(ctx, addressBz)
+				if err != nil {
+					return toAddr, err
+				}
 				if found {
 					return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not authorize tokens", toAddr.String())
 				}
+				_, found, err = token.GetFrozen(ctx, addressBz)
+				if err != nil {
+					return toAddr, err
+				}
+				if found {
+					return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is frozen and can not authorize tokens", grantee)
+				}
 			}
 		}
 	}
@@ -103,25 +323,45 @@ func (k Keeper) ValidatePrivileges(ctx context.Context, address string) error {
 		return err
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found, err := k.GetOwner(ctx)
+	if err != nil {
+		return err
+	}
 	if found && owner.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to owner role", acc.String())
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found, err := k.GetBlacklister(ctx)
+	if err != nil {
+		return err
+	}
 	if found && blacklister.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to black lister role", acc.String())
 	}
 
-	masterminter, found := k.GetMasterMinter(ctx)
+	masterminter, found, err := k.GetMasterMinter(ctx)
+	if err != nil {
+		return err
+	}
 	if found && masterminter.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to master minter role", acc.String())
 	}
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found, err := k.GetPauser(ctx)
+	if err != nil {
+		return err
+	}
 	if found && pauser.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to pauser role", acc.String())
 	}
 
+	wiper, found, err := k.GetWiper(ctx)
+	if err != nil {
+		return err
+	}
+	if found && wiper.Address == acc.String() {
+		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to wiper role", acc.String())
+	}
+
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,6 +35,25 @@ func TestKeeperLogger(t *testing.T) {
 	require.NotNil(t, l)
 }
 
+func TestKeeperSchema(t *testing.T) {
+	k, ctx, key := keeper.FiatTokenfactoryKeeperWithStoreKey()
+	require.NotEmpty(t, k.Schema.ListCollections())
+
+	// collections keep the layout of the store keys
+	blacklisted := sample.TestAccount()
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+	require.True(t, ctx.KVStore(key).Has(append(types.BlacklistedKeyPrefix, types.BlacklistedKey(blacklisted.AddressBz)...)))
+
+	k.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	eurc, err := k.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	eurc.SetMinters(ctx, types.Minters{Address: blacklisted.Address})
+	require.True(t, ctx.KVStore(key).Has(append(types.FiatTokenStoreKey("ueurc"), append(types.MintersKeyPrefix, types.MintersKey(blacklisted.Address)...)...)))
+	_, found, err := k.GetMinters(ctx, blacklisted.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
 func TestSendRestrictionsFn_NotUsingUSDC(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
@@ -50,7 +70,7 @@ func TestSendRestrictionsFn_NotUsingUSDC(t *testing.T) {
 func TestSendRestrictionsFn_Paused(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: true})
+	k.SetPaused(ctx, types.NewPaused())
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
@@ -61,10 +81,37 @@ func TestSendRestrictionsFn_Paused(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_OtherScopesPaused(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_MINT, types.PAUSE_SCOPE_BURN, types.PAUSE_SCOPE_IBC))
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	_, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, amounts)
+	require.NoError(t, err)
+}
+
+func TestSendRestrictionsFn_TransferPausedModuleAccount(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_TRANSFER))
+	address := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	// mints and burns move funds out of and into the module account
+	_, err := k.SendRestrictionFn(ctx, moduleAddress, address, amounts)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, address, moduleAddress, amounts)
+	require.NoError(t, err)
+}
+
 func TestSendRestrictionsFn_FromBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{})
 
 	fromAccount := sample.TestAccount()
 	fromAddress := sdk.MustAccAddressFromBech32(fromAccount.Address)
@@ -81,7 +128,7 @@ func TestSendRestrictionsFn_FromBlacklisted(t *testing.T) {
 func TestSendRestrictionsFn_ToBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAccount := sample.TestAccount()
@@ -98,7 +145,7 @@ func TestSendRestrictionsFn_ToBlacklisted(t *testing.T) {
 func TestSendRestrictionsFn_GranteeBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
@@ -119,7 +166,7 @@ func TestSendRestrictionsFn_GranteeBlacklisted(t *testing.T) {
 func TestSendRestrictionsFn_Bech32mGranteeBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
@@ -137,10 +184,97 @@ func TestSendRestrictionsFn_Bech32mGranteeBlacklisted(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_FromFrozen(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{})
+
+	fromAccount := sample.TestAccount()
+	fromAddress := sdk.MustAccAddressFromBech32(fromAccount.Address)
+	k.SetFrozen(ctx, types.Frozen{AddressBz: fromAccount.AddressBz})
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	newToAddress, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, amounts)
+
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "is frozen and can not send tokens")
+	require.Equal(t, toAddress, newToAddress)
+}
+
+func TestSendRestrictionsFn_ToFrozen(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{})
+
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAccount := sample.TestAccount()
+	toAddress := sdk.MustAccAddressFromBech32(toAccount.Address)
+	k.SetFrozen(ctx, types.Frozen{AddressBz: toAccount.AddressBz})
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	// a frozen address can still receive tokens
+	newToAddress, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, amounts)
+
+	require.NoError(t, err)
+	require.Equal(t, toAddress, newToAddress)
+}
+
+func TestSendRestrictionsFn_GranteeFrozen(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{})
+
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	granteeAccount := sample.TestAccountBech32m()
+	k.SetFrozen(ctx, types.Frozen{AddressBz: granteeAccount.AddressBz})
+
+	grantees := []string{granteeAccount.Address}
+
+	newToAddress, err := k.SendRestrictionFn(ctx.WithValue(types.GranteeKey, grantees), fromAddress, toAddress, amounts)
+
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, toAddress, newToAddress)
+}
+
+func TestSendRestrictionsFn_WipeBlacklisted(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.NewPaused())
+
+	blacklisted := sample.TestAccount()
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+
+	fromAddress := sdk.AccAddress(blacklisted.AddressBz)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+	wipeCtx := ctx.WithValue(types.WipeKey, fromAddress)
+
+	// the wiped address may only send to the module account, even while paused
+	_, err := k.SendRestrictionFn(wipeCtx, fromAddress, moduleAddress, amounts)
+	require.NoError(t, err)
+
+	_, err = k.SendRestrictionFn(wipeCtx, fromAddress, sdk.MustAccAddressFromBech32(sample.AccAddress()), amounts)
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, moduleAddress, amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// another blacklisted sender is not covered by the wipe
+	other := sample.TestAccount()
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: other.AddressBz})
+	k.SetPaused(ctx, types.Paused{})
+	_, err = k.SendRestrictionFn(wipeCtx, sdk.AccAddress(other.AddressBz), moduleAddress, amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
 func TestSendRestrictionsFn_USDCNotRestricted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{})
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
@@ -180,15 +314,57 @@ func TestValidatePrivileges_PrivilegedAddress(t *testing.T) {
 	blacklister := sample.TestAccount()
 	masterMinter := sample.TestAccount()
 	pauser := sample.TestAccount()
+	wiper := sample.TestAccount()
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetOwner(ctx, types.Owner{Address: owner.Address})
 	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
 	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
 	k.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	address := []sample.Account{owner, blacklister, masterMinter, pauser}
+	k.SetWiper(ctx, types.Wiper{Address: wiper.Address})
+	address := []sample.Account{owner, blacklister, masterMinter, pauser, wiper}
 
 	for _, ad := range address {
 		err := k.ValidatePrivileges(ctx, ad.Address)
 		require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 	}
 }
+
+func TestSendRestrictionsFn_FiatTokenPaused(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{})
+	k.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	token, err := k.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, types.NewPaused())
+
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.Coins{sdk.NewInt64Coin("uusdc", 10)})
+	require.NoError(t, err)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.Coins{sdk.NewInt64Coin("ueurc", 10)})
+	require.ErrorIs(t, err, types.ErrPaused)
+}
+
+func TestSendRestrictionsFn_FiatTokenBlacklisted(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{})
+	k.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	token, err := k.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, types.Paused{})
+
+	fromAccount := sample.TestAccount()
+	fromAddress := sdk.MustAccAddressFromBech32(fromAccount.Address)
+	token.SetBlacklisted(ctx, types.Blacklisted{AddressBz: fromAccount.AddressBz})
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.Coins{sdk.NewInt64Coin("uusdc", 10)})
+	require.NoError(t, err)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.Coins{sdk.NewInt64Coin("uusdc", 10), sdk.NewInt64Coin("ueurc", 10)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
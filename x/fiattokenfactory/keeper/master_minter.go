@@ -20,25 +20,33 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetMasterMinter set masterMinter in the store
-func (k Keeper) SetMasterMinter(ctx context.Context, masterMinter types.MasterMinter) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&masterMinter)
-	store.Set(types.KeyPrefix(types.MasterMinterKey), b)
+func (k Keeper) SetMasterMinter(ctx context.Context, masterMinter types.MasterMinter) error {
+	return k.masterMinter.Set(ctx, masterMinter)
 }
 
 // GetMasterMinter returns masterMinter
-func (k Keeper) GetMasterMinter(ctx context.Context) (val types.MasterMinter, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+func (k Keeper) GetMasterMinter(ctx context.Context) (val types.MasterMinter, found bool, err error) {
+	val, err = k.masterMinter.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// SetPendingMasterMinter set pending master minter in the store
+func (k Keeper) SetPendingMasterMinter(ctx context.Context, pendingMasterMinter types.PendingRole) error {
+	return k.pendingMasterMinter.Set(ctx, pendingMasterMinter)
+}
 
-	b := store.Get(types.KeyPrefix(types.MasterMinterKey))
-	if b == nil {
-		return val, false
-	}
+// DeletePendingMasterMinter deletes the pending master minter in the store
+func (k Keeper) DeletePendingMasterMinter(ctx context.Context) error {
+	return k.pendingMasterMinter.Remove(ctx)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetPendingMasterMinter returns pending master minter
+func (k Keeper) GetPendingMasterMinter(ctx context.Context) (val types.PendingRole, found bool, err error) {
+	val, err = k.pendingMasterMinter.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
 }
@@ -58,12 +58,46 @@ func TestMasterMinterSet_Overwrite(t *testing.T) {
 func TestMasterMinterGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetMasterMinter(ctx)
+	_, found, err := keeper.GetMasterMinter(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingMasterMinterGetAndSet_Success(t *testing.T) {
+	pendingMasterMinter := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPendingMasterMinter(ctx, types.PendingRole{Address: pendingMasterMinter.Address, ActivationHeight: 5})
+
+	rst, found, err := keeper.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: pendingMasterMinter.Address, ActivationHeight: 5}, rst)
+}
+
+func TestPendingMasterMinterGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingMasterMinterDelete_Success(t *testing.T) {
+	pendingMasterMinter := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetPendingMasterMinter(ctx, types.PendingRole{Address: pendingMasterMinter.Address})
+
+	keeper.DeletePendingMasterMinter(ctx)
+
+	_, found, err := keeper.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
 func assertMasterMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedMasterMinter sample.Account) {
-	rst, found := keeper.GetMasterMinter(ctx)
+	rst, found, err := keeper.GetMasterMinter(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.MasterMinter{Address: expectedMasterMinter.Address}),
@@ -0,0 +1,40 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetMaxSupply set maxSupply in the store
+func (k Keeper) SetMaxSupply(ctx context.Context, maxSupply types.MaxSupply) error {
+	return k.maxSupply.Set(ctx, maxSupply)
+}
+
+// DeleteMaxSupply removes maxSupply from the store, lifting the cap
+func (k Keeper) DeleteMaxSupply(ctx context.Context) error {
+	return k.maxSupply.Remove(ctx)
+}
+
+// GetMaxSupply returns maxSupply
+func (k Keeper) GetMaxSupply(ctx context.Context) (val types.MaxSupply, found bool, err error) {
+	val, err = k.maxSupply.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMaxSupplyGetSetAndDelete(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetMaxSupply(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	keeper.SetMaxSupply(ctx, types.MaxSupply{Amount: math.NewInt(100)})
+
+	rst, found, err := keeper.GetMaxSupply(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.MaxSupply{Amount: math.NewInt(100)}, rst)
+
+	keeper.DeleteMaxSupply(ctx)
+	_, found, err = keeper.GetMaxSupply(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,345 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store of the minting denom and of every registered
+// fiat token from version 1 to 2.
+//
+// Every configured minter controller gets its primary minter linked (see
+// backfillMinterControllerLinks). Blacklist entries are rewritten in their v2
+// encoding. Version 1 did not record when, why or by whom an address was
+// blacklisted, so those fields stay empty and a zero height marks an entry as
+// recorded before the migration.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	stores, err := m.legacyTokenStores(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if err := m.backfillMinterControllerLinks(store); err != nil {
+			return err
+		}
+
+		for _, entry := range legacyEntries(store, legacyBlacklistedKeyPrefix) {
+			var blacklisted types.Blacklisted
+			if err := m.keeper.cdc.Unmarshal(entry.value, &blacklisted); err != nil {
+				return err
+			}
+
+			bz, err := m.keeper.cdc.Marshal(&blacklisted)
+			if err != nil {
+				return err
+			}
+			prefix.NewStore(store, []byte(legacyBlacklistedKeyPrefix)).Set(entry.key, bz)
+		}
+	}
+
+	return nil
+}
+
+// backfillMinterControllerLinks links every minter controller to its primary
+// minter. Since a controller may manage several minters, the minters it manages
+// are read from the links only, so a version 1 controller without a link would
+// lose control of its minter.
+func (m Migrator) backfillMinterControllerLinks(store storetypes.KVStore) error {
+	for _, entry := range legacyEntries(store, legacyMinterControllerKeyPrefix) {
+		var minterController types.MinterController
+		if err := m.keeper.cdc.Unmarshal(entry.value, &minterController); err != nil {
+			return err
+		}
+
+		controllerStore := prefix.NewStore(store, []byte(legacyControllerMintersKeyPrefix))
+		controllerStore.Set([]byte(minterController.Controller+"/"+minterController.Minter+"/"), entry.value)
+
+		minterStore := prefix.NewStore(store, []byte(legacyMinterControllersKeyPrefix))
+		minterStore.Set([]byte(minterController.Minter+"/"+minterController.Controller+"/"), entry.value)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the store of the minting denom and of every registered
+// fiat token from version 2 to 3.
+//
+// The single paused flag is replaced by per-scope flags. A token that was
+// paused has every scope paused.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	stores, err := m.legacyTokenStores(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		bz := store.Get([]byte(legacyPausedKey))
+		if bz == nil {
+			continue
+		}
+
+		var paused types.Paused
+		if err := m.keeper.cdc.Unmarshal(bz, &paused); err != nil {
+			return err
+		}
+
+		paused = paused.Migrate()
+		bz, err := m.keeper.cdc.Marshal(&paused)
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(legacyPausedKey), bz)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates the module store from version 3 to 4.
+//
+// Keys built by concatenating human readable prefixes and "/" separated index
+// fields are moved to single byte prefixes followed by index fields encoded
+// with the collections key codecs. Values are unchanged.
+//
+// Chains that never stored params get the default params, with the limit on
+// the controllers of a minter raised to the most controllers any minter
+// already has, or lifted if that is above the largest limit.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	root := runtime.KVStoreAdapter(m.keeper.rootStoreService.OpenKVStore(ctx))
+
+	// the registry of fiat tokens and the params are shared by every fiat token
+	for _, entry := range legacyEntries(root, legacyFiatTokenKeyPrefix) {
+		var fiatToken types.FiatToken
+		if err := m.keeper.cdc.Unmarshal(entry.value, &fiatToken); err != nil {
+			return err
+		}
+		if err := m.keeper.SetFiatToken(ctx, fiatToken); err != nil {
+			return err
+		}
+		prefix.NewStore(root, []byte(legacyFiatTokenKeyPrefix)).Delete(entry.key)
+	}
+
+	if bz := root.Get([]byte(legacyParamsKey)); bz != nil {
+		var params types.Params
+		if err := m.keeper.cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+		if err := m.keeper.SetParams(ctx, params); err != nil {
+			return err
+		}
+		root.Delete([]byte(legacyParamsKey))
+	}
+
+	// the minting denom is only stored for the primary token
+	if bz := root.Get([]byte(legacyMintingDenomKey)); bz != nil {
+		root.Set(types.MintingDenomKey, bz)
+		root.Delete([]byte(legacyMintingDenomKey))
+	}
+
+	tokens, err := m.keeper.ManagedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	// the primary token can hold state before its minting denom is set
+	if len(tokens) == 0 || tokens[0].denom != "" {
+		tokens = append([]*Keeper{m.keeper.primary()}, tokens...)
+	}
+
+	for _, token := range tokens {
+		store := storetypes.KVStore(root)
+		if token.denom != "" {
+			store = prefix.NewStore(root, legacyFiatTokenStoreKey(token.denom))
+		}
+
+		for _, legacy := range legacyTokenPrefixes {
+			for _, entry := range legacyEntries(store, legacy.prefix) {
+				if err := legacy.move(ctx, token, entry.value); err != nil {
+					return err
+				}
+				prefix.NewStore(store, []byte(legacy.prefix)).Delete(entry.key)
+			}
+		}
+	}
+
+	// without stored params the default limit on the controllers of a minter would apply,
+	// which minters that are already managed by several controllers cannot satisfy
+	set, err := m.keeper.params.Has(ctx)
+	if err != nil || set {
+		return err
+	}
+
+	params := types.DefaultParams()
+	for _, token := range tokens {
+		controllers, err := token.maxControllersPerMinter(ctx)
+		if err != nil {
+			return err
+		}
+		params.MaxControllersPerMinter = max(params.MaxControllersPerMinter, controllers)
+	}
+	if params.MaxControllersPerMinter > types.MaxControllersPerMinter {
+		params.MaxControllersPerMinter = 0
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// maxControllersPerMinter returns the largest number of controllers linked to a single minter.
+func (k Keeper) maxControllersPerMinter(ctx context.Context) (uint32, error) {
+	iter, err := k.minterControllerLinks.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return 0, err
+	}
+
+	var most uint32
+	controllers := make(map[string]uint32)
+	for _, key := range keys {
+		controllers[key.K1()]++
+		most = max(most, controllers[key.K1()])
+	}
+
+	return most, nil
+}
+
+// Store layout up to version 3. Singletons are stored under their prefix, map
+// entries under their prefix followed by their "/" terminated index fields.
+const (
+	legacyPausedKey                  = "Paused/value/"
+	legacyParamsKey                  = "Params/value/"
+	legacyMintingDenomKey            = "MintingDenom/value/MintingDenom/value/"
+	legacyBlacklistedKeyPrefix       = "Blacklisted/value/"
+	legacyMinterControllerKeyPrefix  = "MinterController/value/"
+	legacyControllerMintersKeyPrefix = "ControllerMinters/value/"
+	legacyMinterControllersKeyPrefix = "MinterControllers/value/"
+	legacyFiatTokenKeyPrefix         = "FiatToken/value/"
+	legacyFiatTokenStoreKeyPrefix    = "FiatTokenStore/"
+)
+
+// legacyTokenPrefixes lists every prefix the state of a single token was stored
+// under up to version 3, along with how an entry is written in the current layout.
+var legacyTokenPrefixes = []legacyPrefix{
+	moveLegacy(legacyPausedKey, Keeper.SetPaused),
+	moveLegacy("MasterMinter/value/", Keeper.SetMasterMinter),
+	moveLegacy("Pauser/value/", Keeper.SetPauser),
+	moveLegacy("Blacklister/value/", Keeper.SetBlacklister),
+	moveLegacy("Owner/value/", Keeper.SetOwner),
+	moveLegacy("Wiper/value/", Keeper.SetWiper),
+	moveLegacy("PendingOwner/value/", Keeper.SetPendingOwner),
+	moveLegacy("PendingMasterMinter/value/", Keeper.SetPendingMasterMinter),
+	moveLegacy("PendingPauser/value/", Keeper.SetPendingPauser),
+	moveLegacy("PendingBlacklister/value/", Keeper.SetPendingBlacklister),
+	moveLegacy("PendingWiper/value/", Keeper.SetPendingWiper),
+	moveLegacy("MaxSupply/value/", Keeper.SetMaxSupply),
+	moveLegacy(legacyBlacklistedKeyPrefix, Keeper.SetBlacklisted),
+	moveLegacy("Frozen/value/", Keeper.SetFrozen),
+	moveLegacy("AllowedChannel/value/", Keeper.SetAllowedChannel),
+	moveLegacy("ChannelRateLimit/value/", Keeper.SetChannelRateLimit),
+	moveLegacy("ChannelFlow/value/", Keeper.SetChannelFlow),
+	moveLegacy("PendingOutflow/value/", Keeper.SetPendingOutflow),
+	moveLegacy("Minters/value/", Keeper.SetMinters),
+	moveLegacy(legacyMinterControllerKeyPrefix, Keeper.SetMinterController),
+	moveLegacy(legacyControllerMintersKeyPrefix, Keeper.SetMinterControllerLink),
+	moveLegacy(legacyMinterControllersKeyPrefix, Keeper.SetMinterControllerLink),
+	moveLegacy("MintRateLimit/value/", Keeper.SetMintRateLimit),
+	moveLegacy("MintWindow/value/", Keeper.SetMintWindow),
+}
+
+type legacyPrefix struct {
+	prefix string
+	move   func(ctx context.Context, token *Keeper, bz []byte) error
+}
+
+// moveLegacy returns a legacyPrefix whose entries are decoded as T and written
+// through the given setter.
+func moveLegacy[T any, PT interface {
+	*T
+	proto.Message
+}](keyPrefix string, set func(Keeper, context.Context, T) error) legacyPrefix {
+	return legacyPrefix{
+		prefix: keyPrefix,
+		move: func(ctx context.Context, token *Keeper, bz []byte) error {
+			var val T
+			if err := token.cdc.Unmarshal(bz, PT(&val)); err != nil {
+				return err
+			}
+			return set(*token, ctx, val)
+		},
+	}
+}
+
+type legacyEntry struct {
+	key   []byte
+	value []byte
+}
+
+// legacyEntries collects the entries below a prefix of the version 3 layout,
+// so that the store can be modified while they are processed.
+func legacyEntries(store storetypes.KVStore, keyPrefix string) (entries []legacyEntry) {
+	iterator := prefix.NewStore(store, []byte(keyPrefix)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, legacyEntry{key: iterator.Key(), value: iterator.Value()})
+	}
+
+	return
+}
+
+// legacyTokenStores returns the store of the minting denom followed by the
+// stores of every registered fiat token in the version 3 layout.
+func (m Migrator) legacyTokenStores(ctx sdk.Context) ([]storetypes.KVStore, error) {
+	root := runtime.KVStoreAdapter(m.keeper.rootStoreService.OpenKVStore(ctx))
+
+	stores := []storetypes.KVStore{root}
+	for _, entry := range legacyEntries(root, legacyFiatTokenKeyPrefix) {
+		var fiatToken types.FiatToken
+		if err := m.keeper.cdc.Unmarshal(entry.value, &fiatToken); err != nil {
+			return nil, err
+		}
+		stores = append(stores, prefix.NewStore(root, legacyFiatTokenStoreKey(fiatToken.Denom)))
+	}
+
+	return stores, nil
+}
+
+func legacyFiatTokenStoreKey(denom string) []byte {
+	key := append([]byte(legacyFiatTokenStoreKeyPrefix), byte(len(denom)))
+	return append(key, []byte(denom)...)
+}
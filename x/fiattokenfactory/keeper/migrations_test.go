@@ -0,0 +1,289 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// legacyFiatTokenStore returns the store of a fiat token in the version 1 to 3 layout.
+func legacyFiatTokenStore(ctx sdk.Context, key storetypes.StoreKey, denom string) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(key), append([]byte("FiatTokenStore/"), append([]byte{byte(len(denom))}, denom...)...))
+}
+
+func legacySet(t *testing.T, store storetypes.KVStore, key string, val proto.Message) {
+	bz, err := proto.Marshal(val)
+	require.NoError(t, err)
+	store.Set([]byte(key), bz)
+}
+
+// v1Fixture writes a version 1 store holding the minting denom and a registered
+// "ueurc" fiat token, each with a blacklisted address, a minter controller and
+// a paused flag.
+func v1Fixture(t *testing.T, ctx sdk.Context, key storetypes.StoreKey, blacklisted []byte, primary, eurcPrimary types.MinterController) {
+	root := ctx.KVStore(key)
+	eurc := legacyFiatTokenStore(ctx, key, "ueurc")
+
+	// a v1 blacklist entry holds only the address, a v1 paused state only the deprecated flag
+	v1Blacklisted := append([]byte{0x0a, byte(len(blacklisted))}, blacklisted...)
+	v1Paused := []byte{0x08, 0x01}
+
+	legacySet(t, root, "MintingDenom/value/MintingDenom/value/", &types.MintingDenom{Denom: "uusdc"})
+	legacySet(t, root, "FiatToken/value/ueurc/", &types.FiatToken{Denom: "ueurc"})
+
+	for _, token := range []struct {
+		store            storetypes.KVStore
+		minterController types.MinterController
+	}{{root, primary}, {eurc, eurcPrimary}} {
+		store, minterController := token.store, token.minterController
+		store.Set(append([]byte("Blacklisted/value/"), append(blacklisted, '/')...), v1Blacklisted)
+		store.Set([]byte("Paused/value/"), v1Paused)
+		legacySet(t, store, "MinterController/value/"+minterController.Controller+"/", &minterController)
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	ftf, ctx, key := keepertest.FiatTokenfactoryKeeperWithStoreKey()
+	blacklisted := sample.TestAccount()
+	primary := types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress()}
+	eurcPrimary := types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress()}
+	v1Fixture(t, ctx, key, blacklisted.AddressBz, primary, eurcPrimary)
+
+	require.NoError(t, keeper.NewMigrator(ftf).Migrate1to2(ctx))
+
+	for _, token := range []struct {
+		store            storetypes.KVStore
+		minterController types.MinterController
+	}{{ctx.KVStore(key), primary}, {legacyFiatTokenStore(ctx, key, "ueurc"), eurcPrimary}} {
+		store, minterController := token.store, token.minterController
+		var entry types.Blacklisted
+		require.NoError(t, proto.Unmarshal(store.Get(append([]byte("Blacklisted/value/"), append(blacklisted.AddressBz, '/')...)), &entry))
+		require.Equal(t, blacklisted.AddressBz, entry.AddressBz)
+		require.Zero(t, entry.Height)
+		require.Empty(t, entry.Blacklister)
+		require.Empty(t, entry.Reason)
+
+		var link types.MinterController
+		require.NoError(t, proto.Unmarshal(store.Get([]byte("ControllerMinters/value/"+minterController.Controller+"/"+minterController.Minter+"/")), &link))
+		require.Equal(t, minterController, link)
+		require.NoError(t, proto.Unmarshal(store.Get([]byte("MinterControllers/value/"+minterController.Minter+"/"+minterController.Controller+"/")), &link))
+		require.Equal(t, minterController, link)
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ftf, ctx, key := keepertest.FiatTokenfactoryKeeperWithStoreKey()
+	root := ctx.KVStore(key)
+	eurc := legacyFiatTokenStore(ctx, key, "ueurc")
+
+	legacySet(t, root, "FiatToken/value/ueurc/", &types.FiatToken{Denom: "ueurc"})
+	root.Set([]byte("Paused/value/"), []byte{0x08, 0x01})
+	legacySet(t, eurc, "Paused/value/", &types.Paused{})
+
+	require.NoError(t, keeper.NewMigrator(ftf).Migrate2to3(ctx))
+
+	var paused types.Paused
+	require.NoError(t, proto.Unmarshal(root.Get([]byte("Paused/value/")), &paused))
+	for _, scope := range types.PauseScopes {
+		require.True(t, paused.IsPaused(scope))
+	}
+
+	require.NoError(t, proto.Unmarshal(eurc.Get([]byte("Paused/value/")), &paused))
+	require.False(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	ftf, ctx, key := keepertest.FiatTokenfactoryKeeperWithStoreKey()
+	root := ctx.KVStore(key)
+	eurcStore := legacyFiatTokenStore(ctx, key, "ueurc")
+
+	owner := types.Owner{Address: sample.AccAddress()}
+	pendingOwner := types.PendingRole{Address: sample.AccAddress()}
+	blacklisted := types.Blacklisted{AddressBz: sample.TestAccount().AddressBz, Height: 5}
+	minters := types.Minters{Address: sample.AccAddress(), Allowance: sdk.NewInt64Coin("uusdc", 10)}
+	link := types.MinterController{Controller: sample.AccAddress(), Minter: minters.Address}
+	outflow := types.PendingOutflow{PortId: "transfer", ChannelId: "channel-0", Sequence: 7, BucketStart: 3}
+	params := types.DefaultParams()
+	params.MaxMinterAllowance = math.NewInt(100)
+	paused := types.Paused{Mint: true}
+
+	legacySet(t, root, "MintingDenom/value/MintingDenom/value/", &types.MintingDenom{Denom: "uusdc"})
+	legacySet(t, root, "FiatToken/value/ueurc/", &types.FiatToken{Denom: "ueurc"})
+	legacySet(t, root, "Params/value/", &params)
+	legacySet(t, root, "Paused/value/", &paused)
+	legacySet(t, root, "Owner/value/", &owner)
+	legacySet(t, root, "PendingOwner/value/", &pendingOwner)
+	legacySet(t, root, "Minters/value/"+minters.Address+"/", &minters)
+	legacySet(t, root, "MinterController/value/"+link.Controller+"/", &link)
+	legacySet(t, root, "ControllerMinters/value/"+link.Controller+"/"+link.Minter+"/", &link)
+	legacySet(t, root, "MinterControllers/value/"+link.Minter+"/"+link.Controller+"/", &link)
+	legacySet(t, eurcStore, "Paused/value/", &types.Paused{})
+	legacySet(t, eurcStore, "Blacklisted/value/"+string(blacklisted.AddressBz)+"/", &blacklisted)
+	legacySet(t, eurcStore, "PendingOutflow/value/transfer/channel-0/"+string(sdk.Uint64ToBigEndian(outflow.Sequence)), &outflow)
+
+	require.NoError(t, keeper.NewMigrator(ftf).Migrate3to4(ctx))
+
+	fiatTokens, err := ftf.GetAllFiatTokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.FiatToken{{Denom: "ueurc"}}, fiatTokens)
+	gotParams, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+	mintingDenom, err := ftf.GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", mintingDenom.Denom)
+	gotPaused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paused, gotPaused)
+
+	gotOwner, found, err := ftf.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, owner, gotOwner)
+	gotPendingOwner, found, err := ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, pendingOwner, gotPendingOwner)
+
+	gotMinters, found, err := ftf.GetMinters(ctx, minters.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, minters, gotMinters)
+	gotController, found, err := ftf.GetMinterController(ctx, link.Controller)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, link, gotController)
+	links, err := ftf.GetAllMinterControllerLinks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.MinterController{link}, links)
+	links, err = ftf.GetMintersOfController(ctx, link.Controller)
+	require.NoError(t, err)
+	require.Equal(t, []types.MinterController{link}, links)
+
+	eurc, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	paused, err = eurc.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{}, paused)
+	gotBlacklisted, found, err := eurc.GetBlacklisted(ctx, blacklisted.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, blacklisted, gotBlacklisted)
+	_, found, err = ftf.GetBlacklisted(ctx, blacklisted.AddressBz)
+	require.NoError(t, err)
+	require.False(t, found)
+	gotOutflow, found, err := eurc.GetPendingOutflow(ctx, outflow.PortId, outflow.ChannelId, outflow.Sequence)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, outflow, gotOutflow)
+
+	// no key of the version 3 layout is left behind
+	iterator := root.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		require.Less(t, iterator.Key()[0], byte('A'), "legacy key %q", iterator.Key())
+	}
+}
+
+func TestMigrate3to4_SharedMinter(t *testing.T) {
+	ftf, ctx, key := keepertest.FiatTokenfactoryKeeperWithStoreKey()
+	root := ctx.KVStore(key)
+
+	minter := sample.AccAddress()
+	primary := types.MinterController{Controller: sample.AccAddress(), Minter: minter}
+	shared := types.MinterController{Controller: sample.AccAddress(), Minter: minter}
+
+	legacySet(t, root, "MintingDenom/value/MintingDenom/value/", &types.MintingDenom{Denom: "uusdc"})
+	legacySet(t, root, "Paused/value/", &types.Paused{})
+	for _, link := range []types.MinterController{primary, shared} {
+		legacySet(t, root, "MinterController/value/"+link.Controller+"/", &link)
+		legacySet(t, root, "ControllerMinters/value/"+link.Controller+"/"+link.Minter+"/", &link)
+		legacySet(t, root, "MinterControllers/value/"+link.Minter+"/"+link.Controller+"/", &link)
+	}
+
+	require.NoError(t, keeper.NewMigrator(ftf).Migrate3to4(ctx))
+
+	// the params are stored with a limit that the shared minter satisfies
+	params, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, params.MaxControllersPerMinter)
+	require.NoError(t, fiattokenfactory.ExportGenesis(ctx, ftf).Validate())
+
+	// a third controller still exceeds the limit
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	masterMinter := sample.AccAddress()
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	_, err = msgServer.ConfigureMinterController(ctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: sample.AccAddress(), Minter: minter})
+	require.ErrorIs(t, err, types.ErrMaxControllers)
+}
+
+func TestMigrateFromVersion1(t *testing.T) {
+	ftf, ctx, key := keepertest.FiatTokenfactoryKeeperWithStoreKey()
+	blacklisted := sample.TestAccount()
+	primary := types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress()}
+	eurcPrimary := types.MinterController{Controller: sample.AccAddress(), Minter: sample.AccAddress()}
+	v1Fixture(t, ctx, key, blacklisted.AddressBz, primary, eurcPrimary)
+
+	m := keeper.NewMigrator(ftf)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.NoError(t, m.Migrate3to4(ctx))
+
+	eurc, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+
+	for token, minterController := range map[*keeper.Keeper]types.MinterController{ftf: primary, eurc: eurcPrimary} {
+		entry, found, err := token.GetBlacklisted(ctx, blacklisted.AddressBz)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, blacklisted.AddressBz, entry.AddressBz)
+		require.Zero(t, entry.Height)
+
+		for _, scope := range types.PauseScopes {
+			paused, err := token.GetPaused(ctx)
+			require.NoError(t, err)
+			require.True(t, paused.IsPaused(scope))
+		}
+
+		minterControllers, err := token.GetAllMinterControllers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []types.MinterController{minterController}, minterControllers)
+		links, err := token.GetAllMinterControllerLinks(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []types.MinterController{minterController}, links)
+		found, err = token.HasMinterControllerLink(ctx, minterController.Controller, minterController.Minter)
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+
+	mintingDenom, err := ftf.GetMintingDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", mintingDenom.Denom)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetMintRateLimit set a specific mintRateLimit in the store from its index
+func (k Keeper) SetMintRateLimit(ctx context.Context, rateLimit types.MintRateLimit) error {
+	return k.mintRateLimits.Set(ctx, rateLimit.Minter, rateLimit)
+}
+
+// GetMintRateLimit returns a mintRateLimit from its index
+func (k Keeper) GetMintRateLimit(ctx context.Context, minter string) (val types.MintRateLimit, found bool, err error) {
+	val, err = k.mintRateLimits.Get(ctx, minter)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// DeleteMintRateLimit removes the mintRateLimit of a minter together with its window
+func (k Keeper) DeleteMintRateLimit(ctx context.Context, minter string) error {
+	if err := k.mintRateLimits.Remove(ctx, minter); err != nil {
+		return err
+	}
+
+	return k.DeleteMintWindow(ctx, minter)
+}
+
+// GetAllMintRateLimits returns all mintRateLimit
+func (k Keeper) GetAllMintRateLimits(ctx context.Context) (list []types.MintRateLimit, err error) {
+	return values(ctx, k.mintRateLimits, nil)
+}
+
+// SetMintWindow set a specific mintWindow in the store from its index
+func (k Keeper) SetMintWindow(ctx context.Context, window types.MintWindow) error {
+	return k.mintWindows.Set(ctx, window.Minter, window)
+}
+
+// GetMintWindow returns a mintWindow from its index
+func (k Keeper) GetMintWindow(ctx context.Context, minter string) (val types.MintWindow, found bool, err error) {
+	val, err = k.mintWindows.Get(ctx, minter)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// DeleteMintWindow removes a mintWindow from the store
+func (k Keeper) DeleteMintWindow(ctx context.Context, minter string) error {
+	return k.mintWindows.Remove(ctx, minter)
+}
+
+// GetAllMintWindows returns all mintWindow
+func (k Keeper) GetAllMintWindows(ctx context.Context) (list []types.MintWindow, err error) {
+	return values(ctx, k.mintWindows, nil)
+}
+
+// CurrentMintWindow returns the rolling window of a rate limited minter as of the current
+// block, holding only the amounts minted within the length of the window.
+func (k Keeper) CurrentMintWindow(ctx context.Context, rateLimit types.MintRateLimit) (types.MintWindow, error) {
+	window, found, err := k.GetMintWindow(ctx, rateLimit.Minter)
+	if err != nil {
+		return window, err
+	}
+	if !found {
+		window = types.MintWindow{Minter: rateLimit.Minter}
+	}
+
+	return window.Current(mintWindowPosition(ctx, rateLimit.Unit), rateLimit.Window), nil
+}
+
+// consumeMintRateLimit records amount against the window of a rate limited minter,
+// failing if it would exceed the limit of the window.
+func (k Keeper) consumeMintRateLimit(ctx context.Context, minter string, amount math.Int) error {
+	rateLimit, found, err := k.GetMintRateLimit(ctx, minter)
+	if err != nil || !found {
+		return err
+	}
+
+	window, err := k.CurrentMintWindow(ctx, rateLimit)
+	if err != nil {
+		return err
+	}
+	if window.Minted().Add(amount).GT(rateLimit.Limit) {
+		return sdkerrors.Wrapf(
+			types.ErrMintRateLimited,
+			"minting %s would exceed the limit of %s per %d %s",
+			amount, rateLimit.Limit, rateLimit.Window, rateLimit.Unit,
+		)
+	}
+
+	window.Add(mintWindowPosition(ctx, rateLimit.Unit), rateLimit.Window, amount)
+	return k.SetMintWindow(ctx, window)
+}
+
+func mintWindowPosition(ctx context.Context, unit types.WindowUnit) int64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if unit == types.WINDOW_UNIT_SECONDS {
+		return sdkCtx.BlockTime().Unix()
+	}
+
+	return sdkCtx.BlockHeight()
+}
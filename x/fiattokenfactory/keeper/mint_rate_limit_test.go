@@ -0,0 +1,92 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMintRateLimitGetAndSet(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	minter := sample.AccAddress()
+
+	_, found, err := keeper.GetMintRateLimit(ctx, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	rateLimit := types.MintRateLimit{Minter: minter, Limit: math.NewInt(10), Window: 5, Unit: types.WINDOW_UNIT_BLOCKS}
+	keeper.SetMintRateLimit(ctx, rateLimit)
+	keeper.SetMintWindow(ctx, types.MintWindow{Minter: minter, Buckets: []types.MintBucket{{Start: 1, Minted: math.NewInt(3)}}})
+
+	rst, found, err := keeper.GetMintRateLimit(ctx, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, rateLimit, rst)
+	mintRateLimits, err := keeper.GetAllMintRateLimits(ctx)
+	require.NoError(t, err)
+	require.Len(t, mintRateLimits, 1)
+	mintWindows, err := keeper.GetAllMintWindows(ctx)
+	require.NoError(t, err)
+	require.Len(t, mintWindows, 1)
+
+	keeper.DeleteMintRateLimit(ctx, minter)
+	_, found, err = keeper.GetMintRateLimit(ctx, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = keeper.GetMintWindow(ctx, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestCurrentMintWindow(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	minter := sample.AccAddress()
+	now := time.Unix(1_000, 0)
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(now)
+
+	rateLimit := types.MintRateLimit{Minter: minter, Limit: math.NewInt(10), Window: 60, Unit: types.WINDOW_UNIT_SECONDS}
+
+	// no window yet is an empty one
+	window, err := keeper.CurrentMintWindow(ctx, rateLimit)
+	require.NoError(t, err)
+	require.Equal(t, types.MintWindow{Minter: minter}, window)
+	require.True(t, window.Minted().IsZero())
+	require.Zero(t, window.NextRelease(rateLimit.Window))
+
+	// buckets are six seconds long, the bucket of now started at 996
+	window.Add(now.Unix(), rateLimit.Window, math.NewInt(7))
+	require.Equal(t, []types.MintBucket{{Start: 996, Minted: math.NewInt(7)}}, window.Buckets)
+	keeper.SetMintWindow(ctx, window)
+
+	// the amount counts until the window has elapsed since the end of its bucket
+	window, err = keeper.CurrentMintWindow(ctx.WithBlockTime(now.Add(60*time.Second)), rateLimit)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(7), window.Minted())
+	require.Equal(t, int64(1061), window.NextRelease(rateLimit.Window))
+
+	window, err = keeper.CurrentMintWindow(ctx.WithBlockTime(now.Add(61*time.Second)), rateLimit)
+	require.NoError(t, err)
+	require.Equal(t, types.MintWindow{Minter: minter}, window)
+}
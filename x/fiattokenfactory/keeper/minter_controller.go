@@ -21,64 +21,111 @@ import (
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 )
 
 // SetMinterController set a specific minterController in the store from its index
-func (k Keeper) SetMinterController(ctx context.Context, minterController types.MinterController) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	b := k.cdc.MustMarshal(&minterController)
-	store.Set(types.MinterControllerKey(
-		minterController.Controller,
-	), b)
+func (k Keeper) SetMinterController(ctx context.Context, minterController types.MinterController) error {
+	return k.minterControllers.Set(ctx, minterController.Controller, minterController)
 }
 
 // GetMinterController returns a minterController from its index
 func (k Keeper) GetMinterController(
 	ctx context.Context,
 	controller string,
-) (val types.MinterController, found bool) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-
-	b := store.Get(types.MinterControllerKey(
-		controller,
-	))
-	if b == nil {
-		return val, false
-	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+) (val types.MinterController, found bool, err error) {
+	val, err = k.minterControllers.Get(ctx, controller)
+	found, err = exists(err)
+	return val, found, err
 }
 
 // RemoveMinterController removes a minterController from the store
 func (k Keeper) DeleteMinterController(
 	ctx context.Context,
 	controller string,
-) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	store.Delete(types.MinterControllerKey(
-		controller,
-	))
+) error {
+	return k.minterControllers.Remove(ctx, controller)
 }
 
 // GetAllMinterController returns all minterController
-func (k Keeper) GetAllMinterControllers(ctx context.Context) (list []types.MinterController) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	iterator := store.Iterator(nil, nil)
+func (k Keeper) GetAllMinterControllers(ctx context.Context) (list []types.MinterController, err error) {
+	return values(ctx, k.minterControllers, nil)
+}
+
+// SetMinterControllerLink links a minter to a controller, indexing the link in both directions
+func (k Keeper) SetMinterControllerLink(ctx context.Context, minterController types.MinterController) error {
+	if err := k.controllerMinters.Set(ctx, collections.Join(minterController.Controller, minterController.Minter), minterController); err != nil {
+		return err
+	}
+
+	return k.minterControllerLinks.Set(ctx, collections.Join(minterController.Minter, minterController.Controller), minterController)
+}
+
+// HasMinterControllerLink returns whether the minter is linked to the controller
+func (k Keeper) HasMinterControllerLink(ctx context.Context, controller string, minter string) (bool, error) {
+	return k.controllerMinters.Has(ctx, collections.Join(controller, minter))
+}
+
+// DeleteMinterControllerLink removes the link between a minter and a controller
+func (k Keeper) DeleteMinterControllerLink(ctx context.Context, controller string, minter string) error {
+	if err := k.controllerMinters.Remove(ctx, collections.Join(controller, minter)); err != nil {
+		return err
+	}
+
+	return k.minterControllerLinks.Remove(ctx, collections.Join(minter, controller))
+}
+
+// GetMintersOfController returns all links of a controller
+func (k Keeper) GetMintersOfController(ctx context.Context, controller string) (list []types.MinterController, err error) {
+	return values(ctx, k.controllerMinters, collections.NewPrefixedPairRange[string, string](controller))
+}
+
+// GetControllersOfMinter returns all links of a minter
+func (k Keeper) GetControllersOfMinter(ctx context.Context, minter string) (list []types.MinterController, err error) {
+	return values(ctx, k.minterControllerLinks, collections.NewPrefixedPairRange[string, string](minter))
+}
+
+// GetAllMinterControllerLinks returns all links between minters and controllers
+func (k Keeper) GetAllMinterControllerLinks(ctx context.Context) (list []types.MinterController, err error) {
+	return values(ctx, k.controllerMinters, nil)
+}
+
+// IsControllerOfMinter returns whether the controller is allowed to manage the minter, either
+// through a link or because the minter is the controller's primary minter.
+func (k Keeper) IsControllerOfMinter(ctx context.Context, controller string, minter string) (bool, error) {
+	linked, err := k.HasMinterControllerLink(ctx, controller, minter)
+	if err != nil || linked {
+		return linked, err
+	}
 
-	defer iterator.Close()
+	minterController, found, err := k.GetMinterController(ctx, controller)
+	return found && minterController.Minter == minter, err
+}
+
+// checkMaxControllersPerMinter returns an error if linking the minter to the controller would give the
+// minter more controllers than the params allow. A controller already linked to the minter does not count twice.
+func (k Keeper) checkMaxControllersPerMinter(ctx context.Context, minter string, controller string) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	links, err := k.GetControllersOfMinter(ctx, minter)
+	if err != nil {
+		return err
+	}
+
+	controllers := 1
+	for _, link := range links {
+		if link.Controller != controller {
+			controllers++
+		}
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.MinterController
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	if params.ExceedsMaxControllersPerMinter(controllers) {
+		return errors.Wrapf(types.ErrMaxControllers, "minter (%s) cannot have more than %d controllers", minter, params.MaxControllersPerMinter)
 	}
 
-	return
+	return nil
 }
@@ -97,21 +97,25 @@ func TestMinterControllerDelete_AddressIsController(t *testing.T) {
 func TestMinterControllerGetAll_EmptyControllerList(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	controllers := keeper.GetAllMinterControllers(ctx)
+	controllers, err := keeper.GetAllMinterControllers(ctx)
+	require.NoError(t, err)
 	require.Empty(t, controllers)
 }
 
 func TestMinterControllerGetAll_NonEmptyControllerList(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	items := createNMinterController(keeper, ctx, 10)
+	minterControllers, err := keeper.GetAllMinterControllers(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(items),
-		nullify.Fill(keeper.GetAllMinterControllers(ctx)),
+		nullify.Fill(minterControllers),
 	)
 }
 
 func assertAccountIsAController(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, controller sample.Account, minter sample.Account) {
-	rst, found := keeper.GetMinterController(ctx, controller.Address)
+	rst, found, err := keeper.GetMinterController(ctx, controller.Address)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.MinterController{Controller: controller.Address, Minter: minter.Address}),
@@ -120,6 +124,7 @@ func assertAccountIsAController(t *testing.T, keeper keeper.Keeper, ctx sdk.Cont
 }
 
 func assertAccountIsNotAController(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, controller sample.Account) {
-	_, found := keeper.GetMinterController(ctx, controller.Address)
+	_, found, err := keeper.GetMinterController(ctx, controller.Address)
+	require.NoError(t, err)
 	require.False(t, found)
 }
@@ -19,65 +19,33 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetMinters set a specific minters in the store from its index
-func (k Keeper) SetMinters(ctx context.Context, minters types.Minters) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintersKeyPrefix))
-	b := k.cdc.MustMarshal(&minters)
-	store.Set(types.MintersKey(
-		minters.Address,
-	), b)
+func (k Keeper) SetMinters(ctx context.Context, minters types.Minters) error {
+	return k.minters.Set(ctx, minters.Address, minters)
 }
 
 // GetMinters returns a minters from its index
 func (k Keeper) GetMinters(
 	ctx context.Context,
 	address string,
-) (val types.Minters, found bool) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintersKeyPrefix))
-
-	b := store.Get(types.MintersKey(
-		address,
-	))
-	if b == nil {
-		return val, false
-	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+) (val types.Minters, found bool, err error) {
+	val, err = k.minters.Get(ctx, address)
+	found, err = exists(err)
+	return val, found, err
 }
 
 // RemoveMinters removes a minters from the store
 func (k Keeper) RemoveMinters(
 	ctx context.Context,
 	address string,
-) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintersKeyPrefix))
-	store.Delete(types.MintersKey(
-		address,
-	))
+) error {
+	return k.minters.Remove(ctx, address)
 }
 
 // GetAllMinters returns all minters
-func (k Keeper) GetAllMinters(ctx context.Context) (list []types.Minters) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintersKeyPrefix))
-	iterator := store.Iterator(nil, nil)
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Minters
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
-	}
-
-	return
+func (k Keeper) GetAllMinters(ctx context.Context) (list []types.Minters, err error) {
+	return values(ctx, k.minters, nil)
 }
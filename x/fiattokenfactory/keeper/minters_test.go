@@ -97,21 +97,25 @@ func TestMintersRemove_AddressIsMinter(t *testing.T) {
 func TestMintersGetAll_EmptyMinterList(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	controllers := keeper.GetAllMinters(ctx)
+	controllers, err := keeper.GetAllMinters(ctx)
+	require.NoError(t, err)
 	require.Empty(t, controllers)
 }
 
 func TestMintersGetAll_NonEmptyMinterList(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	items := createNMinters(keeper, ctx, 10)
+	minters, err := keeper.GetAllMinters(ctx)
+	require.NoError(t, err)
 	require.ElementsMatch(t,
 		nullify.Fill(items),
-		nullify.Fill(keeper.GetAllMinters(ctx)),
+		nullify.Fill(minters),
 	)
 }
 
 func assertAccountIsAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, minter sample.Account, allowance sdk.Coin) {
-	rst, found := keeper.GetMinters(ctx, minter.Address)
+	rst, found, err := keeper.GetMinters(ctx, minter.Address)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.Minters{Address: minter.Address, Allowance: allowance}),
@@ -120,6 +124,7 @@ func assertAccountIsAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context,
 }
 
 func assertAccountIsNotAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, minter sample.Account) {
-	_, found := keeper.GetMinters(ctx, minter.Address)
+	_, found, err := keeper.GetMinters(ctx, minter.Address)
+	require.NoError(t, err)
 	require.False(t, found)
 }
@@ -18,51 +18,49 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 
-	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/errors"
 )
 
 // SetMintingDenom set mintingDenom in the store
-func (k *Keeper) SetMintingDenom(ctx context.Context, mintingDenom types.MintingDenom) {
-	if k.MintingDenomSet(ctx) {
-		panic(types.ErrMintingDenomSet)
+func (k *Keeper) SetMintingDenom(ctx context.Context, mintingDenom types.MintingDenom) error {
+	set, err := k.MintingDenomSet(ctx)
+	if err != nil {
+		return err
+	}
+	if set {
+		return types.ErrMintingDenomSet
 	}
 
 	_, found := k.bankKeeper.GetDenomMetaData(ctx, mintingDenom.Denom)
 	if !found {
-		panic(fmt.Sprintf("Denom metadata for '%s' should be set", mintingDenom.Denom))
+		return errors.Wrapf(types.ErrDenomNotRegistered, "denom metadata for '%s' should be set", mintingDenom.Denom)
 	}
 
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
-	b := k.cdc.MustMarshal(&mintingDenom)
-	store.Set(types.KeyPrefix(types.MintingDenomKey), b)
+	return k.mintingDenom.Set(ctx, mintingDenom)
 }
 
 // GetMintingDenom returns mintingDenom
-func (k *Keeper) GetMintingDenom(ctx context.Context) (val types.MintingDenom) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
+func (k *Keeper) GetMintingDenom(ctx context.Context) (val types.MintingDenom, err error) {
+	if k.denom != "" {
+		return types.MintingDenom{Denom: k.denom}, nil
+	}
 
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
-	if b == nil {
-		panic("Minting denom is not set")
+	val, err = k.mintingDenom.Get(ctx)
+	if err != nil {
+		return val, errors.Wrap(err, "minting denom is not set")
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val
+	return val, nil
 }
 
 // MintingDenomSet returns true if the MintingDenom is already set in the store, it returns false otherwise.
-func (k Keeper) MintingDenomSet(ctx context.Context) bool {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
-
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
+func (k Keeper) MintingDenomSet(ctx context.Context) (bool, error) {
+	if k.denom != "" {
+		return true, nil
+	}
 
-	return b != nil
+	return k.mintingDenom.Has(ctx)
 }
@@ -19,6 +19,8 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -40,37 +42,42 @@ func TestMintingDenomGetAndSet_Success(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	expectedDenom := createTestMintingDenom(keeper, ctx)
 
-	denom := keeper.GetMintingDenom(ctx)
+	denom, err := keeper.GetMintingDenom(ctx)
+	require.NoError(t, err)
 	require.Equal(t,
 		nullify.Fill(&expectedDenom),
 		nullify.Fill(&denom),
 	)
 }
 
-func TestSetMintingDenom_AlreadySetPanics(t *testing.T) {
+func TestSetMintingDenom_AlreadySet(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	createTestMintingDenom(keeper, ctx)
 
-	require.Panics(t, func() { keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"}) })
+	err := keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrMintingDenomSet)
 }
 
-func TestSetMintingDenom_NoMetadataPanics(t *testing.T) {
+func TestSetMintingDenom_NoMetadata(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
 	// in the mock bank keeper, it provides metadata only for uusdc
-	require.Panics(t, func() { keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "notadenom"}) })
+	err := keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "notadenom"})
+	require.ErrorIs(t, err, types.ErrDenomNotRegistered)
 }
 
-func TestMintingDenomGet_UnsetPanics(t *testing.T) {
+func TestMintingDenomGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	require.Panics(t, func() { keeper.GetMintingDenom(ctx) })
+	_, err := keeper.GetMintingDenom(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
 }
 
 func TestIsMintingDenomSet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	isSet := keeper.MintingDenomSet(ctx)
+	isSet, err := keeper.MintingDenomSet(ctx)
+	require.NoError(t, err)
 	require.False(t, isSet)
 }
 
@@ -78,6 +85,7 @@ func TestIsMintingDenomSet_AlreadySet(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	createTestMintingDenom(keeper, ctx)
 
-	isSet := keeper.MintingDenomSet(ctx)
+	isSet, err := keeper.MintingDenomSet(ctx)
+	require.NoError(t, err)
 	require.True(t, isSet)
 }
@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptMasterMinter(goCtx context.Context, msg *types.MsgAcceptMasterMinter) (*types.MsgAcceptMasterMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingMasterMinter, found, err := token.GetPendingMasterMinter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending master minter is not set")
+	}
+
+	if pendingMasterMinter.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending master minter")
+	}
+
+	if ctx.BlockHeight() < pendingMasterMinter.ActivationHeight {
+		return nil, sdkerrors.Wrapf(types.ErrHandoverDelay, "pending master minter can accept at height %d", pendingMasterMinter.ActivationHeight)
+	}
+
+	// ensure that the pending address has not been assigned to a privileged role since it was nominated
+	err = token.ValidatePrivileges(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	masterMinter := types.MasterMinter{
+		Address: pendingMasterMinter.Address,
+	}
+
+	if err := token.SetMasterMinter(ctx, masterMinter); err != nil {
+		return nil, err
+	}
+
+	if err := token.DeletePendingMasterMinter(ctx); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgAcceptMasterMinterResponse{}, err
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptMasterMinter_NoPendingMasterMinter(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AcceptMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgAcceptMasterMinter{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "pending master minter is not set")
+}
+
+func TestAcceptMasterMinter_FromAddressIsNotPendingMasterMinter(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	ftf.SetPendingMasterMinter(ctx, types.PendingRole{Address: sample.AccAddress()})
+	_, err := msgServer.AcceptMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgAcceptMasterMinter{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the pending master minter")
+}
+
+func TestAcceptMasterMinter_DelayNotElapsed(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pendingMasterMinter := sample.AccAddress()
+
+	ftf.SetPendingMasterMinter(ctx, types.PendingRole{Address: pendingMasterMinter, ActivationHeight: ctx.BlockHeight() + 10})
+	_, err := msgServer.AcceptMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgAcceptMasterMinter{From: pendingMasterMinter})
+	require.ErrorIs(t, err, types.ErrHandoverDelay)
+
+	_, found, err := ftf.GetMasterMinter(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestAcceptMasterMinter_AddressAlreadyPrivileged(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pendingMasterMinter := sample.AccAddress()
+
+	ftf.SetPendingMasterMinter(ctx, types.PendingRole{Address: pendingMasterMinter})
+	ftf.SetOwner(ctx, types.Owner{Address: pendingMasterMinter})
+	_, err := msgServer.AcceptMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgAcceptMasterMinter{From: pendingMasterMinter})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+}
+
+func TestAcceptMasterMinter_Success(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pendingMasterMinter := sample.AccAddress()
+
+	ftf.SetPendingMasterMinter(ctx, types.PendingRole{Address: pendingMasterMinter, ActivationHeight: ctx.BlockHeight()})
+	res, err := msgServer.AcceptMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgAcceptMasterMinter{From: pendingMasterMinter})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAcceptMasterMinterResponse{}, res)
+
+	masterMinter, found, err := ftf.GetMasterMinter(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, pendingMasterMinter, masterMinter.Address)
+	_, found, err = ftf.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
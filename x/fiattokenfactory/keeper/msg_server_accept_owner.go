@@ -28,20 +28,36 @@ import (
 func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner) (*types.MsgAcceptOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetPendingOwner(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingOwner, found, err := token.GetPendingOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending owner is not set")
 	}
 
-	if owner.Address != msg.From {
+	if pendingOwner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending owner")
 	}
 
-	k.SetOwner(ctx, owner)
+	if ctx.BlockHeight() < pendingOwner.ActivationHeight {
+		return nil, sdkerrors.Wrapf(types.ErrHandoverDelay, "pending owner can accept at height %d", pendingOwner.ActivationHeight)
+	}
 
-	k.DeletePendingOwner(ctx)
+	if err := token.SetOwner(ctx, types.Owner{Address: pendingOwner.Address}); err != nil {
+		return nil, err
+	}
+
+	if err := token.DeletePendingOwner(ctx); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgAcceptOwnerResponse{}, err
 }
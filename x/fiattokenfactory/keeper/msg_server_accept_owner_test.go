@@ -39,18 +39,40 @@ func TestAcceptOwner_FromAddressIsNotPendingOwner(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	ftf.SetPendingOwner(ctx, types.Owner{Address: sample.AccAddress()})
+	ftf.SetPendingOwner(ctx, types.PendingRole{Address: sample.AccAddress()})
 	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress()})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 }
 
+func TestAcceptOwner_DelayNotElapsed(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pendingOwner := sample.AccAddress()
+
+	ftf.SetPendingOwner(ctx, types.PendingRole{Address: pendingOwner, ActivationHeight: ctx.BlockHeight() + 10})
+	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner})
+	require.ErrorIs(t, err, types.ErrHandoverDelay)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner})
+	require.NoError(t, err)
+}
+
 func TestAcceptOwner_Success(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	pendingOwner := sample.AccAddress()
 
-	ftf.SetPendingOwner(ctx, types.Owner{Address: pendingOwner})
+	ftf.SetPendingOwner(ctx, types.PendingRole{Address: pendingOwner})
 	res, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgAcceptOwnerResponse{}, res)
+
+	owner, found, err := ftf.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, pendingOwner, owner.Address)
+	_, found, err = ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
 }
@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptWiper(goCtx context.Context, msg *types.MsgAcceptWiper) (*types.MsgAcceptWiperResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingWiper, found, err := token.GetPendingWiper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending wiper is not set")
+	}
+
+	if pendingWiper.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending wiper")
+	}
+
+	if ctx.BlockHeight() < pendingWiper.ActivationHeight {
+		return nil, sdkerrors.Wrapf(types.ErrHandoverDelay, "pending wiper can accept at height %d", pendingWiper.ActivationHeight)
+	}
+
+	// ensure that the pending address has not been assigned to a privileged role since it was nominated
+	err = token.ValidatePrivileges(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	wiper := types.Wiper{
+		Address: pendingWiper.Address,
+	}
+
+	if err := token.SetWiper(ctx, wiper); err != nil {
+		return nil, err
+	}
+
+	if err := token.DeletePendingWiper(ctx); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgAcceptWiperResponse{}, err
+}
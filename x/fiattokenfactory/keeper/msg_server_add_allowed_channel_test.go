@@ -0,0 +1,81 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAllowedChannel_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AddAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedChannel{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestAddAllowedChannel_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.AddAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedChannel{From: sample.AccAddress(), PortId: "transfer", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestAddAllowedChannel_AlreadyAllowed(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+	ftf.SetAllowedChannel(ctx, types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"})
+
+	_, err := msgServer.AddAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrChannelAllowed)
+}
+
+func TestAddAllowedChannel_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	res, err := msgServer.AddAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAddAllowedChannelResponse{}, res)
+
+	_, found, err := ftf.GetAllowedChannel(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// adding a channel enables the allowlist
+	allowlist, err := ftf.GetChannelAllowlist(ctx)
+	require.NoError(t, err)
+	require.True(t, allowlist.Enabled)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MsgAddAllowedChannel", events[len(events)-1].Type)
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddMinterToController_MasterMinterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "master minter is not set")
+}
+
+func TestAddMinterToController_FromAddressIsNotMasterMinter(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: sample.AccAddress()})
+
+	_, err := msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the master minter")
+}
+
+func TestAddMinterToController_ControllerNotConfigured(t *testing.T) {
+	masterMinter := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+
+	_, err := msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: masterMinter, Controller: sample.AccAddress(), Minter: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestAddMinterToController_AlreadyLinked(t *testing.T) {
+	masterMinter, controller, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller, Minter: minter})
+
+	_, err := msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: masterMinter, Controller: controller, Minter: minter})
+	require.ErrorIs(t, err, types.ErrMinterLinked)
+}
+
+func TestAddMinterToController_Success(t *testing.T) {
+	masterMinter, controller := sample.AccAddress(), sample.AccAddress()
+	primary, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: primary})
+
+	res, err := msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: masterMinter, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAddMinterToControllerResponse{}, res)
+
+	found, err := ftf.IsControllerOfMinter(ctx, controller, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = ftf.IsControllerOfMinter(ctx, controller, primary)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// the primary minter is untouched
+	minterController, found, err := ftf.GetMinterController(ctx, controller)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, primary, minterController.Minter)
+}
+
+func TestAddMinterToController_MaxControllersPerMinter(t *testing.T) {
+	masterMinter, controller, otherController := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	minter := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	for _, minterController := range []types.MinterController{
+		{Controller: controller, Minter: minter},
+		{Controller: otherController, Minter: sample.AccAddress()},
+	} {
+		ftf.SetMinterController(ctx, minterController)
+		ftf.SetMinterControllerLink(ctx, minterController)
+	}
+
+	params, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxControllersPerMinter = 1
+	ftf.SetParams(ctx, params)
+
+	// the minter is already managed by its primary controller
+	_, err = msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: masterMinter, Controller: otherController, Minter: minter})
+	require.ErrorIs(t, err, types.ErrMaxControllers)
+	require.ErrorContains(t, err, "cannot have more than 1 controllers")
+	found, err := ftf.HasMinterControllerLink(ctx, otherController, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	params.MaxControllersPerMinter = 2
+	ftf.SetParams(ctx, params)
+
+	_, err = msgServer.AddMinterToController(sdk.WrapSDKContext(ctx), &types.MsgAddMinterToController{From: masterMinter, Controller: otherController, Minter: minter})
+	require.NoError(t, err)
+	controllers, err := ftf.GetControllersOfMinter(ctx, minter)
+	require.NoError(t, err)
+	require.Len(t, controllers, 2)
+}
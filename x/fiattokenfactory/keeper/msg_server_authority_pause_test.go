@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorityPause_InvalidAuthority(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{})
+
+	// the pauser can not use the authority override
+	_, err := msgServer.AuthorityPause(sdk.WrapSDKContext(ctx), &types.MsgAuthorityPause{Authority: pauser.Address})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "invalid authority")
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{}, paused)
+}
+
+func TestAuthorityPause_Success(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	paused := types.Paused{}
+	paused.Pause(10, 0)
+	ftf.SetPaused(ctx, paused)
+
+	res, err := msgServer.AuthorityPause(sdk.WrapSDKContext(ctx), &types.MsgAuthorityPause{Authority: testkeeper.Authority})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAuthorityPauseResponse{}, res)
+
+	// the pause does not inherit a previously scheduled expiry
+	paused, err = ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPaused(), paused)
+}
+
+func TestAuthorityPause_Scopes(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPaused(ctx, types.Paused{})
+
+	_, err := msgServer.AuthorityPause(sdk.WrapSDKContext(ctx), &types.MsgAuthorityPause{
+		Authority: testkeeper.Authority,
+		Scopes:    []types.PauseScope{types.PAUSE_SCOPE_MINT, types.PAUSE_SCOPE_IBC},
+	})
+	require.NoError(t, err)
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Mint: true, Ibc: true}, paused)
+}
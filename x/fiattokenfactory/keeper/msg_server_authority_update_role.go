@@ -0,0 +1,91 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AuthorityUpdateRole(goCtx context.Context, msg *types.MsgAuthorityUpdateRole) (*types.MsgAuthorityUpdateRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOverrideAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure that the specified address is not already assigned to a privileged role
+	err = token.ValidatePrivileges(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the role is assigned immediately and any pending handover is dropped, as
+	// the current holder may be the key being recovered from
+	switch msg.Role {
+	case types.ROLE_OWNER:
+		if err := token.SetOwner(ctx, types.Owner{Address: msg.Address}); err != nil {
+			return nil, err
+		}
+		if err := token.DeletePendingOwner(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_MASTER_MINTER:
+		if err := token.SetMasterMinter(ctx, types.MasterMinter{Address: msg.Address}); err != nil {
+			return nil, err
+		}
+		if err := token.DeletePendingMasterMinter(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_PAUSER:
+		if err := token.SetPauser(ctx, types.Pauser{Address: msg.Address}); err != nil {
+			return nil, err
+		}
+		if err := token.DeletePendingPauser(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_BLACKLISTER:
+		if err := token.SetBlacklister(ctx, types.Blacklister{Address: msg.Address}); err != nil {
+			return nil, err
+		}
+		if err := token.DeletePendingBlacklister(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_WIPER:
+		if err := token.SetWiper(ctx, types.Wiper{Address: msg.Address}); err != nil {
+			return nil, err
+		}
+		if err := token.DeletePendingWiper(ctx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidType, "invalid role %s", msg.Role)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgAuthorityUpdateRoleResponse{}, err
+}
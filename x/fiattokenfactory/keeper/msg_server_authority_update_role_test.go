@@ -0,0 +1,164 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorityUpdateRole_AuthorityDisabled(t *testing.T) {
+	_, ctx, key := testkeeper.FiatTokenfactoryKeeperWithStoreKey()
+	ftf := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		testkeeper.Authority,
+		true,
+		testkeeper.MockBankKeeper{},
+	)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+		Authority: testkeeper.Authority,
+		Role:      types.ROLE_OWNER,
+		Address:   sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "authority override is disabled")
+}
+
+func TestAuthorityUpdateRole_InvalidAuthority(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+		Authority: sample.AccAddress(),
+		Role:      types.ROLE_OWNER,
+		Address:   sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "invalid authority")
+}
+
+func TestAuthorityUpdateRole_AlreadyPrivileged(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+
+	_, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+		Authority: testkeeper.Authority,
+		Role:      types.ROLE_OWNER,
+		Address:   pauser.Address,
+	})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+}
+
+func TestAuthorityUpdateRole_UnknownDenom(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+		Authority: testkeeper.Authority,
+		Role:      types.ROLE_OWNER,
+		Address:   sample.AccAddress(),
+		Denom:     "ueurc",
+	})
+	require.ErrorIs(t, err, types.ErrFiatTokenNotFound)
+}
+
+func TestAuthorityUpdateRole_Success(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	// a lost owner key left a handover pending that can never be accepted
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+	ftf.SetPendingOwner(ctx, types.PendingRole{Address: sample.AccAddress()})
+
+	roles := []types.Role{types.ROLE_OWNER, types.ROLE_MASTER_MINTER, types.ROLE_PAUSER, types.ROLE_BLACKLISTER, types.ROLE_WIPER}
+	addresses := make(map[types.Role]string)
+	for _, role := range roles {
+		addresses[role] = sample.AccAddress()
+
+		res, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+			Authority: testkeeper.Authority,
+			Role:      role,
+			Address:   addresses[role],
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgAuthorityUpdateRoleResponse{}, res)
+	}
+
+	owner, _, err := ftf.GetOwner(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addresses[types.ROLE_OWNER], owner.Address)
+	_, found, err := ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	masterMinter, _, err := ftf.GetMasterMinter(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addresses[types.ROLE_MASTER_MINTER], masterMinter.Address)
+	pauser, _, err := ftf.GetPauser(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addresses[types.ROLE_PAUSER], pauser.Address)
+	blacklister, _, err := ftf.GetBlacklister(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addresses[types.ROLE_BLACKLISTER], blacklister.Address)
+	wiper, _, err := ftf.GetWiper(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addresses[types.ROLE_WIPER], wiper.Address)
+}
+
+func TestAuthorityUpdateRole_FiatToken(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+
+	owner := sample.AccAddress()
+	_, err := msgServer.AuthorityUpdateRole(sdk.WrapSDKContext(ctx), &types.MsgAuthorityUpdateRole{
+		Authority: testkeeper.Authority,
+		Role:      types.ROLE_OWNER,
+		Address:   owner,
+		Denom:     "ueurc",
+	})
+	require.NoError(t, err)
+
+	token, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	tokenOwner, found, err := token.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, owner, tokenOwner.Address)
+
+	// the minting denom is left untouched
+	_, found, err = ftf.GetOwner(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
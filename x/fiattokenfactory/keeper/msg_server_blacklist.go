@@ -28,7 +28,15 @@ import (
 func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*types.MsgBlacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	blacklister, found, err := token.GetBlacklister(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -42,16 +50,25 @@ func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*t
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found, err = token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
 	if found {
 		return nil, types.ErrUserBlacklisted
 	}
 
 	blacklisted := types.Blacklisted{
-		AddressBz: addressBz,
+		AddressBz:   addressBz,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		Blacklister: msg.From,
+		Reason:      msg.Reason,
 	}
 
-	k.SetBlacklisted(ctx, blacklisted)
+	if err := token.SetBlacklisted(ctx, blacklisted); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(msg)
 
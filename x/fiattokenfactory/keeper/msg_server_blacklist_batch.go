@@ -0,0 +1,100 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BlacklistBatch(goCtx context.Context, msg *types.MsgBlacklistBatch) (*types.MsgBlacklistBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	blacklister, found, err := token.GetBlacklister(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+	}
+
+	if blacklister.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Addresses) > int(params.MaxBatchSize) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "cannot process more than %d addresses at once", params.MaxBatchSize)
+	}
+
+	res := &types.MsgBlacklistBatchResponse{}
+
+	// addresses are keyed by their bytes, so one account cannot be listed twice under different prefixes
+	seen := make(map[string]struct{}, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		// charge every address the same, whether or not it is already blacklisted
+		ctx.GasMeter().ConsumeGas(types.BatchAddressGas, "blacklist batch address")
+
+		_, addressBz, err := DecodeNoLimitToBase256(address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid address %s", address)
+		}
+
+		if _, ok := seen[string(addressBz)]; ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "duplicate address %s", address)
+		}
+		seen[string(addressBz)] = struct{}{}
+
+		_, found, err := token.GetBlacklisted(ctx, addressBz)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			res.AlreadyBlacklisted = append(res.AlreadyBlacklisted, address)
+			continue
+		}
+
+		err = token.SetBlacklisted(ctx, types.Blacklisted{
+			AddressBz:   addressBz,
+			Height:      ctx.BlockHeight(),
+			Time:        ctx.BlockTime(),
+			Blacklister: msg.From,
+			Reason:      msg.Reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.Blacklisted = append(res.Blacklisted, address)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return res, err
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlacklistBatch_BlacklisterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "blacklister is not set")
+}
+
+func TestBlacklistBatch_FromAddressIsNotBlacklister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: sample.AccAddress()})
+
+	_, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the blacklister")
+}
+
+func TestBlacklistBatch_InvalidAddress(t *testing.T) {
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	_, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{sample.AccAddress(), "invalid address"}})
+	require.ErrorContains(t, err, "invalid address invalid address")
+}
+
+func TestBlacklistBatch_DuplicateAddress(t *testing.T) {
+	blacklister := sample.AccAddress()
+	account := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	// the same account under another prefix is still a duplicate
+	prefixed, err := bech32.ConvertAndEncode("noble", account.AddressBz)
+	require.NoError(t, err)
+
+	_, err = msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{account.Address, prefixed}})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	require.ErrorContains(t, err, "duplicate address "+prefixed)
+}
+
+func TestBlacklistBatch_ExceedsMaxBatchSize(t *testing.T) {
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+	params := types.DefaultParams()
+	params.MaxBatchSize = 1
+	ftf.SetParams(ctx, params)
+
+	_, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{sample.AccAddress(), sample.AccAddress()}})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	require.ErrorContains(t, err, "cannot process more than 1 addresses at once")
+}
+
+func TestBlacklistBatch_Idempotent(t *testing.T) {
+	blacklister := sample.AccAddress()
+	existing := sample.TestAccount()
+	newUser := sample.TestAccount()
+	newUserBech32m := sample.TestAccountBech32m()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(7)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: existing.AddressBz, Reason: "original"})
+
+	res, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{
+		From:      blacklister,
+		Addresses: []string{existing.Address, newUser.Address, newUserBech32m.Address},
+		Reason:    "list update",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{newUser.Address, newUserBech32m.Address}, res.Blacklisted)
+	require.Equal(t, []string{existing.Address}, res.AlreadyBlacklisted)
+
+	// existing entries keep their original record
+	entry, found, err := ftf.GetBlacklisted(ctx, existing.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "original", entry.Reason)
+
+	entry, found, err = ftf.GetBlacklisted(ctx, newUserBech32m.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(7), entry.Height)
+	require.Equal(t, blacklister, entry.Blacklister)
+	require.Equal(t, "list update", entry.Reason)
+}
+
+func TestBlacklistBatch_GasPerAddress(t *testing.T) {
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err := msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{sample.AccAddress()}})
+	require.NoError(t, err)
+	single := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = msgServer.BlacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{sample.AccAddress(), sample.AccAddress()}})
+	require.NoError(t, err)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), single+types.BatchAddressGas)
+}
@@ -18,6 +18,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil/bech32"
 	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
@@ -92,3 +93,28 @@ func TestBlacklist_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBlacklistResponse{}, res)
 }
+
+func TestBlacklist_RecordsAuditFields(t *testing.T) {
+	blacklister := sample.TestAccount()
+	user := sample.TestAccount()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(now)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: user.Address, Reason: "case 1234"})
+	require.NoError(t, err)
+
+	blacklisted, found, err := ftf.GetBlacklisted(ctx, user.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.Blacklisted{
+		AddressBz:   user.AddressBz,
+		Height:      42,
+		Time:        now,
+		Blacklister: blacklister.Address,
+		Reason:      "case 1234",
+	}, blacklisted)
+}
@@ -31,7 +31,19 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 }
 
 func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
-	_, found := k.GetMinters(ctx, msg.From)
+	// operate on the fiat token being burned, if it is managed by this module
+	token, found, err := k.ManagedDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		k = *token
+	}
+
+	_, found, err = k.GetMinters(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrBurn, "%v: you are not a minter", types.ErrUnauthorized)
 	}
@@ -41,12 +53,18 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found, err = k.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
 	if found {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "minter address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	mintingDenom, err := k.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if msg.Amount.Denom != mintingDenom.Denom {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
@@ -56,9 +74,12 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning amount is invalid")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused, err := k.GetPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if paused.Paused {
+	if paused.IsPaused(types.PAUSE_SCOPE_BURN) {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
 	}
 
@@ -124,7 +124,7 @@ func TestBurn_Paused(t *testing.T) {
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
 	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.NewPaused())
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning is paused")
@@ -196,10 +196,25 @@ func TestBurn_Success(t *testing.T) {
 	require.Equal(t, &types.MsgBurnResponse{}, res)
 }
 
+func TestBurn_OtherScopesPaused(t *testing.T) {
+	mintingDenom := "uusdc"
+	minter := sample.TestAccount()
+	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
+	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
+
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: amount})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: minter.Address, Amount: amount})
+	require.NoError(t, err)
+
+	ftf.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_MINT, types.PAUSE_SCOPE_TRANSFER, types.PAUSE_SCOPE_IBC))
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
+	require.NoError(t, err)
+}
+
 func setupForBurnTest(mintingDenom string) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
 }
@@ -0,0 +1,128 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelPendingRole(goCtx context.Context, msg *types.MsgCancelPendingRole) (*types.MsgCancelPendingRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, found, err := token.GetOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	// the owner initiates every handover and the current holder of the role hands
+	// it over, so either may cancel it; the nominee may additionally decline it.
+	var pending types.PendingRole
+	var pendingFound bool
+	switch msg.Role {
+	case types.ROLE_OWNER:
+		pending, pendingFound, err = token.GetPendingOwner(ctx)
+	case types.ROLE_MASTER_MINTER:
+		pending, pendingFound, err = token.GetPendingMasterMinter(ctx)
+	case types.ROLE_PAUSER:
+		pending, pendingFound, err = token.GetPendingPauser(ctx)
+	case types.ROLE_BLACKLISTER:
+		pending, pendingFound, err = token.GetPendingBlacklister(ctx)
+	case types.ROLE_WIPER:
+		pending, pendingFound, err = token.GetPendingWiper(ctx)
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidType, "invalid role %s", msg.Role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := roleHolder(ctx, token, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.From != owner.Address && msg.From != holder && (!pendingFound || msg.From != pending.Address) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner, the holder or the pending nominee of the role")
+	}
+
+	if !pendingFound {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "no pending handover for role %s", msg.Role)
+	}
+
+	switch msg.Role {
+	case types.ROLE_OWNER:
+		if err := token.DeletePendingOwner(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_MASTER_MINTER:
+		if err := token.DeletePendingMasterMinter(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_PAUSER:
+		if err := token.DeletePendingPauser(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_BLACKLISTER:
+		if err := token.DeletePendingBlacklister(ctx); err != nil {
+			return nil, err
+		}
+	case types.ROLE_WIPER:
+		if err := token.DeletePendingWiper(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgCancelPendingRoleResponse{}, err
+}
+
+// roleHolder returns the address currently holding the role, or an empty address if it is not assigned.
+func roleHolder(ctx context.Context, token *Keeper, role types.Role) (string, error) {
+	switch role {
+	case types.ROLE_OWNER:
+		owner, _, err := token.GetOwner(ctx)
+		return owner.Address, err
+	case types.ROLE_MASTER_MINTER:
+		masterMinter, _, err := token.GetMasterMinter(ctx)
+		return masterMinter.Address, err
+	case types.ROLE_PAUSER:
+		pauser, _, err := token.GetPauser(ctx)
+		return pauser.Address, err
+	case types.ROLE_BLACKLISTER:
+		blacklister, _, err := token.GetBlacklister(ctx)
+		return blacklister.Address, err
+	case types.ROLE_WIPER:
+		wiper, _, err := token.GetWiper(ctx)
+		return wiper.Address, err
+	default:
+		return "", sdkerrors.Wrapf(types.ErrInvalidType, "invalid role %s", role)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelPendingRole_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{Role: types.ROLE_PAUSER})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestCancelPendingRole_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: sample.AccAddress(), Role: types.ROLE_PAUSER})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestCancelPendingRole_CurrentHolder(t *testing.T) {
+	pauser := sample.AccAddress()
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser})
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+	ftf.SetPendingPauser(ctx, types.PendingRole{Address: sample.AccAddress()})
+	ftf.SetPendingBlacklister(ctx, types.PendingRole{Address: sample.AccAddress()})
+
+	// the holder of another role cannot cancel the handover
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: blacklister, Role: types.ROLE_PAUSER})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: pauser, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+
+	_, found, err := ftf.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = ftf.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestCancelPendingRole_Nominee(t *testing.T) {
+	nominee := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+	ftf.SetPendingPauser(ctx, types.PendingRole{Address: nominee})
+	ftf.SetPendingBlacklister(ctx, types.PendingRole{Address: sample.AccAddress()})
+
+	// the nominee cannot cancel the handover of another role
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: nominee, Role: types.ROLE_BLACKLISTER})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: nominee, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+
+	_, found, err := ftf.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestCancelPendingRole_NothingPending(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	for _, role := range []types.Role{types.ROLE_OWNER, types.ROLE_MASTER_MINTER, types.ROLE_PAUSER, types.ROLE_BLACKLISTER} {
+		_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: owner, Role: role})
+		require.ErrorIs(t, err, types.ErrUserNotFound)
+	}
+}
+
+func TestCancelPendingRole_InvalidRole(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: owner, Role: types.ROLE_UNSPECIFIED})
+	require.ErrorIs(t, err, types.ErrInvalidType)
+}
+
+func TestCancelPendingRole_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	pending := types.PendingRole{Address: sample.AccAddress()}
+	ftf.SetPendingOwner(ctx, pending)
+	ftf.SetPendingMasterMinter(ctx, pending)
+	ftf.SetPendingPauser(ctx, pending)
+	ftf.SetPendingBlacklister(ctx, pending)
+	ftf.SetPendingWiper(ctx, pending)
+
+	_, err := msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: owner, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+
+	_, found, err := ftf.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = ftf.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = ftf.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	for _, role := range []types.Role{types.ROLE_OWNER, types.ROLE_MASTER_MINTER, types.ROLE_BLACKLISTER, types.ROLE_WIPER} {
+		_, err = msgServer.CancelPendingRole(sdk.WrapSDKContext(ctx), &types.MsgCancelPendingRole{From: owner, Role: role})
+		require.NoError(t, err)
+	}
+
+	_, found, err = ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = ftf.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = ftf.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = ftf.GetPendingWiper(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -28,7 +28,19 @@ import (
 func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigureMinter) (*types.MsgConfigureMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	token := k.Keeper
+	scoped, found, err := k.ManagedDenom(ctx, msg.Allowance.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		token = scoped
+	}
+
+	mintingDenom, err := token.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if msg.Allowance.Denom != mintingDenom.Denom {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
@@ -38,7 +50,10 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		return nil, sdkerrors.Wrapf(types.ErrMint, "allowance amount is invalid")
 	}
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
+	minterController, found, err := token.GetMinterController(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
 	}
@@ -47,13 +62,20 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if paused.Paused {
+	if paused.IsPaused(types.PAUSE_SCOPE_MINT) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
-	if msg.Address != minterController.Minter {
+	controlled, err := token.IsControllerOfMinter(ctx, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !controlled {
 		return nil, sdkerrors.Wrapf(
 			types.ErrUnauthorized,
 			"minter address ≠ minter controller's minter address, (%s≠%s)",
@@ -61,12 +83,23 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		)
 	}
 
-	k.SetMinters(ctx, types.Minters{
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.ExceedsMaxMinterAllowance(msg.Allowance.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "allowance exceeds the max minter allowance of %s", params.MaxMinterAllowance)
+	}
+
+	err = token.SetMinters(ctx, types.Minters{
 		Address:   msg.Address,
 		Allowance: msg.Allowance,
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgConfigureMinterResponse{}, err
 }
@@ -28,7 +28,15 @@ import (
 func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.MsgConfigureMinterController) (*types.MsgConfigureMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	masterMinter, found, err := token.GetMasterMinter(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -42,7 +50,27 @@ func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.M
 		Controller: msg.Controller,
 	}
 
-	k.SetMinterController(ctx, controller)
+	if err := token.checkMaxControllersPerMinter(ctx, msg.Minter, msg.Controller); err != nil {
+		return nil, err
+	}
+
+	// the previous primary minter is no longer managed by the controller
+	previous, found, err := token.GetMinterController(ctx, msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+	if found && previous.Minter != msg.Minter {
+		if err := token.DeleteMinterControllerLink(ctx, previous.Controller, previous.Minter); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := token.SetMinterController(ctx, controller); err != nil {
+		return nil, err
+	}
+	if err := token.SetMinterControllerLink(ctx, controller); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgConfigureMinterControllerResponse{}, nil
 }
@@ -56,3 +56,78 @@ func TestConfigureMinterController_Success(t *testing.T) {
 	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address})
 	require.NoError(t, err)
 }
+
+func TestConfigureMinterController_ReplacesPrimaryLink(t *testing.T) {
+	masterMinter := sample.TestAccount()
+	controller := sample.AccAddress()
+	oldMinter, newMinter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+
+	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller, Minter: oldMinter})
+	require.NoError(t, err)
+	found, err := ftf.HasMinterControllerLink(ctx, controller, oldMinter)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller, Minter: newMinter})
+	require.NoError(t, err)
+	found, err = ftf.HasMinterControllerLink(ctx, controller, oldMinter)
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = ftf.HasMinterControllerLink(ctx, controller, newMinter)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestConfigureMinterController_MaxControllersPerMinter(t *testing.T) {
+	masterMinter := sample.TestAccount()
+	controller, otherController := sample.AccAddress(), sample.AccAddress()
+	minter := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+
+	params, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxControllersPerMinter = 1
+	ftf.SetParams(ctx, params)
+
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+
+	// reconfiguring the same controller does not count against the limit
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: otherController, Minter: minter})
+	require.ErrorIs(t, err, types.ErrMaxControllers)
+	require.ErrorContains(t, err, "cannot have more than 1 controllers")
+
+	params.MaxControllersPerMinter = 0
+	ftf.SetParams(ctx, params)
+
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: otherController, Minter: minter})
+	require.NoError(t, err)
+	controllers, err := ftf.GetControllersOfMinter(ctx, minter)
+	require.NoError(t, err)
+	require.Len(t, controllers, 2)
+}
+
+func TestConfigureMinterController_DefaultMaxControllersPerMinter(t *testing.T) {
+	masterMinter := sample.TestAccount()
+	controller, otherController := sample.AccAddress(), sample.AccAddress()
+	minter := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+
+	// without stored params a minter can only be managed by a single controller
+	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+
+	_, err = msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: otherController, Minter: minter})
+	require.ErrorIs(t, err, types.ErrMaxControllers)
+	require.ErrorContains(t, err, "cannot have more than 1 controllers")
+}
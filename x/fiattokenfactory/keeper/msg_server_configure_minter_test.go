@@ -101,6 +101,23 @@ func TestConfigureMinter_ControllerMinterMismatch(t *testing.T) {
 	require.ErrorContains(t, err, "minter address ≠ minter controller's minter address")
 }
 
+func TestConfigureMinter_LinkedMinterSuccess(t *testing.T) {
+	mintingDenom := "uusdc"
+	allowance := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: sample.AccAddress()})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+
+	_, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: allowance})
+	require.NoError(t, err)
+
+	_, found, err := ftf.GetMinters(ctx, minter.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
 func TestConfigureMinter_Paused(t *testing.T) {
 	mintingDenom := "uusdc"
 	allowance := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
@@ -108,7 +125,7 @@ func TestConfigureMinter_Paused(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
 	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.NewPaused())
 
 	_, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
@@ -141,10 +158,28 @@ func TestConfigureMinter_Success(t *testing.T) {
 	require.Equal(t, &types.MsgConfigureMinterResponse{}, res)
 }
 
+func TestConfigureMinter_ExceedsMaxMinterAllowance(t *testing.T) {
+	mintingDenom := "uusdc"
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	params := types.DefaultParams()
+	params.MaxMinterAllowance = math.NewInt(10)
+	ftf.SetParams(ctx, params)
+
+	_, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: sdk.NewCoin(mintingDenom, math.NewInt(11))})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "allowance exceeds the max minter allowance of 10")
+
+	_, err = msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: sdk.NewCoin(mintingDenom, math.NewInt(10))})
+	require.NoError(t, err)
+}
+
 func setupForConfigureMinterTest(mintingDenom string) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
 }
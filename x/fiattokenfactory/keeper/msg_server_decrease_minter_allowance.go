@@ -0,0 +1,102 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecreaseMinterAllowance lowers the allowance of a minter, flooring it at zero. Unlike
+// increases, decreases are allowed while minting is paused since they only reduce risk.
+func (k msgServer) DecreaseMinterAllowance(goCtx context.Context, msg *types.MsgDecreaseMinterAllowance) (*types.MsgDecreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token := k.Keeper
+	scoped, found, err := k.ManagedDenom(ctx, msg.Decrement.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		token = scoped
+	}
+
+	mintingDenom, err := token.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Decrement.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	if msg.Decrement.IsNil() || !msg.Decrement.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "allowance decrement is invalid")
+	}
+
+	_, found, err = token.GetMinterController(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+	}
+
+	controlled, err := token.IsControllerOfMinter(ctx, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !controlled {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	}
+
+	minter, found, err := token.GetMinters(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
+	}
+
+	before := minter.Allowance
+	if before.Denom != msg.Decrement.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minter allowance denom is incorrect")
+	}
+
+	if before.IsLT(msg.Decrement) {
+		minter.Allowance = sdk.NewCoin(before.Denom, math.ZeroInt())
+	} else {
+		minter.Allowance = before.Sub(msg.Decrement)
+	}
+
+	if err := token.SetMinters(ctx, minter); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.MinterAllowanceDecreased{
+		Minter:     msg.Address,
+		Controller: msg.From,
+		Before:     before,
+		After:      minter.Allowance,
+	})
+
+	return &types.MsgDecreaseMinterAllowanceResponse{Allowance: minter.Allowance}, err
+}
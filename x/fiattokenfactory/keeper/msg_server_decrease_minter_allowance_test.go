@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecreaseMinterAllowance_FromAddressIsNotController(t *testing.T) {
+	_, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+
+	_, err := msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgDecreaseMinterAllowance{From: sample.AccAddress(), Address: sample.AccAddress(), Decrement: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "minter controller not found")
+}
+
+func TestDecreaseMinterAllowance_ControllerMinterMismatch(t *testing.T) {
+	controller := sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: sample.AccAddress()})
+
+	_, err := msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgDecreaseMinterAllowance{From: controller, Address: sample.AccAddress(), Decrement: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestDecreaseMinterAllowance_Success(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+
+	res, err := msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgDecreaseMinterAllowance{From: controller, Address: minter, Decrement: sdk.NewCoin("uusdc", math.NewInt(4))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(6)), res.Allowance)
+}
+
+func TestDecreaseMinterAllowance_FloorsAtZero(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+
+	res, err := msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgDecreaseMinterAllowance{From: controller, Address: minter, Decrement: sdk.NewCoin("uusdc", math.NewInt(25))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.ZeroInt()), res.Allowance)
+
+	stored, found, err := ftf.GetMinters(ctx, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, stored.Allowance.IsZero())
+}
+
+func TestDecreaseMinterAllowance_WhilePaused(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+	ftf.SetPaused(ctx, types.NewPaused())
+
+	_, err := msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgDecreaseMinterAllowance{From: controller, Address: minter, Decrement: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MinterAllowanceDecreased", events[len(events)-1].Type)
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeze_BlacklisterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: sample.TestAccount().Address})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "blacklister is not set")
+}
+
+func TestFreeze_FromAddressIsNotBlacklister(t *testing.T) {
+	blacklister := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the blacklister")
+}
+
+func TestFreeze_AddressIsInvalid(t *testing.T) {
+	blacklister := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: "invalid address"})
+	require.ErrorIs(t, err, bech32.ErrInvalidCharacter(32))
+}
+
+func TestFreeze_AddressAlreadyFrozen(t *testing.T) {
+	blacklister := sample.TestAccount()
+	frozenUserBech32 := sample.TestAccount()
+	frozenUserBech32m := sample.TestAccountBech32m()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetFrozen(ctx, types.Frozen{AddressBz: frozenUserBech32.AddressBz})
+	ftf.SetFrozen(ctx, types.Frozen{AddressBz: frozenUserBech32m.AddressBz})
+
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: frozenUserBech32.Address})
+	require.ErrorIs(t, err, types.ErrUserFrozen)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: frozenUserBech32m.Address})
+	require.ErrorIs(t, err, types.ErrUserFrozen)
+}
+
+func TestFreeze_Success(t *testing.T) {
+	blacklister := sample.TestAccount()
+	newUserBech32 := sample.TestAccount()
+	newUserBech32m := sample.TestAccountBech32m()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	res, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: newUserBech32.Address})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgFreezeResponse{}, res)
+
+	res, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: newUserBech32m.Address})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgFreezeResponse{}, res)
+}
+
+func TestFreeze_RecordsAuditFields(t *testing.T) {
+	blacklister := sample.TestAccount()
+	user := sample.TestAccount()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(now)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), &types.MsgFreeze{From: blacklister.Address, Address: user.Address, Reason: "case 1234"})
+	require.NoError(t, err)
+
+	frozen, found, err := ftf.GetFrozen(ctx, user.AddressBz)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.Frozen{
+		AddressBz:   user.AddressBz,
+		Height:      42,
+		Time:        now,
+		Blacklister: blacklister.Address,
+		Reason:      "case 1234",
+	}, frozen)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncreaseMinterAllowance_IncorrectDenom(t *testing.T) {
+	_, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{Increment: sdk.NewCoin("ueurc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "minting denom is incorrect")
+}
+
+func TestIncreaseMinterAllowance_FromAddressIsNotController(t *testing.T) {
+	_, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: sample.AccAddress(), Address: sample.AccAddress(), Increment: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "minter controller not found")
+}
+
+func TestIncreaseMinterAllowance_ControllerMinterMismatch(t *testing.T) {
+	controller := sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: sample.AccAddress()})
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: controller, Address: sample.AccAddress(), Increment: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not a controller of this minter")
+}
+
+func TestIncreaseMinterAllowance_Paused(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+	ftf.SetPaused(ctx, types.NewPaused())
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: controller, Address: minter, Increment: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "minting is paused")
+}
+
+func TestIncreaseMinterAllowance_MinterNotConfigured(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: controller, Address: minter, Increment: sdk.NewCoin("uusdc", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestIncreaseMinterAllowance_ExceedsMaxMinterAllowance(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+	params := types.DefaultParams()
+	params.MaxMinterAllowance = math.NewInt(12)
+	ftf.SetParams(ctx, params)
+
+	_, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: controller, Address: minter, Increment: sdk.NewCoin("uusdc", math.NewInt(5))})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "allowance exceeds the max minter allowance of 12")
+
+	stored, found, err := ftf.GetMinters(ctx, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(10)), stored.Allowance)
+}
+
+func TestIncreaseMinterAllowance_Success(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewCoin("uusdc", math.NewInt(10))})
+
+	res, err := msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), &types.MsgIncreaseMinterAllowance{From: controller, Address: minter, Increment: sdk.NewCoin("uusdc", math.NewInt(5))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(15)), res.Allowance)
+
+	stored, found, err := ftf.GetMinters(ctx, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(15)), stored.Allowance)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MinterAllowanceIncreased", events[len(events)-1].Type)
+}
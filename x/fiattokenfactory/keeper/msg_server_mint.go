@@ -32,7 +32,19 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 }
 
 func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	minter, found := k.GetMinters(ctx, msg.From)
+	// operate on the fiat token being minted, if it is managed by this module
+	token, found, err := k.ManagedDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		k = *token
+	}
+
+	minter, found, err := k.GetMinters(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
 	}
@@ -42,7 +54,10 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found, err = k.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
 	}
@@ -52,12 +67,18 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found, err = k.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
+	mintingDenom, err := k.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if msg.Amount.Denom != mintingDenom.Denom {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
@@ -71,15 +92,35 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused, err := k.GetPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if paused.Paused {
+	if paused.IsPaused(types.PAUSE_SCOPE_MINT) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
+	if err := k.consumeMintRateLimit(ctx, msg.From, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	maxSupply, found, err := k.GetMaxSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		supply := k.bankKeeper.GetSupply(ctx, mintingDenom.Denom)
+		if supply.Amount.Add(msg.Amount.Amount).GT(maxSupply.Amount) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "minting %s would exceed the max supply of %s", msg.Amount, maxSupply.Amount)
+		}
+	}
+
 	minter.Allowance = minter.Allowance.Sub(msg.Amount)
 
-	k.SetMinters(ctx, minter)
+	if err := k.SetMinters(ctx, minter); err != nil {
+		return nil, err
+	}
 
 	amount := sdk.NewCoins(msg.Amount)
 
@@ -248,12 +248,27 @@ func TestMint_Paused(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.NewPaused())
 	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "minting is paused")
 }
 
+func TestMint_OtherScopesPaused(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
+		amount       = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+
+	ftf.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_BURN, types.PAUSE_SCOPE_TRANSFER, types.PAUSE_SCOPE_IBC))
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+}
+
 func TestMint_AmountEqualsAllowance(t *testing.T) {
 	var (
 		receiver     = sample.TestAccount()
@@ -284,11 +299,108 @@ func TestMint_Success(t *testing.T) {
 	require.Equal(t, &types.MsgMintResponse{}, res)
 }
 
+func TestMint_FiatToken(t *testing.T) {
+	var (
+		receiver   = sample.TestAccount()
+		usdcMinter = sample.TestAccount()
+		eurcMinter = sample.TestAccount()
+		allowance  = sdk.Coin{Denom: "ueurc", Amount: math.NewInt(10)}
+		amount     = sdk.Coin{Denom: "ueurc", Amount: math.NewInt(1)}
+	)
+	ftf, ctx, msgServer := setupForMintTest("uusdc", usdcMinter, sdk.Coin{Denom: "uusdc", Amount: math.NewInt(10)})
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	token, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	token.SetPaused(ctx, types.Paused{})
+	token.SetMinters(ctx, types.Minters{Address: eurcMinter.Address, Allowance: allowance})
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: usdcMinter.Address, Address: receiver.Address, Amount: amount})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: eurcMinter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgMintResponse{}, res)
+
+	minter, found, err := token.GetMinters(ctx, eurcMinter.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, allowance.Sub(amount), minter.Allowance)
+
+	token.SetPaused(ctx, types.NewPaused())
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: eurcMinter.Address, Address: receiver.Address, Amount: amount})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "minting is paused")
+}
+
 func setupForMintTest(mintingDenom string, minter sample.Account, allowance sdk.Coin) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{})
 	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: allowance})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
 }
+
+func TestMint_RateLimited(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(100)}
+		amount       = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(6)}
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+	ftf.SetMintRateLimit(ctx, types.MintRateLimit{Minter: minter.Address, Limit: math.NewInt(10), Window: 5, Unit: types.WINDOW_UNIT_BLOCKS})
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx.WithBlockHeight(14)), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.ErrorIs(t, err, types.ErrMintRateLimited)
+
+	// the failed mint must not consume allowance
+	stored, found, err := ftf.GetMinters(ctx, minter.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(94), stored.Allowance.Amount)
+
+	// the amount of block 10 leaves the window once five blocks have passed
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx.WithBlockHeight(15)), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+
+	window, found, err := ftf.GetMintWindow(ctx, minter.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.MintWindow{Minter: minter.Address, Buckets: []types.MintBucket{{Start: 15, Minted: math.NewInt(6)}}}, window)
+
+	// the limit holds across the boundary of any window, so it cannot be minted twice in adjacent blocks
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx.WithBlockHeight(19)), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin(mintingDenom, 4)})
+	require.NoError(t, err)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx.WithBlockHeight(20)), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin(mintingDenom, 10)})
+	require.ErrorIs(t, err, types.ErrMintRateLimited)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx.WithBlockHeight(20)), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+}
+
+func TestMint_MaxSupplyExceeded(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(100)}
+		amount       = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(6)}
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+	ftf.SetMaxSupply(ctx, types.MaxSupply{Amount: math.NewInt(12)})
+
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+
+	// minting up to the cap is allowed
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewCoin(mintingDenom, math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+}
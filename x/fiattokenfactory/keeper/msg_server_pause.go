@@ -28,7 +28,15 @@ import (
 func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pauser, found, err := token.GetPauser(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -37,13 +45,25 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
-	paused := types.Paused{
-		Paused: true,
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiry height must be after the current height %d", ctx.BlockHeight())
+	}
+
+	if msg.ExpiryTime != 0 && msg.ExpiryTime <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiry time must be after the current time %d", ctx.BlockTime().Unix())
+	}
+
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return nil, err
 	}
+	paused.Pause(msg.ExpiryHeight, msg.ExpiryTime, msg.Scopes...)
 
-	k.SetPaused(ctx, paused)
+	if err := token.SetPaused(ctx, paused); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgPauseResponse{}, err
 }
@@ -18,6 +18,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -52,7 +53,7 @@ func TestPause_AlreadyPausedSuccess(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.NewPaused())
 
 	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address})
 	require.NoError(t, err)
@@ -64,8 +65,103 @@ func TestPause_Success(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{})
 
 	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgPauseResponse{}, res)
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPaused(), paused)
+}
+
+func TestPause_Scopes(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{})
+
+	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_IBC}})
+	require.NoError(t, err)
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Ibc: true}, paused)
+
+	// pausing another scope leaves the already paused scopes untouched
+	_, err = msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_MINT}})
+	require.NoError(t, err)
+	paused, err = ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Mint: true, Ibc: true}, paused)
+}
+
+func TestPause_Expiry(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{})
+
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, ExpiryHeight: 10})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, ExpiryTime: 1000})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, ExpiryHeight: 20, ExpiryTime: 2000})
+	require.NoError(t, err)
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Len(t, paused.Expiries, len(types.PauseScopes))
+	for _, scope := range types.PauseScopes {
+		require.Equal(t, types.PauseExpiry{Scope: scope, Height: 20, Time: 2000}, paused.Expiries[scope-1])
+	}
+
+	// pausing a scope again without an expiry makes only its pause indefinite
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_MINT}})
+	require.NoError(t, err)
+	paused, err = ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.True(t, paused.IsPaused(types.PAUSE_SCOPE_MINT))
+	_, found := paused.Expiry(types.PAUSE_SCOPE_MINT)
+	require.False(t, found)
+	expiry, found := paused.Expiry(types.PAUSE_SCOPE_TRANSFER)
+	require.True(t, found)
+	require.Equal(t, int64(20), expiry.Height)
+}
+
+func TestPause_ExpiryPerScope(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{})
+
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_TRANSFER}})
+	require.NoError(t, err)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_MINT}, ExpiryHeight: 100})
+	require.NoError(t, err)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Scopes: []types.PauseScope{types.PAUSE_SCOPE_IBC}, ExpiryHeight: 200})
+	require.NoError(t, err)
+
+	// only the mint scope is unpaused once its expiry is reached
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, ftf.EndBlocker(ctx))
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused.IsPaused(types.PAUSE_SCOPE_MINT))
+	require.True(t, paused.IsPaused(types.PAUSE_SCOPE_TRANSFER))
+	require.True(t, paused.IsPaused(types.PAUSE_SCOPE_IBC))
+	require.Equal(t, []types.PauseExpiry{{Scope: types.PAUSE_SCOPE_IBC, Height: 200}}, paused.Expiries)
+
+	// the indefinite transfer pause outlives every expiry
+	ctx = ctx.WithBlockHeight(200)
+	require.NoError(t, ftf.EndBlocker(ctx))
+	paused, err = ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPaused(types.PAUSE_SCOPE_TRANSFER), paused)
 }
@@ -0,0 +1,71 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterFiatToken(goCtx context.Context, msg *types.MsgRegisterFiatToken) (*types.MsgRegisterFiatTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	_, found, err := k.ManagedDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrFiatTokenExists, "denom %s is already managed by this module", msg.Denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, msg.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotRegistered, "denom %s is not registered in bank module denom_metadata", msg.Denom)
+	}
+
+	// the module only takes over denoms it issues all of, never existing tokens
+	if supply := k.bankKeeper.GetSupply(ctx, msg.Denom); !supply.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrDenomHasSupply, "denom %s has an existing supply of %s", msg.Denom, supply)
+	}
+
+	if err := k.SetFiatToken(ctx, types.FiatToken{Denom: msg.Denom}); err != nil {
+		return nil, err
+	}
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := token.SetPaused(ctx, types.Paused{}); err != nil {
+		return nil, err
+	}
+	if err := token.SetOwner(ctx, types.Owner{Address: msg.Owner}); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgRegisterFiatTokenResponse{}, err
+}
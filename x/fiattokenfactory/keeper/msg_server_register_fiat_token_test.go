@@ -0,0 +1,137 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterFiatToken_InvalidAuthority(t *testing.T) {
+	owner := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+
+	// the owner of the primary token cannot register a fiat token
+	_, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: owner.Address, Denom: "ueurc", Owner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "invalid authority")
+}
+
+func TestRegisterFiatToken_MintingDenom(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: testkeeper.Authority, Denom: "uusdc", Owner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrFiatTokenExists)
+}
+
+func TestRegisterFiatToken_AlreadyRegistered(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+
+	_, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: testkeeper.Authority, Denom: "ueurc", Owner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrFiatTokenExists)
+}
+
+func TestRegisterFiatToken_DenomMetadataNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: testkeeper.Authority, Denom: "ujpyc", Owner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrDenomNotRegistered)
+}
+
+func TestRegisterFiatToken_ExistingSupply(t *testing.T) {
+	_, ctx, key := testkeeper.FiatTokenfactoryKeeperWithStoreKey()
+	ftf := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		testkeeper.Authority,
+		false,
+		testkeeper.MockBankKeeper{
+			Balances: map[string]sdk.Coins{sample.AccAddress(): sdk.NewCoins(sdk.NewInt64Coin("ueurc", 1))},
+		},
+	)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: testkeeper.Authority, Denom: "ueurc", Owner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrDenomHasSupply)
+
+	_, found, err := ftf.GetFiatToken(ctx, "ueurc")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRegisterFiatToken_Success(t *testing.T) {
+	owner := sample.TestAccount()
+	eurcOwner := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	res, err := msgServer.RegisterFiatToken(sdk.WrapSDKContext(ctx), &types.MsgRegisterFiatToken{Authority: testkeeper.Authority, Denom: "ueurc", Owner: eurcOwner.Address})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRegisterFiatTokenResponse{}, res)
+
+	_, found, err := ftf.GetFiatToken(ctx, "ueurc")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	token, err := ftf.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+	paused, err := token.GetPaused(ctx)
+	require.NoError(t, err)
+	require.False(t, paused.IsPaused(types.PAUSE_SCOPE_UNSPECIFIED))
+	eurcOwnerSet, found, err := token.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, eurcOwner.Address, eurcOwnerSet.Address)
+
+	// the minting denom's owner is unaffected and has no authority over the new token
+	usdcOwner, found, err := ftf.GetOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, owner.Address, usdcOwner.Address)
+
+	_, err = msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: sample.AccAddress(), Denom: "ueurc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: eurcOwner.Address, Address: sample.AccAddress(), Denom: "ueurc"})
+	require.NoError(t, err)
+	_, found, err = ftf.GetPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
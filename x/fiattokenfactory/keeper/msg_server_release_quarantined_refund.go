@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ReleaseQuarantinedRefund(goCtx context.Context, msg *types.MsgReleaseQuarantinedRefund) (*types.MsgReleaseQuarantinedRefundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.From != msg.Address {
+		blacklister, found, err := token.GetBlacklister(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !found || blacklister.Address != msg.From {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister or the owner of the refund")
+		}
+	}
+
+	refund, found, err := token.GetQuarantinedRefund(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address has no quarantined refund")
+	}
+
+	// the refund stays quarantined for as long as it could not have been returned in the first place
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if paused.IsPaused(types.PAUSE_SCOPE_TRANSFER) {
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "cannot release quarantined refunds while transfers are paused")
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found, err = token.GetBlacklisted(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "the specified address is blacklisted")
+	}
+
+	mintingDenom, err := token.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoin(mintingDenom.Denom, refund.Amount)
+	if err := token.RemoveQuarantinedRefund(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.QuarantinedRefundReleased{
+		Address: msg.Address,
+		Amount:  amount,
+		From:    msg.From,
+	})
+
+	return &types.MsgReleaseQuarantinedRefundResponse{Amount: amount}, err
+}
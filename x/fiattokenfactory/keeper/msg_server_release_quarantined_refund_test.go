@@ -0,0 +1,118 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseQuarantinedRefund_Unauthorized(t *testing.T) {
+	holder := sample.TestAccount()
+	ftf, ctx, _, msgServer := setupForReleaseTest(holder)
+
+	_, err := msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: sample.AccAddress(), Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the blacklister or the owner of the refund")
+
+	_, found, err := ftf.GetQuarantinedRefund(ctx, holder.Address)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestReleaseQuarantinedRefund_NotFound(t *testing.T) {
+	holder := sample.TestAccount()
+	_, ctx, _, msgServer := setupForReleaseTest(holder)
+
+	other := sample.AccAddress()
+	_, err := msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: other, Address: other})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestReleaseQuarantinedRefund_Paused(t *testing.T) {
+	holder := sample.TestAccount()
+	ftf, ctx, _, msgServer := setupForReleaseTest(holder)
+	ftf.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_TRANSFER))
+
+	_, err := msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: holder.Address, Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// a pause of other scopes does not hold the refund back
+	ftf.SetPaused(ctx, types.NewPaused(types.PAUSE_SCOPE_IBC))
+	_, err = msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: holder.Address, Address: holder.Address})
+	require.NoError(t, err)
+}
+
+func TestReleaseQuarantinedRefund_Blacklisted(t *testing.T) {
+	holder := sample.TestAccount()
+	ftf, ctx, _, msgServer := setupForReleaseTest(holder)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: holder.AddressBz})
+
+	_, err := msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: holder.Address, Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "the specified address is blacklisted")
+}
+
+func TestReleaseQuarantinedRefund_Success(t *testing.T) {
+	holder := sample.TestAccount()
+	ftf, ctx, bank, msgServer := setupForReleaseTest(holder)
+	blacklister, _, err := ftf.GetBlacklister(ctx)
+	require.NoError(t, err)
+
+	res, err := msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: blacklister.Address, Address: holder.Address})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(30)), res.Amount)
+	require.Equal(t, math.NewInt(30), bank.SpendableCoins(ctx, holder.AddressBz).AmountOf("uusdc"))
+
+	_, found, err := ftf.GetQuarantinedRefund(ctx, holder.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.QuarantinedRefundReleased", events[len(events)-1].Type)
+
+	// the refund can only be released once
+	_, err = msgServer.ReleaseQuarantinedRefund(ctx, &types.MsgReleaseQuarantinedRefund{From: holder.Address, Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+// setupForReleaseTest quarantines two refunds of 10uusdc and 20uusdc owed to holder.
+func setupForReleaseTest(holder sample.Account) (*keeper.Keeper, sdk.Context, testkeeper.MockBankKeeper, types.MsgServer) {
+	ftf, ctx, bank := testkeeper.FiatTokenfactoryKeeperWithBank()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.Paused{})
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: sample.AccAddress()})
+
+	quarantineRefund(ftf, ctx, bank, holder.Address, math.NewInt(10))
+	quarantineRefund(ftf, ctx, bank, holder.Address, math.NewInt(20))
+	return ftf, ctx, bank, keeper.NewMsgServerImpl(ftf)
+}
+
+// quarantineRefund holds amount in the module account as a refund owed to address, as the IBC middleware does.
+func quarantineRefund(ftf *keeper.Keeper, ctx sdk.Context, bank testkeeper.MockBankKeeper, address string, amount math.Int) {
+	if err := bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uusdc", amount))); err != nil {
+		panic(err)
+	}
+	ftf.QuarantineRefund(ctx, address, amount)
+}
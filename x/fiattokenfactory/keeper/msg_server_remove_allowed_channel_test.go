@@ -0,0 +1,96 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveAllowedChannel_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedChannel{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestRemoveAllowedChannel_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedChannel{From: sample.AccAddress(), PortId: "transfer", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestRemoveAllowedChannel_NotAllowed(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := msgServer.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+}
+
+func TestRemoveAllowedChannel_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+	ftf.SetAllowedChannel(ctx, types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"})
+
+	res, err := msgServer.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRemoveAllowedChannelResponse{}, res)
+
+	_, found, err := ftf.GetAllowedChannel(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRemoveAllowedChannel_LastChannelFailsClosed(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := msgServer.AddAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgAddAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	_, err = msgServer.RemoveAllowedChannel(sdk.WrapSDKContext(ctx), &types.MsgRemoveAllowedChannel{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	// removing the last channel keeps the allowlist enabled, so every channel is blocked
+	allowlist, err := ftf.GetChannelAllowlist(ctx)
+	require.NoError(t, err)
+	require.True(t, allowlist.Enabled)
+	allowed, err := ftf.IsChannelAllowed(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = ftf.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
@@ -28,7 +28,15 @@ import (
 func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	minterController, found, err := token.GetMinterController(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
 	}
@@ -37,7 +45,11 @@ func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinte
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
-	if msg.Address != minterController.Minter {
+	controlled, err := token.IsControllerOfMinter(ctx, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !controlled {
 		return nil, sdkerrors.Wrapf(
 			types.ErrUnauthorized,
 			"minter address ≠ minter controller's minter address, (%s≠%s)",
@@ -45,14 +57,19 @@ func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinte
 		)
 	}
 
-	minter, found := k.GetMinters(ctx, msg.Address)
+	minter, found, err := token.GetMinters(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
 	}
 
-	k.RemoveMinters(ctx, minter.Address)
+	if err := token.RemoveMinters(ctx, minter.Address); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgRemoveMinterResponse{}, err
 }
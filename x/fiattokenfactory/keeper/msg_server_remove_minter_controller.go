@@ -28,7 +28,15 @@ import (
 func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgRemoveMinterController) (*types.MsgRemoveMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	masterMinter, found, err := token.GetMasterMinter(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -37,12 +45,28 @@ func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgR
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
-	_, found = k.GetMinterController(ctx, msg.Controller)
+	_, found, err = token.GetMinterController(ctx, msg.Controller)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
 	}
 
-	k.DeleteMinterController(ctx, msg.Controller)
+	links, err := token.GetMintersOfController(ctx, msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range links {
+		if err := token.DeleteMinterControllerLink(ctx, link.Controller, link.Minter); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := token.DeleteMinterController(ctx, msg.Controller); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRemoveMinterControllerResponse{}, nil
 }
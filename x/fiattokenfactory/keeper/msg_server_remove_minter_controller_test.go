@@ -73,3 +73,24 @@ func TestRemoveMinterController_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgRemoveMinterControllerResponse{}, res)
 }
+
+func TestRemoveMinterController_RemovesLinks(t *testing.T) {
+	masterMinter := sample.TestAccount()
+	controller := sample.AccAddress()
+	primary, linked := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: primary})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller, Minter: primary})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller, Minter: linked})
+
+	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: controller})
+	require.NoError(t, err)
+	links, err := ftf.GetMintersOfController(ctx, controller)
+	require.NoError(t, err)
+	require.Empty(t, links)
+	found, err := ftf.IsControllerOfMinter(ctx, controller, linked)
+	require.NoError(t, err)
+	require.False(t, found)
+}
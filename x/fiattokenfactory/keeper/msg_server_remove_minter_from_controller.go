@@ -0,0 +1,72 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveMinterFromController(goCtx context.Context, msg *types.MsgRemoveMinterFromController) (*types.MsgRemoveMinterFromControllerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	masterMinter, found, err := token.GetMasterMinter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
+	}
+
+	if msg.From != masterMinter.Address {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
+	}
+
+	linked, err := token.HasMinterControllerLink(ctx, msg.Controller, msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+	if !linked {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter (%s) is not managed by controller (%s)", msg.Minter, msg.Controller)
+	}
+
+	// the primary minter is replaced with configure-minter-controller or removed with remove-minter-controller
+	minterController, found, err := token.GetMinterController(ctx, msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+	if found && minterController.Minter == msg.Minter {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "cannot remove the primary minter (%s) of controller (%s)", msg.Minter, msg.Controller)
+	}
+
+	if err := token.DeleteMinterControllerLink(ctx, msg.Controller, msg.Minter); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgRemoveMinterFromControllerResponse{}, err
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveMinterFromController_MasterMinterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.RemoveMinterFromController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterFromController{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "master minter is not set")
+}
+
+func TestRemoveMinterFromController_FromAddressIsNotMasterMinter(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: sample.AccAddress()})
+
+	_, err := msgServer.RemoveMinterFromController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterFromController{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the master minter")
+}
+
+func TestRemoveMinterFromController_NotLinked(t *testing.T) {
+	masterMinter := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+
+	_, err := msgServer.RemoveMinterFromController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterFromController{From: masterMinter, Controller: sample.AccAddress(), Minter: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestRemoveMinterFromController_PrimaryMinter(t *testing.T) {
+	masterMinter, controller, minter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller, Minter: minter})
+
+	_, err := msgServer.RemoveMinterFromController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterFromController{From: masterMinter, Controller: controller, Minter: minter})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "cannot remove the primary minter")
+}
+
+func TestRemoveMinterFromController_Success(t *testing.T) {
+	masterMinter, controller := sample.AccAddress(), sample.AccAddress()
+	primary, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: primary})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Controller: controller, Minter: minter})
+
+	res, err := msgServer.RemoveMinterFromController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterFromController{From: masterMinter, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRemoveMinterFromControllerResponse{}, res)
+	found, err := ftf.IsControllerOfMinter(ctx, controller, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -68,6 +68,25 @@ func TestRemoveMinter_MinterNotConfigured(t *testing.T) {
 	require.ErrorContains(t, err, "a minter with a given address doesn't exist")
 }
 
+func TestRemoveMinter_LinkedMinterSuccess(t *testing.T) {
+	mintingDenom := "uusdc"
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMinterController(ctx, types.MinterController{Minter: sample.AccAddress(), Controller: controller.Address})
+	ftf.SetMinterControllerLink(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+
+	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: minter.Address})
+	require.NoError(t, err)
+
+	_, found, err := ftf.GetMinters(ctx, minter.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
 func TestRemoveMinter_Success(t *testing.T) {
 	mintingDenom := "uusdc"
 	controller := sample.TestAccount()
@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetChannelAllowlist_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.SetChannelAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetChannelAllowlist{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestSetChannelAllowlist_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.SetChannelAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetChannelAllowlist{From: sample.AccAddress(), Enabled: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+	allowlist, err := ftf.GetChannelAllowlist(ctx)
+	require.NoError(t, err)
+	require.False(t, allowlist.Enabled)
+}
+
+func TestSetChannelAllowlist_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+	ftf.SetAllowedChannel(ctx, types.AllowedChannel{PortId: "transfer", ChannelId: "channel-0"})
+
+	// an enabled allowlist blocks every channel that is not allowed
+	res, err := msgServer.SetChannelAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetChannelAllowlist{From: owner, Enabled: true})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSetChannelAllowlistResponse{}, res)
+	allowed, err := ftf.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// disabling the allowlist keeps the allowed channels for when it is enabled again
+	_, err = msgServer.SetChannelAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetChannelAllowlist{From: owner, Enabled: false})
+	require.NoError(t, err)
+	allowed, err = ftf.IsChannelAllowed(ctx, "transfer", "channel-1")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowedChannels, err := ftf.GetAllAllowedChannels(ctx)
+	require.NoError(t, err)
+	require.Len(t, allowedChannels, 1)
+}
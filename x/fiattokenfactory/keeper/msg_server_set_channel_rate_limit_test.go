@@ -0,0 +1,91 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetChannelRateLimit_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetChannelRateLimit{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestSetChannelRateLimit_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetChannelRateLimit{From: sample.AccAddress(), PortId: "transfer", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestSetChannelRateLimit_SetAndRemove(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	msg := types.MsgSetChannelRateLimit{
+		From:       owner,
+		PortId:     "transfer",
+		ChannelId:  "channel-0",
+		MaxInflow:  math.NewInt(10),
+		MaxOutflow: math.NewInt(20),
+		Window:     5,
+		Unit:       types.WINDOW_UNIT_BLOCKS,
+	}
+	_, err := msgServer.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	rateLimit, found, err := ftf.GetChannelRateLimit(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, channelRateLimit(10, 20), rateLimit)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MsgSetChannelRateLimit", events[len(events)-1].Type)
+
+	// changing the unit resets the flow of the channel
+	ftf.SetChannelFlow(ctx, types.ChannelFlow{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Start: 1, Inflow: math.NewInt(3), Outflow: math.ZeroInt()}}})
+	msg.Unit = types.WINDOW_UNIT_SECONDS
+	_, err = msgServer.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	_, found, err = ftf.GetChannelFlow(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = msgServer.SetChannelRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetChannelRateLimit{From: owner, PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	_, found, err = ftf.GetChannelRateLimit(ctx, "transfer", "channel-0")
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaxSupply_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), &types.MsgSetMaxSupply{})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestSetMaxSupply_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+
+	_, err := msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), &types.MsgSetMaxSupply{From: sample.AccAddress(), MaxSupply: math.NewInt(1)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestSetMaxSupply_SetAndRemove(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), &types.MsgSetMaxSupply{From: owner, MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+
+	maxSupply, found, err := ftf.GetMaxSupply(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), maxSupply.Amount)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MsgSetMaxSupply", events[len(events)-1].Type)
+
+	_, err = msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), &types.MsgSetMaxSupply{From: owner, MaxSupply: math.ZeroInt()})
+	require.NoError(t, err)
+
+	_, found, err = ftf.GetMaxSupply(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
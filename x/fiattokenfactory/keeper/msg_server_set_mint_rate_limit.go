@@ -0,0 +1,82 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetMintRateLimit(goCtx context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found, err := token.GetMinterController(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+	}
+
+	controlled, err := token.IsControllerOfMinter(ctx, msg.From, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !controlled {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
+	}
+
+	if msg.Window == 0 {
+		if err := token.DeleteMintRateLimit(ctx, msg.Address); err != nil {
+			return nil, err
+		}
+	} else {
+		// the buckets of the window start at positions measured in the unit of the rate limit
+		rateLimit, found, err := token.GetMintRateLimit(ctx, msg.Address)
+		if err != nil {
+			return nil, err
+		}
+		if found && rateLimit.Unit != msg.Unit {
+			if err := token.DeleteMintWindow(ctx, msg.Address); err != nil {
+				return nil, err
+			}
+		}
+
+		err = token.SetMintRateLimit(ctx, types.MintRateLimit{
+			Minter: msg.Address,
+			Limit:  msg.Limit,
+			Window: msg.Window,
+			Unit:   msg.Unit,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(msg)
+
+	return &types.MsgSetMintRateLimitResponse{}, err
+}
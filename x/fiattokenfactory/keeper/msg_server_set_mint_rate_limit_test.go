@@ -0,0 +1,96 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMintRateLimit_FromAddressIsNotController(t *testing.T) {
+	_, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+
+	_, err := msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{From: sample.AccAddress(), Address: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "minter controller not found")
+}
+
+func TestSetMintRateLimit_ControllerMinterMismatch(t *testing.T) {
+	controller := sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: sample.AccAddress()})
+
+	_, err := msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{From: controller, Address: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not a controller of this minter")
+}
+
+func TestSetMintRateLimit_UnknownDenom(t *testing.T) {
+	_, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+
+	_, err := msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{From: sample.AccAddress(), Address: sample.AccAddress(), Denom: "ujpyc"})
+	require.ErrorIs(t, err, types.ErrFiatTokenNotFound)
+}
+
+func TestSetMintRateLimit_SetAndRemove(t *testing.T) {
+	controller, minter := sample.AccAddress(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForConfigureMinterTest("uusdc")
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller, Minter: minter})
+
+	_, err := msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{
+		From:    controller,
+		Address: minter,
+		Limit:   math.NewInt(100),
+		Window:  10,
+		Unit:    types.WINDOW_UNIT_SECONDS,
+	})
+	require.NoError(t, err)
+
+	rateLimit, found, err := ftf.GetMintRateLimit(ctx, minter)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.MintRateLimit{Minter: minter, Limit: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_SECONDS}, rateLimit)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.MsgSetMintRateLimit", events[len(events)-1].Type)
+
+	// changing the unit resets the window of the minter
+	ftf.SetMintWindow(ctx, types.MintWindow{Minter: minter, Buckets: []types.MintBucket{{Start: 1_000, Minted: math.NewInt(3)}}})
+	_, err = msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{
+		From:    controller,
+		Address: minter,
+		Limit:   math.NewInt(100),
+		Window:  10,
+		Unit:    types.WINDOW_UNIT_BLOCKS,
+	})
+	require.NoError(t, err)
+	_, found, err = ftf.GetMintWindow(ctx, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = msgServer.SetMintRateLimit(sdk.WrapSDKContext(ctx), &types.MsgSetMintRateLimit{From: controller, Address: minter})
+	require.NoError(t, err)
+
+	_, found, err = ftf.GetMintRateLimit(ctx, minter)
+	require.NoError(t, err)
+	require.False(t, found)
+}
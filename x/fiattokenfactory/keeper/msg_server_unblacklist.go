@@ -28,7 +28,15 @@ import (
 func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist) (*types.MsgUnblacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	blacklister, found, err := token.GetBlacklister(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -42,12 +50,17 @@ func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist)
 		return nil, err
 	}
 
-	blacklisted, found := k.GetBlacklisted(ctx, addressBz)
+	blacklisted, found, err := token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
 	}
 
-	k.RemoveBlacklisted(ctx, blacklisted.AddressBz)
+	if err := token.RemoveBlacklisted(ctx, blacklisted.AddressBz); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(msg)
 
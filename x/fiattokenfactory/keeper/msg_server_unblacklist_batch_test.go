@@ -0,0 +1,89 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnblacklistBatch_BlacklisterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.UnblacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgUnblacklistBatch{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "blacklister is not set")
+}
+
+func TestUnblacklistBatch_FromAddressIsNotBlacklister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: sample.AccAddress()})
+
+	_, err := msgServer.UnblacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgUnblacklistBatch{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the blacklister")
+}
+
+func TestUnblacklistBatch_DuplicateAddress(t *testing.T) {
+	blacklister := sample.AccAddress()
+	blacklisted := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+
+	// the same account under another prefix is still a duplicate
+	prefixed, err := bech32.ConvertAndEncode("noble", blacklisted.AddressBz)
+	require.NoError(t, err)
+
+	_, err = msgServer.UnblacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgUnblacklistBatch{From: blacklister, Addresses: []string{blacklisted.Address, prefixed}})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	require.ErrorContains(t, err, "duplicate address "+prefixed)
+}
+
+func TestUnblacklistBatch_Idempotent(t *testing.T) {
+	blacklister := sample.AccAddress()
+	blacklisted := sample.TestAccount()
+	blacklistedBech32m := sample.TestAccountBech32m()
+	notBlacklisted := sample.TestAccount()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklisted.AddressBz})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedBech32m.AddressBz})
+
+	res, err := msgServer.UnblacklistBatch(sdk.WrapSDKContext(ctx), &types.MsgUnblacklistBatch{
+		From:      blacklister,
+		Addresses: []string{blacklisted.Address, notBlacklisted.Address, blacklistedBech32m.Address},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{blacklisted.Address, blacklistedBech32m.Address}, res.Unblacklisted)
+	require.Equal(t, []string{notBlacklisted.Address}, res.NotBlacklisted)
+	list, err := ftf.GetAllBlacklisted(ctx)
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
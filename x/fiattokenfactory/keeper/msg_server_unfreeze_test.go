@@ -0,0 +1,96 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnfreeze_BlacklisterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "blacklister is not set")
+}
+
+func TestUnfreeze_FromAddressIsNotBlacklister(t *testing.T) {
+	blacklister := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestUnfreeze_AddressIsInvalid(t *testing.T) {
+	blacklister := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: blacklister.Address, Address: "invalid address"})
+	require.ErrorIs(t, err, bech32.ErrInvalidCharacter(32))
+}
+
+func TestUnfreeze_AddressIsNotFrozen(t *testing.T) {
+	blacklister := sample.TestAccount()
+	unfrozenBech32User := sample.TestAccount()
+	unfrozenBech32mUser := sample.TestAccountBech32m()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+
+	_, err := msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: blacklister.Address, Address: unfrozenBech32User.Address})
+	require.ErrorIs(t, types.ErrUserNotFound, err)
+	require.ErrorContains(t, err, "the specified address is not frozen")
+
+	_, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: blacklister.Address, Address: unfrozenBech32mUser.Address})
+	require.ErrorIs(t, types.ErrUserNotFound, err)
+	require.ErrorContains(t, err, "the specified address is not frozen")
+}
+
+func TestUnfreeze_Success(t *testing.T) {
+	blacklister := sample.TestAccount()
+	frozenBech32User := sample.TestAccount()
+	frozenBech32mUser := sample.TestAccountBech32m()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetFrozen(ctx, types.Frozen{AddressBz: frozenBech32User.AddressBz})
+	ftf.SetFrozen(ctx, types.Frozen{AddressBz: frozenBech32mUser.AddressBz})
+
+	res, err := msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: blacklister.Address, Address: frozenBech32User.Address})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUnfreezeResponse{}, res)
+
+	res, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), &types.MsgUnfreeze{From: blacklister.Address, Address: frozenBech32mUser.Address})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUnfreezeResponse{}, res)
+}
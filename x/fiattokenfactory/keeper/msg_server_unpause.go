@@ -28,7 +28,15 @@ import (
 func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pauser, found, err := token.GetPauser(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -37,13 +45,17 @@ func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
-	paused := types.Paused{
-		Paused: false,
+	paused, err := token.GetPaused(ctx)
+	if err != nil {
+		return nil, err
 	}
+	paused.Set(false, msg.Scopes...)
 
-	k.SetPaused(ctx, paused)
+	if err := token.SetPaused(ctx, paused); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(msg)
 
 	return &types.MsgUnpauseResponse{}, err
 }
@@ -52,7 +52,7 @@ func TestUnpause_AlreadyUnpausedSuccess(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{})
 
 	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address})
 	require.NoError(t, err)
@@ -64,9 +64,26 @@ func TestUnpause_Success(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.NewPaused())
 
 	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUnpauseResponse{}, res)
 }
+
+func TestUnpause_Scopes(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPaused(ctx, types.NewPaused())
+
+	_, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{
+		From:   pauser.Address,
+		Scopes: []types.PauseScope{types.PAUSE_SCOPE_TRANSFER, types.PAUSE_SCOPE_BURN},
+	})
+	require.NoError(t, err)
+	paused, err := ftf.GetPaused(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Mint: true, Ibc: true}, paused)
+}
@@ -67,4 +67,25 @@ func TestUpdateBlacklister_Success(t *testing.T) {
 	res, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: newBlacklister.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateBlacklisterResponse{}, res)
+
+	pending, found, err := ftf.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: newBlacklister.Address, ActivationHeight: ctx.BlockHeight()}, pending)
+}
+
+func TestUpdateBlacklister_Delay(t *testing.T) {
+	owner := sample.TestAccount()
+	newBlacklister := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+
+	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: newBlacklister.Address, Delay: 100})
+	require.NoError(t, err)
+
+	pending, found, err := ftf.GetPendingBlacklister(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+100, pending.ActivationHeight)
 }
@@ -28,7 +28,15 @@ import (
 func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdateMasterMinter) (*types.MsgUpdateMasterMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, found, err := token.GetOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
@@ -38,16 +46,19 @@ func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdat
 	}
 
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err = token.ValidatePrivileges(ctx, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	masterMinter := types.MasterMinter{
-		Address: msg.Address,
+	pendingMasterMinter := types.PendingRole{
+		Address:          msg.Address,
+		ActivationHeight: ctx.BlockHeight() + int64(msg.Delay),
 	}
 
-	k.SetMasterMinter(ctx, masterMinter)
+	if err := token.SetPendingMasterMinter(ctx, pendingMasterMinter); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(msg)
 
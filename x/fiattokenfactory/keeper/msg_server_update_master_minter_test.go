@@ -67,4 +67,25 @@ func TestUpdateMasterMinter_Success(t *testing.T) {
 	res, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: newMasterMinter.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateMasterMinterResponse{}, res)
+
+	pending, found, err := ftf.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: newMasterMinter.Address, ActivationHeight: ctx.BlockHeight()}, pending)
+}
+
+func TestUpdateMasterMinter_Delay(t *testing.T) {
+	owner := sample.TestAccount()
+	newMasterMinter := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+
+	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: newMasterMinter.Address, Delay: 100})
+	require.NoError(t, err)
+
+	pending, found, err := ftf.GetPendingMasterMinter(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+100, pending.ActivationHeight)
 }
@@ -69,4 +69,25 @@ func TestUpdateOwner_Success(t *testing.T) {
 	res, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateOwnerResponse{}, res)
+
+	pending, found, err := ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: newOwner.Address, ActivationHeight: ctx.BlockHeight()}, pending)
+}
+
+func TestUpdateOwner_Delay(t *testing.T) {
+	owner := sample.TestAccount()
+	newOwner := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+
+	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address, Delay: 100})
+	require.NoError(t, err)
+
+	pending, found, err := ftf.GetPendingOwner(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+100, pending.ActivationHeight)
 }
@@ -0,0 +1,92 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams_InvalidAuthority(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: sample.AccAddress(), Params: types.DefaultParams()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "invalid authority")
+}
+
+func TestUpdateParams_InvalidParams(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: testkeeper.Authority, Params: types.Params{MaxMinterAllowance: math.ZeroInt()}})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	params, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+}
+
+func TestUpdateParams_Success(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	params := types.Params{
+		MaxBatchSize:            10,
+		MaxMinterAllowance:      math.NewInt(1_000_000),
+		RequireChannelAllowlist: true,
+	}
+	res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: testkeeper.Authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUpdateParamsResponse{}, res)
+	gotParams, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+}
+
+func TestUpdateParams_OverrideDisabled(t *testing.T) {
+	_, ctx, key := testkeeper.FiatTokenfactoryKeeperWithStoreKey()
+	ftf := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		log.NewNopLogger(),
+		runtime.NewKVStoreService(key),
+		testkeeper.Authority,
+		true,
+		testkeeper.MockBankKeeper{},
+	)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	// disabling the override of the privileged roles leaves the params to the authority
+	params := types.DefaultParams()
+	params.MaxBatchSize = 10
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: testkeeper.Authority, Params: params})
+	require.NoError(t, err)
+	gotParams, err := ftf.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+}
@@ -67,4 +67,25 @@ func TestUpdatePauser_Success(t *testing.T) {
 	res, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: newPauser.Address})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdatePauserResponse{}, res)
+
+	pending, found, err := ftf.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: newPauser.Address, ActivationHeight: ctx.BlockHeight()}, pending)
+}
+
+func TestUpdatePauser_Delay(t *testing.T) {
+	owner := sample.TestAccount()
+	newPauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+
+	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: newPauser.Address, Delay: 100})
+	require.NoError(t, err)
+
+	pending, found, err := ftf.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+100, pending.ActivationHeight)
 }
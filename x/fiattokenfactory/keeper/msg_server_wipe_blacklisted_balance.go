@@ -0,0 +1,118 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) WipeBlacklistedBalance(goCtx context.Context, msg *types.MsgWipeBlacklistedBalance) (*types.MsgWipeBlacklistedBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := k.ForDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	wiper, found, err := token.GetWiper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "wiper is not set")
+	}
+
+	if wiper.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the wiper")
+	}
+
+	_, addressBz, err := DecodeNoLimitToBase256(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found, err = token.GetBlacklisted(ctx, addressBz)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
+	}
+
+	account := sdk.AccAddress(addressBz)
+	mintingDenom, err := token.GetMintingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := mintingDenom.Denom
+	balance := sdk.NewCoin(denom, k.bankKeeper.SpendableCoins(ctx, account).AmountOf(denom))
+
+	// refunds quarantined for the address are already held by the module account
+	amount := balance
+	refund, found, err := token.GetQuarantinedRefund(ctx, account.String())
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		amount = amount.AddAmount(refund.Amount)
+		if err := token.RemoveQuarantinedRefund(ctx, account.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	if !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCoins, "the specified address holds no %s", denom)
+	}
+
+	if balance.IsPositive() {
+		// only this transfer into the module account bypasses the send restriction
+		wipeCtx := ctx.WithValue(types.WipeKey, account)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(wipeCtx, account, types.ModuleName, sdk.NewCoins(balance)); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrWipe, err.Error())
+		}
+	}
+
+	if msg.Recipient == "" {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+		}
+	} else {
+		recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.BlacklistedBalanceWiped{
+		Address:   msg.Address,
+		Wiper:     msg.From,
+		Amount:    amount,
+		Recipient: msg.Recipient,
+	})
+
+	return &types.MsgWipeBlacklistedBalanceResponse{Amount: amount}, err
+}
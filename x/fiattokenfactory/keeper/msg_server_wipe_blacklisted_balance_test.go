@@ -0,0 +1,144 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWipeBlacklistedBalance_WiperNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "wiper is not set")
+}
+
+func TestWipeBlacklistedBalance_FromAddressIsNotWiper(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetWiper(ctx, types.Wiper{Address: sample.AccAddress()})
+
+	_, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the wiper")
+}
+
+func TestWipeBlacklistedBalance_AddressNotBlacklisted(t *testing.T) {
+	wiper, holder := sample.AccAddress(), sample.TestAccount()
+	ftf, ctx, msgServer := setupForWipeTest(wiper, holder)
+	ftf.RemoveBlacklisted(ctx, holder.AddressBz)
+
+	_, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "the specified address is not blacklisted")
+}
+
+func TestWipeBlacklistedBalance_NoBalance(t *testing.T) {
+	wiper, holder := sample.AccAddress(), sample.TestAccount()
+	ftf, ctx, msgServer := setupForWipeTest(wiper, holder)
+
+	empty := sample.TestAccount()
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: empty.AddressBz})
+
+	_, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: empty.Address})
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+}
+
+func TestWipeBlacklistedBalance_Burn(t *testing.T) {
+	wiper, holder := sample.AccAddress(), sample.TestAccount()
+	ftf, ctx, msgServer := setupForWipeTest(wiper, holder)
+
+	res, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(50)), res.Amount)
+
+	supply, err := ftf.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, supply.Supply.IsZero())
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.BlacklistedBalanceWiped", events[len(events)-1].Type)
+}
+
+func TestWipeBlacklistedBalance_SendToRecipient(t *testing.T) {
+	wiper, holder, treasury := sample.AccAddress(), sample.TestAccount(), sample.AccAddress()
+	ftf, ctx, msgServer := setupForWipeTest(wiper, holder)
+
+	res, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address, Recipient: treasury})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(50)), res.Amount)
+
+	// the balance moved to the treasury, so the supply is unchanged
+	supply, err := ftf.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), supply.Supply.Amount)
+
+	_, err = msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address})
+	require.ErrorIs(t, err, types.ErrInvalidCoins)
+}
+
+func TestWipeBlacklistedBalance_QuarantinedRefund(t *testing.T) {
+	wiper, holder, treasury := sample.AccAddress(), sample.TestAccount(), sample.AccAddress()
+	ftf, ctx, bank := testkeeper.FiatTokenfactoryKeeperWithBank()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetWiper(ctx, types.Wiper{Address: wiper})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: holder.AddressBz})
+	bank.Balances[holder.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(50)))
+	quarantineRefund(ftf, ctx, bank, holder.Address, math.NewInt(25))
+
+	// the quarantined refund is wiped together with the balance of the address
+	res, err := msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address, Recipient: treasury})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(75)), res.Amount)
+	require.Equal(t, math.NewInt(75), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(treasury)).AmountOf("uusdc"))
+	_, found, err := ftf.GetQuarantinedRefund(ctx, holder.Address)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// a quarantined refund alone can be wiped
+	quarantineRefund(ftf, ctx, bank, holder.Address, math.NewInt(10))
+	res, err = msgServer.WipeBlacklistedBalance(sdk.WrapSDKContext(ctx), &types.MsgWipeBlacklistedBalance{From: wiper, Address: holder.Address})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(10)), res.Amount)
+}
+
+// setupForWipeTest mints 50uusdc to holder and blacklists it.
+func setupForWipeTest(wiper string, holder sample.Account) (*keeper.Keeper, sdk.Context, types.MsgServer) {
+	minter := sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewCoin("uusdc", math.NewInt(50)))
+	ftf.SetWiper(ctx, types.Wiper{Address: wiper})
+	ftf.SetMaxSupply(ctx, types.MaxSupply{Amount: math.NewInt(1_000)})
+
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: holder.Address, Amount: sdk.NewCoin("uusdc", math.NewInt(50))})
+	if err != nil {
+		panic(err)
+	}
+
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: holder.AddressBz})
+	return ftf, ctx, msgServer
+}
@@ -20,51 +20,33 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetOwner set owner in the store
-func (k Keeper) SetOwner(ctx context.Context, owner types.Owner) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.OwnerKey), b)
+func (k Keeper) SetOwner(ctx context.Context, owner types.Owner) error {
+	return k.owner.Set(ctx, owner)
 }
 
 // GetOwner returns owner
-func (k Keeper) GetOwner(ctx context.Context) (val types.Owner, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.OwnerKey))
-	if b == nil {
-		return val, false
-	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+func (k Keeper) GetOwner(ctx context.Context) (val types.Owner, found bool, err error) {
+	val, err = k.owner.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
 }
 
 // SetPendingOwner set pending owner in the store
-func (k Keeper) SetPendingOwner(ctx context.Context, owner types.Owner) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.PendingOwnerKey), b)
+func (k Keeper) SetPendingOwner(ctx context.Context, pendingOwner types.PendingRole) error {
+	return k.pendingOwner.Set(ctx, pendingOwner)
 }
 
 // DeletePendingOwner deletes the pending owner in the store
-func (k Keeper) DeletePendingOwner(ctx context.Context) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Delete(types.KeyPrefix(types.PendingOwnerKey))
+func (k Keeper) DeletePendingOwner(ctx context.Context) error {
+	return k.pendingOwner.Remove(ctx)
 }
 
 // GetPendingOwner returns pending owner
-func (k Keeper) GetPendingOwner(ctx context.Context) (val types.Owner, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.PendingOwnerKey))
-	if b == nil {
-		return val, false
-	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+func (k Keeper) GetPendingOwner(ctx context.Context) (val types.PendingRole, found bool, err error) {
+	val, err = k.pendingOwner.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
 }
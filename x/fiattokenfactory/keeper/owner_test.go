@@ -52,7 +52,8 @@ func TestOwnerSet_Overwrite(t *testing.T) {
 func TestOwnerGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetOwner(ctx)
+	_, found, err := keeper.GetOwner(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
@@ -60,7 +61,7 @@ func TestPendingOwnerGetAndSet_Success(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.PendingRole{Address: pendingOwner.Address})
 
 	assertPendingOwner(t, *keeper, ctx, pendingOwner)
 }
@@ -68,18 +69,19 @@ func TestPendingOwnerGetAndSet_Success(t *testing.T) {
 func TestPendingOwnerSet_Overwrite(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.PendingRole{Address: pendingOwner.Address})
 	assertPendingOwner(t, *keeper, ctx, pendingOwner)
 
 	newOwner := sample.TestAccount()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: newOwner.Address})
+	keeper.SetPendingOwner(ctx, types.PendingRole{Address: newOwner.Address})
 	assertPendingOwner(t, *keeper, ctx, newOwner)
 }
 
 func TestPendingOwnerGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found, err := keeper.GetPendingOwner(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
@@ -88,23 +90,26 @@ func TestPendingOwnerDelete_Unset(t *testing.T) {
 
 	keeper.DeletePendingOwner(ctx)
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found, err := keeper.GetPendingOwner(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
 func TestPendingOwnerDelete_Success(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.PendingRole{Address: pendingOwner.Address})
 
 	keeper.DeletePendingOwner(ctx)
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found, err := keeper.GetPendingOwner(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
 func assertOwner(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedOwner sample.Account) {
-	rst, found := keeper.GetOwner(ctx)
+	rst, found, err := keeper.GetOwner(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.Owner{Address: expectedOwner.Address}),
@@ -113,10 +118,11 @@ func assertOwner(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedOw
 }
 
 func assertPendingOwner(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedPendingOwner sample.Account) {
-	rst, found := keeper.GetPendingOwner(ctx)
+	rst, found, err := keeper.GetPendingOwner(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.Owner{Address: expectedPendingOwner.Address}),
+		nullify.Fill(&types.PendingRole{Address: expectedPendingOwner.Address}),
 		nullify.Fill(&rst),
 	)
 }
@@ -0,0 +1,41 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+)
+
+// SetParams set the module parameters in the store, they are shared by every fiat token
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the module parameters, or the default parameters if none were set yet
+func (k Keeper) GetParams(ctx context.Context) (val types.Params, err error) {
+	val, err = k.params.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return val, err
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsGetSet(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	// the defaults apply until params are stored
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, params.MaxControllersPerMinter)
+	require.Equal(t, types.DefaultParams(), params)
+
+	params = types.Params{
+		MaxBatchSize:            10,
+		MaxMinterAllowance:      math.NewInt(1_000_000),
+		RequireChannelAllowlist: true,
+		MaxControllersPerMinter: 3,
+	}
+	keeper.SetParams(ctx, params)
+	gotParams, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+}
+
+func TestParams_SharedByFiatTokens(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+
+	token, err := keeper.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxBatchSize = 10
+	keeper.SetParams(ctx, params)
+	gotParams, err := token.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+}
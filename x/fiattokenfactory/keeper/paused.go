@@ -20,25 +20,26 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/errors"
 )
 
 // SetPaused set paused in the store
-func (k Keeper) SetPaused(ctx context.Context, paused types.Paused) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&paused)
-	store.Set(types.KeyPrefix(types.PausedKey), b)
+func (k Keeper) SetPaused(ctx context.Context, paused types.Paused) error {
+	return k.paused.Set(ctx, paused)
 }
 
 // GetPaused returns paused
-func (k Keeper) GetPaused(ctx context.Context) (val types.Paused) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.PausedKey))
-	if b == nil {
-		panic("Paused state is not set")
+func (k Keeper) GetPaused(ctx context.Context) (val types.Paused, err error) {
+	val, err = k.paused.Get(ctx)
+	if err != nil {
+		return val, errors.Wrap(err, "paused state is not set")
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val
+	return val, nil
+}
+
+// PausedSet returns true if the paused state is set in the store, it returns false otherwise.
+func (k Keeper) PausedSet(ctx context.Context) (bool, error) {
+	return k.paused.Has(ctx)
 }
@@ -19,6 +19,8 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -29,7 +31,8 @@ import (
 )
 
 func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context, isPaused bool) types.Paused {
-	item := types.Paused{Paused: isPaused}
+	item := types.Paused{}
+	item.Set(isPaused)
 	keeper.SetPaused(ctx, item)
 	return item
 }
@@ -37,10 +40,11 @@ func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context, isPaused bool) typ
 func TestPausedGetAndSet_Success(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	pauseState := types.Paused{Paused: true}
+	pauseState := types.NewPaused()
 	keeper.SetPaused(ctx, pauseState)
 
-	rst := keeper.GetPaused(ctx)
+	rst, err := keeper.GetPaused(ctx)
+	require.NoError(t, err)
 	require.Equal(t,
 		nullify.Fill(&pauseState),
 		nullify.Fill(&rst),
@@ -50,27 +54,30 @@ func TestPausedGetAndSet_Success(t *testing.T) {
 func TestPausedSet_Overwrite(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	pausedState := types.Paused{Paused: true}
+	pausedState := types.NewPaused()
 	keeper.SetPaused(ctx, pausedState)
 
-	rst := keeper.GetPaused(ctx)
+	rst, err := keeper.GetPaused(ctx)
+	require.NoError(t, err)
 	require.Equal(t,
 		nullify.Fill(&pausedState),
 		nullify.Fill(&rst),
 	)
 
-	unpausedState := types.Paused{Paused: true}
+	unpausedState := types.NewPaused()
 	keeper.SetPaused(ctx, unpausedState)
 
-	rst = keeper.GetPaused(ctx)
+	rst, err = keeper.GetPaused(ctx)
+	require.NoError(t, err)
 	require.Equal(t,
 		nullify.Fill(&unpausedState),
 		nullify.Fill(&rst),
 	)
 }
 
-func TestPausedGet_UnsetPanics(t *testing.T) {
+func TestPausedGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	require.Panics(t, func() { keeper.GetPaused(ctx) })
+	_, err := keeper.GetPaused(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
 }
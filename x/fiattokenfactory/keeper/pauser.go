@@ -20,25 +20,33 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetPauser set pauser in the store
-func (k Keeper) SetPauser(ctx context.Context, pauser types.Pauser) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&pauser)
-	store.Set(types.KeyPrefix(types.PauserKey), b)
+func (k Keeper) SetPauser(ctx context.Context, pauser types.Pauser) error {
+	return k.pauser.Set(ctx, pauser)
 }
 
 // GetPauser returns pauser
-func (k Keeper) GetPauser(ctx context.Context) (val types.Pauser, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+func (k Keeper) GetPauser(ctx context.Context) (val types.Pauser, found bool, err error) {
+	val, err = k.pauser.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// SetPendingPauser set pending pauser in the store
+func (k Keeper) SetPendingPauser(ctx context.Context, pendingPauser types.PendingRole) error {
+	return k.pendingPauser.Set(ctx, pendingPauser)
+}
 
-	b := store.Get(types.KeyPrefix(types.PauserKey))
-	if b == nil {
-		return val, false
-	}
+// DeletePendingPauser deletes the pending pauser in the store
+func (k Keeper) DeletePendingPauser(ctx context.Context) error {
+	return k.pendingPauser.Remove(ctx)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetPendingPauser returns pending pauser
+func (k Keeper) GetPendingPauser(ctx context.Context) (val types.PendingRole, found bool, err error) {
+	val, err = k.pendingPauser.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
 }
@@ -58,12 +58,46 @@ func TestPauserSet_Overwrite(t *testing.T) {
 func TestPauserGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetPauser(ctx)
+	_, found, err := keeper.GetPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingPauserGetAndSet_Success(t *testing.T) {
+	pendingPauser := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPendingPauser(ctx, types.PendingRole{Address: pendingPauser.Address, ActivationHeight: 5})
+
+	rst, found, err := keeper.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: pendingPauser.Address, ActivationHeight: 5}, rst)
+}
+
+func TestPendingPauserGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetPendingPauser(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingPauserDelete_Success(t *testing.T) {
+	pendingPauser := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetPendingPauser(ctx, types.PendingRole{Address: pendingPauser.Address})
+
+	keeper.DeletePendingPauser(ctx)
+
+	_, found, err := keeper.GetPendingPauser(ctx)
+	require.NoError(t, err)
 	require.False(t, found)
 }
 
 func assertPauser(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedPauser sample.Account) {
-	rst, found := keeper.GetPauser(ctx)
+	rst, found, err := keeper.GetPauser(ctx)
+	require.NoError(t, err)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.Pauser{Address: expectedPauser.Address}),
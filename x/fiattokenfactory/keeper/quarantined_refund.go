@@ -0,0 +1,61 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	"cosmossdk.io/math"
+)
+
+// SetQuarantinedRefund set a specific quarantinedRefund in the store from its index
+func (k Keeper) SetQuarantinedRefund(ctx context.Context, refund types.QuarantinedRefund) error {
+	return k.quarantinedRefunds.Set(ctx, refund.Address, refund)
+}
+
+// GetQuarantinedRefund returns a quarantinedRefund from its index
+func (k Keeper) GetQuarantinedRefund(ctx context.Context, address string) (val types.QuarantinedRefund, found bool, err error) {
+	val, err = k.quarantinedRefunds.Get(ctx, address)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// RemoveQuarantinedRefund removes a quarantinedRefund from the store
+func (k Keeper) RemoveQuarantinedRefund(ctx context.Context, address string) error {
+	return k.quarantinedRefunds.Remove(ctx, address)
+}
+
+// GetAllQuarantinedRefunds returns all quarantinedRefund
+func (k Keeper) GetAllQuarantinedRefunds(ctx context.Context) (list []types.QuarantinedRefund, err error) {
+	return values(ctx, k.quarantinedRefunds, nil)
+}
+
+// QuarantineRefund adds amount to the refunds quarantined for address.
+func (k Keeper) QuarantineRefund(ctx context.Context, address string, amount math.Int) error {
+	refund, found, err := k.GetQuarantinedRefund(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !found {
+		refund = types.QuarantinedRefund{Address: address, Amount: math.ZeroInt()}
+	}
+
+	refund.Amount = refund.Amount.Add(amount)
+	return k.SetQuarantinedRefund(ctx, refund)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuarantineRefund_Accumulates(t *testing.T) {
+	holder := sample.AccAddress()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	require.NoError(t, keeper.QuarantineRefund(ctx, holder, math.NewInt(10)))
+	require.NoError(t, keeper.QuarantineRefund(ctx, holder, math.NewInt(20)))
+
+	refund, found, err := keeper.GetQuarantinedRefund(ctx, holder)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.QuarantinedRefund{Address: holder, Amount: math.NewInt(30)}, refund)
+}
+
+func TestQuarantinedRefundGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetQuarantinedRefund(ctx, sample.AccAddress())
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestQuarantinedRefundRemove(t *testing.T) {
+	holder, other := sample.AccAddress(), sample.AccAddress()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	require.NoError(t, keeper.QuarantineRefund(ctx, holder, math.NewInt(10)))
+	require.NoError(t, keeper.QuarantineRefund(ctx, other, math.NewInt(20)))
+
+	require.NoError(t, keeper.RemoveQuarantinedRefund(ctx, holder))
+
+	_, found, err := keeper.GetQuarantinedRefund(ctx, holder)
+	require.NoError(t, err)
+	require.False(t, found)
+	refunds, err := keeper.GetAllQuarantinedRefunds(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.QuarantinedRefund{{Address: other, Amount: math.NewInt(20)}}, refunds)
+
+	// a removed refund starts from zero when it is quarantined again
+	require.NoError(t, keeper.QuarantineRefund(ctx, holder, math.NewInt(5)))
+	refund, _, err := keeper.GetQuarantinedRefund(ctx, holder)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(5), refund.Amount)
+}
+
+func TestQuarantineRefund_ScopedToFiatToken(t *testing.T) {
+	holder := sample.AccAddress()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	keeper.SetFiatToken(ctx, types.FiatToken{Denom: "ueurc"})
+	eurc, err := keeper.ForDenom(ctx, "ueurc")
+	require.NoError(t, err)
+
+	require.NoError(t, eurc.QuarantineRefund(ctx, holder, math.NewInt(10)))
+
+	_, found, err := keeper.GetQuarantinedRefund(ctx, holder)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = eurc.GetQuarantinedRefund(ctx, holder)
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetWiper set wiper in the store
+func (k Keeper) SetWiper(ctx context.Context, wiper types.Wiper) error {
+	return k.wiper.Set(ctx, wiper)
+}
+
+// GetWiper returns wiper
+func (k Keeper) GetWiper(ctx context.Context) (val types.Wiper, found bool, err error) {
+	val, err = k.wiper.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
+
+// SetPendingWiper set pending wiper in the store
+func (k Keeper) SetPendingWiper(ctx context.Context, pendingWiper types.PendingRole) error {
+	return k.pendingWiper.Set(ctx, pendingWiper)
+}
+
+// DeletePendingWiper deletes the pending wiper in the store
+func (k Keeper) DeletePendingWiper(ctx context.Context) error {
+	return k.pendingWiper.Remove(ctx)
+}
+
+// GetPendingWiper returns pending wiper
+func (k Keeper) GetPendingWiper(ctx context.Context) (val types.PendingRole, found bool, err error) {
+	val, err = k.pendingWiper.Get(ctx)
+	found, err = exists(err)
+	return val, found, err
+}
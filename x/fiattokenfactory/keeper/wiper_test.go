@@ -0,0 +1,106 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func createTestWiper(keeper *keeper.Keeper, ctx sdk.Context) types.Wiper {
+	item := types.Wiper{}
+	keeper.SetWiper(ctx, item)
+	return item
+}
+
+func TestWiperGetAndSet_Success(t *testing.T) {
+	wiper := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetWiper(ctx, types.Wiper{Address: wiper.Address})
+
+	assertWiper(t, *keeper, ctx, wiper)
+}
+
+func TestWiperSet_Overwrite(t *testing.T) {
+	wiper := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetWiper(ctx, types.Wiper{Address: wiper.Address})
+	assertWiper(t, *keeper, ctx, wiper)
+
+	newWiper := sample.TestAccount()
+	keeper.SetWiper(ctx, types.Wiper{Address: newWiper.Address})
+	assertWiper(t, *keeper, ctx, newWiper)
+}
+
+func TestWiperGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetWiper(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingWiperGetAndSet_Success(t *testing.T) {
+	pendingWiper := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPendingWiper(ctx, types.PendingRole{Address: pendingWiper.Address, ActivationHeight: 5})
+
+	rst, found, err := keeper.GetPendingWiper(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.PendingRole{Address: pendingWiper.Address, ActivationHeight: 5}, rst)
+}
+
+func TestPendingWiperGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found, err := keeper.GetPendingWiper(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPendingWiperDelete_Success(t *testing.T) {
+	pendingWiper := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetPendingWiper(ctx, types.PendingRole{Address: pendingWiper.Address})
+
+	keeper.DeletePendingWiper(ctx)
+
+	_, found, err := keeper.GetPendingWiper(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func assertWiper(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedWiper sample.Account) {
+	rst, found, err := keeper.GetWiper(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&types.Wiper{Address: expectedWiper.Address}),
+		nullify.Fill(&rst),
+	)
+}
@@ -5,16 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Blacklisted struct {
 	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	// block height at which the address was blacklisted, zero for entries recorded before v2
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the address was blacklisted
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// blacklister that blacklisted the address
+	Blacklister string `protobuf:"bytes,4,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	// optional reason or external reference for blacklisting the address
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *Blacklisted) Reset()         { *m = Blacklisted{} }
@@ -66,6 +80,34 @@ func (m *Blacklisted) GetAddressBz() []byte {
 	return nil
 }
 
+func (m *Blacklisted) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Blacklisted) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Blacklisted) GetBlacklister() string {
+	if m != nil {
+		return m.Blacklister
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Blacklisted)(nil), "circle.fiattokenfactory.v1.Blacklisted")
 }
@@ -75,19 +117,28 @@ func init() {
 }
 
 var fileDescriptor_75ae79ec42bab5e2 = []byte{
-	// 178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xce, 0x2c, 0x4a,
-	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
-	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9, 0x2c, 0x2e,
-	0x49, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0xa8, 0xd6, 0x43, 0x57, 0xad,
-	0x57, 0x66, 0xa8, 0xa4, 0xcd, 0xc5, 0xed, 0x84, 0xd0, 0x20, 0x24, 0xc3, 0xc5, 0x99, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0xec, 0x54, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x84, 0x10, 0x70,
-	0x8a, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x97, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x6d, 0x69, 0x99, 0x79, 0xfa, 0x79, 0xf9,
-	0x49, 0x39, 0xa9, 0xba, 0x18, 0x8e, 0xac, 0xc0, 0x74, 0x77, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0xd8, 0xbd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x4a, 0x87, 0xbd, 0xdf, 0x00,
-	0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4e, 0xc2, 0x40,
+	0x14, 0xc6, 0x3b, 0x82, 0x44, 0x8a, 0xab, 0x86, 0x98, 0xda, 0x98, 0xd2, 0xb8, 0xea, 0x42, 0x66,
+	0x82, 0x6e, 0x8c, 0x3b, 0x1b, 0x4f, 0x50, 0x5d, 0xb9, 0xd0, 0xb4, 0x65, 0x3a, 0x4c, 0x68, 0xfb,
+	0xc8, 0xcc, 0x40, 0xc4, 0x53, 0x70, 0x18, 0x0f, 0xc1, 0x12, 0x5d, 0xb9, 0x52, 0x03, 0x17, 0x31,
+	0xed, 0x94, 0x60, 0x64, 0x37, 0xdf, 0xcb, 0xef, 0x9b, 0xef, 0xfd, 0x31, 0x2f, 0x12, 0x2e, 0x92,
+	0x8c, 0x92, 0x94, 0x47, 0x4a, 0xc1, 0x98, 0x16, 0x69, 0x94, 0x28, 0x10, 0x73, 0x32, 0x1b, 0x90,
+	0x38, 0x8b, 0x92, 0x71, 0xc6, 0xa5, 0xa2, 0x43, 0x3c, 0x11, 0xa0, 0xc0, 0x72, 0x34, 0x8d, 0xff,
+	0xd3, 0x78, 0x36, 0x70, 0x4e, 0x13, 0x90, 0x39, 0xc8, 0xe7, 0x8a, 0x24, 0x5a, 0x68, 0x9b, 0xd3,
+	0x65, 0xc0, 0x40, 0xd7, 0xcb, 0x57, 0x5d, 0xed, 0x31, 0x00, 0x96, 0x51, 0x52, 0xa9, 0x78, 0x9a,
+	0x12, 0xc5, 0x73, 0x2a, 0x55, 0x94, 0x4f, 0x34, 0x70, 0xfe, 0x8e, 0xcc, 0x4e, 0xb0, 0xeb, 0xc1,
+	0x3a, 0x33, 0xdb, 0xd1, 0x70, 0x28, 0xa8, 0x94, 0xc1, 0xab, 0x8d, 0x3c, 0xe4, 0x1f, 0x87, 0xbb,
+	0x82, 0x75, 0x62, 0xb6, 0x46, 0x94, 0xb3, 0x91, 0xb2, 0x0f, 0x3c, 0xe4, 0x37, 0xc2, 0x5a, 0x59,
+	0xd7, 0x66, 0xb3, 0xfc, 0xd8, 0x6e, 0x78, 0xc8, 0xef, 0x5c, 0x3a, 0x58, 0xa7, 0xe2, 0x6d, 0x2a,
+	0x7e, 0xd8, 0xa6, 0x06, 0x47, 0xcb, 0xaf, 0x9e, 0xb1, 0xf8, 0xee, 0xa1, 0xb0, 0x72, 0x58, 0x37,
+	0x66, 0x67, 0xb7, 0x02, 0x61, 0x37, 0x3d, 0xe4, 0xb7, 0x03, 0xfb, 0xe3, 0xad, 0xdf, 0xad, 0xa7,
+	0xbb, 0xd5, 0xe1, 0xf7, 0x4a, 0xf0, 0x82, 0x85, 0x7f, 0xe1, 0xb2, 0x1b, 0x41, 0x23, 0x09, 0x85,
+	0x7d, 0x58, 0xda, 0xc2, 0x5a, 0x05, 0x4f, 0xcb, 0xb5, 0x8b, 0x56, 0x6b, 0x17, 0xfd, 0xac, 0x5d,
+	0xb4, 0xd8, 0xb8, 0xc6, 0x6a, 0xe3, 0x1a, 0x9f, 0x1b, 0xd7, 0x78, 0xbc, 0x63, 0x5c, 0x8d, 0xa6,
+	0x31, 0x4e, 0x20, 0x27, 0x7a, 0xcd, 0x29, 0x2f, 0x48, 0x01, 0x71, 0x46, 0xfb, 0x7b, 0xd7, 0x79,
+	0xd9, 0x3f, 0x98, 0x9a, 0x4f, 0xa8, 0x8c, 0x5b, 0xd5, 0x5c, 0x57, 0xbf, 0x03, 0x00, 0x18, 0xd1,
+	0xe1, 0x8f, 0xd8, 0x01, 0x00, 0x00,
 }
 
 func (m *Blacklisted) Marshal() (dAtA []byte, err error) {
@@ -110,6 +161,33 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Blacklister) > 0 {
+		i -= len(m.Blacklister)
+		copy(dAtA[i:], m.Blacklister)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Blacklister)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBlacklisted(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintBlacklisted(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AddressBz) > 0 {
 		i -= len(m.AddressBz)
 		copy(dAtA[i:], m.AddressBz)
@@ -141,6 +219,19 @@ func (m *Blacklisted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlacklisted(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovBlacklisted(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBlacklisted(uint64(l))
+	l = len(m.Blacklister)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
 	return n
 }
 
@@ -213,6 +304,122 @@ func (m *Blacklisted) Unmarshal(dAtA []byte) error {
 				m.AddressBz = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlacklisted(dAtA[iNdEx:])
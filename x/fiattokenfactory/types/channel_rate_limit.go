@@ -0,0 +1,116 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxBps is the share of the total supply, in basis points, that a channel rate limit can be set to at most.
+const MaxBps = 10_000
+
+func validateChannelRateLimit(maxInflow, maxOutflow math.Int, maxInflowBps, maxOutflowBps uint32, window uint64, unit WindowUnit) error {
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+
+	if maxInflow.IsNil() || maxInflow.IsNegative() || maxOutflow.IsNil() || maxOutflow.IsNegative() {
+		return errors.Wrap(ErrInvalidCoins, "channel rate limit cannot be nil or negative")
+	}
+
+	if maxInflowBps > MaxBps || maxOutflowBps > MaxBps {
+		return errors.Wrapf(ErrInvalidCoins, "channel rate limit cannot exceed %d basis points of the supply", MaxBps)
+	}
+
+	if unit != WINDOW_UNIT_BLOCKS && unit != WINDOW_UNIT_SECONDS {
+		return errors.Wrapf(ErrInvalidType, "invalid window unit (%s)", unit)
+	}
+
+	return nil
+}
+
+// Quota returns the maximum amount transferred in one direction within a window given the absolute and
+// supply based quota of that direction, and whether the direction is limited at all.
+func Quota(max math.Int, maxBps uint32, supply math.Int) (math.Int, bool) {
+	quota, limited := max, max.IsPositive()
+
+	if maxBps > 0 {
+		share := supply.MulRaw(int64(maxBps)).QuoRaw(MaxBps)
+		if !limited || share.LT(quota) {
+			quota, limited = share, true
+		}
+	}
+
+	return quota, limited
+}
+
+// Current returns the flow without the buckets that no longer count towards its
+// window at position now.
+func (f ChannelFlow) Current(now int64, window uint64) ChannelFlow {
+	current := ChannelFlow{PortId: f.PortId, ChannelId: f.ChannelId}
+	for _, bucket := range f.Buckets {
+		if InWindow(bucket.Start, now, window) {
+			current.Buckets = append(current.Buckets, bucket)
+		}
+	}
+
+	return current
+}
+
+// Inflow returns the amount received within the window.
+func (f ChannelFlow) Inflow() math.Int {
+	inflow := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		inflow = inflow.Add(bucket.Inflow)
+	}
+
+	return inflow
+}
+
+// Outflow returns the amount sent within the window.
+func (f ChannelFlow) Outflow() math.Int {
+	outflow := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	return outflow
+}
+
+// Bucket returns the bucket that records a transfer at position now, adding it
+// if needed. As with MintWindow.Add, a transfer before the newest bucket is
+// recorded in the newest bucket.
+func (f *ChannelFlow) Bucket(now int64, window uint64) *FlowBucket {
+	start := BucketStart(now, window)
+	if n := len(f.Buckets); n > 0 && f.Buckets[n-1].Start >= start {
+		return &f.Buckets[n-1]
+	}
+
+	f.Buckets = append(f.Buckets, FlowBucket{Start: start, Inflow: math.ZeroInt(), Outflow: math.ZeroInt()})
+	return &f.Buckets[len(f.Buckets)-1]
+}
+
+// NextRelease returns the position at which the oldest amount transferred leaves
+// the window, or zero when nothing was transferred within the window.
+func (f ChannelFlow) NextRelease(window uint64) int64 {
+	if len(f.Buckets) == 0 {
+		return 0
+	}
+
+	return releaseAt(f.Buckets[0].Start, window)
+}
@@ -0,0 +1,1466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/channel_rate_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelRateLimit caps the amount of the fiat token received and sent over a channel within any rolling window.
+// Each direction is limited by the lower of its absolute quota and its share of the total supply,
+// a zero quota does not limit the direction.
+type ChannelRateLimit struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// maximum amount received over the channel within any window
+	MaxInflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_inflow,json=maxInflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_inflow"`
+	// maximum amount sent over the channel within any window
+	MaxOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_outflow,json=maxOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_outflow"`
+	// maximum amount received over the channel within any window, in basis points of the total supply
+	MaxInflowBps uint32 `protobuf:"varint,5,opt,name=max_inflow_bps,json=maxInflowBps,proto3" json:"max_inflow_bps,omitempty"`
+	// maximum amount sent over the channel within any window, in basis points of the total supply
+	MaxOutflowBps uint32 `protobuf:"varint,6,opt,name=max_outflow_bps,json=maxOutflowBps,proto3" json:"max_outflow_bps,omitempty"`
+	// length of the window, measured in unit
+	Window uint64     `protobuf:"varint,7,opt,name=window,proto3" json:"window,omitempty"`
+	Unit   WindowUnit `protobuf:"varint,8,opt,name=unit,proto3,enum=circle.fiattokenfactory.v1.WindowUnit" json:"unit,omitempty"`
+}
+
+func (m *ChannelRateLimit) Reset()         { *m = ChannelRateLimit{} }
+func (m *ChannelRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChannelRateLimit) ProtoMessage()    {}
+func (*ChannelRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{0}
+}
+func (m *ChannelRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRateLimit.Merge(m, src)
+}
+func (m *ChannelRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRateLimit proto.InternalMessageInfo
+
+func (m *ChannelRateLimit) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelRateLimit) GetMaxInflowBps() uint32 {
+	if m != nil {
+		return m.MaxInflowBps
+	}
+	return 0
+}
+
+func (m *ChannelRateLimit) GetMaxOutflowBps() uint32 {
+	if m != nil {
+		return m.MaxOutflowBps
+	}
+	return 0
+}
+
+func (m *ChannelRateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ChannelRateLimit) GetUnit() WindowUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return WINDOW_UNIT_UNSPECIFIED
+}
+
+// ChannelFlow tracks the amounts received and sent over a rate limited channel within its rolling window,
+// split into buckets like a MintWindow.
+type ChannelFlow struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// buckets still counting towards the window, oldest first
+	Buckets []FlowBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ChannelFlow) Reset()         { *m = ChannelFlow{} }
+func (m *ChannelFlow) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlow) ProtoMessage()    {}
+func (*ChannelFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{1}
+}
+func (m *ChannelFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlow.Merge(m, src)
+}
+func (m *ChannelFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlow proto.InternalMessageInfo
+
+func (m *ChannelFlow) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelFlow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFlow) GetBuckets() []FlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// FlowBucket is the amount received and sent within one bucket of a rolling window.
+type FlowBucket struct {
+	// block height or unix time, depending on the unit of the rate limit, at which the bucket started
+	Start   int64                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Inflow  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+}
+
+func (m *FlowBucket) Reset()         { *m = FlowBucket{} }
+func (m *FlowBucket) String() string { return proto.CompactTextString(m) }
+func (*FlowBucket) ProtoMessage()    {}
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{2}
+}
+func (m *FlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBucket.Merge(m, src)
+}
+func (m *FlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBucket proto.InternalMessageInfo
+
+func (m *FlowBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+// PendingOutflow is a packet sent over a rate limited channel that has not been acknowledged or timed out yet.
+// Its amount is returned to the outflow of the channel if it fails while the bucket it was sent in still counts.
+type PendingOutflow struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// start of the bucket the packet was sent in
+	BucketStart int64 `protobuf:"varint,4,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+}
+
+func (m *PendingOutflow) Reset()         { *m = PendingOutflow{} }
+func (m *PendingOutflow) String() string { return proto.CompactTextString(m) }
+func (*PendingOutflow) ProtoMessage()    {}
+func (*PendingOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{3}
+}
+func (m *PendingOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOutflow.Merge(m, src)
+}
+func (m *PendingOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOutflow proto.InternalMessageInfo
+
+func (m *PendingOutflow) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingOutflow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingOutflow) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingOutflow) GetBucketStart() int64 {
+	if m != nil {
+		return m.BucketStart
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ChannelRateLimit)(nil), "circle.fiattokenfactory.v1.ChannelRateLimit")
+	proto.RegisterType((*ChannelFlow)(nil), "circle.fiattokenfactory.v1.ChannelFlow")
+	proto.RegisterType((*FlowBucket)(nil), "circle.fiattokenfactory.v1.FlowBucket")
+	proto.RegisterType((*PendingOutflow)(nil), "circle.fiattokenfactory.v1.PendingOutflow")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/channel_rate_limit.proto", fileDescriptor_bc2ec15bd8529fb1)
+}
+
+var fileDescriptor_bc2ec15bd8529fb1 = []byte{
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x34, 0xdb, 0xa4, 0x7d, 0x69, 0xa3, 0x0c, 0x55, 0xd7, 0x80, 0xdb, 0x18, 0xa4, 0x04,
+	0xa4, 0xbb, 0xd6, 0xde, 0x3c, 0xa6, 0x5a, 0x88, 0x14, 0x94, 0x15, 0x11, 0x3c, 0xb8, 0x6c, 0x76,
+	0x27, 0xc9, 0x90, 0xec, 0xcc, 0xba, 0x3b, 0xdb, 0xa4, 0x7f, 0x40, 0x4f, 0x82, 0xbf, 0xc4, 0x93,
+	0x3f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x49, 0xfe, 0x88, 0xcc, 0xcc, 0x6e, 0x23, 0x06, 0x0b,
+	0xcd, 0x2d, 0xdf, 0x7b, 0xef, 0xfb, 0xe6, 0xcb, 0xfb, 0xf6, 0xc1, 0x61, 0x40, 0x93, 0x60, 0x4c,
+	0x9c, 0x3e, 0xf5, 0x85, 0xe0, 0x23, 0xc2, 0xfa, 0x7e, 0x20, 0x78, 0x72, 0xe6, 0x9c, 0x1e, 0x38,
+	0xc1, 0xd0, 0x67, 0x8c, 0x8c, 0xbd, 0xc4, 0x17, 0xc4, 0x1b, 0xd3, 0x88, 0x0a, 0x3b, 0x4e, 0xb8,
+	0xe0, 0xb8, 0xa1, 0x49, 0xf6, 0xbf, 0x24, 0xfb, 0xf4, 0xa0, 0xf1, 0xe4, 0x1a, 0xc1, 0x88, 0x32,
+	0xb1, 0xa4, 0xd6, 0xb8, 0x1f, 0xf0, 0x34, 0xe2, 0xa9, 0xa7, 0x90, 0xa3, 0x41, 0xde, 0xda, 0x19,
+	0xf0, 0x01, 0xd7, 0x75, 0xf9, 0x4b, 0x57, 0x5b, 0x9f, 0xca, 0x70, 0xfb, 0x48, 0x7b, 0x73, 0x7d,
+	0x41, 0x4e, 0xa4, 0x16, 0xbe, 0x07, 0xd5, 0x98, 0x27, 0xc2, 0xa3, 0xa1, 0x89, 0x9a, 0xa8, 0xbd,
+	0xe9, 0x56, 0x24, 0xec, 0x86, 0xf8, 0x01, 0x40, 0xf1, 0x47, 0x68, 0x68, 0xae, 0xa9, 0xde, 0x66,
+	0x5e, 0xe9, 0x86, 0xf8, 0x25, 0x40, 0xe4, 0x4f, 0x3d, 0xca, 0xfa, 0x63, 0x3e, 0x31, 0xcb, 0xb2,
+	0xdd, 0x79, 0x7c, 0x7e, 0xb9, 0x5b, 0xfa, 0x75, 0xb9, 0x7b, 0x47, 0x9b, 0x49, 0xc3, 0x91, 0x4d,
+	0xb9, 0x13, 0xf9, 0x62, 0x68, 0x77, 0x99, 0xf8, 0xf1, 0x7d, 0x1f, 0x72, 0x97, 0x5d, 0x26, 0xdc,
+	0xcd, 0xc8, 0x9f, 0x76, 0x15, 0x1b, 0x9f, 0x40, 0x4d, 0x6a, 0xf1, 0x4c, 0x28, 0x31, 0xe3, 0xe6,
+	0x62, 0xd2, 0xcb, 0x2b, 0x4d, 0xc7, 0x8f, 0xa0, 0xbe, 0x70, 0xe6, 0xf5, 0xe2, 0xd4, 0x5c, 0x6f,
+	0xa2, 0xf6, 0xb6, 0xbb, 0x75, 0xf5, 0x60, 0x27, 0x4e, 0xf1, 0x1e, 0xdc, 0xfa, 0xeb, 0x4d, 0x35,
+	0x56, 0x51, 0x63, 0xdb, 0x0b, 0x29, 0x39, 0x77, 0x17, 0x2a, 0x13, 0xca, 0x42, 0x3e, 0x31, 0xab,
+	0x4d, 0xd4, 0x36, 0xdc, 0x1c, 0xe1, 0x67, 0x60, 0x64, 0x8c, 0x0a, 0x73, 0xa3, 0x89, 0xda, 0xf5,
+	0xa7, 0x7b, 0xf6, 0xff, 0xa3, 0xb5, 0xdf, 0x29, 0xc6, 0x5b, 0x46, 0x85, 0xab, 0x38, 0xad, 0x2f,
+	0x08, 0x6a, 0x79, 0x10, 0xc7, 0xd2, 0xf1, 0xaa, 0x19, 0x1c, 0x43, 0xb5, 0x97, 0x05, 0x23, 0x22,
+	0x52, 0xb3, 0xdc, 0x2c, 0xb7, 0x6b, 0xd7, 0xdb, 0x90, 0x4f, 0x75, 0xd4, 0x78, 0xc7, 0x90, 0xbb,
+	0x75, 0x0b, 0x72, 0xeb, 0x1b, 0x02, 0x58, 0x74, 0xf1, 0x0e, 0xac, 0xa7, 0xc2, 0x4f, 0x84, 0x32,
+	0x53, 0x76, 0x35, 0xc0, 0x47, 0x50, 0xc9, 0xc3, 0x5e, 0xbb, 0x79, 0x3e, 0x39, 0x15, 0xbf, 0x80,
+	0x6a, 0x91, 0xf2, 0x0a, 0x9f, 0x4c, 0xc1, 0x6d, 0x7d, 0x46, 0x50, 0x7f, 0x4d, 0x58, 0x48, 0xd9,
+	0xa0, 0x48, 0x7d, 0xd5, 0x1d, 0x36, 0x60, 0x23, 0x25, 0x1f, 0x33, 0xc2, 0x02, 0xa2, 0x2c, 0x19,
+	0xee, 0x15, 0xc6, 0x0f, 0x61, 0x4b, 0xaf, 0xc8, 0xd3, 0xfb, 0x30, 0xd4, 0x3e, 0x6a, 0xba, 0xf6,
+	0x46, 0x96, 0x3a, 0x1f, 0xce, 0x67, 0x16, 0xba, 0x98, 0x59, 0xe8, 0xf7, 0xcc, 0x42, 0x5f, 0xe7,
+	0x56, 0xe9, 0x62, 0x6e, 0x95, 0x7e, 0xce, 0xad, 0xd2, 0xfb, 0xe7, 0x03, 0x2a, 0x86, 0x59, 0xcf,
+	0x0e, 0x78, 0xe4, 0xe8, 0x54, 0xfa, 0x94, 0x39, 0x8c, 0xf7, 0xc6, 0x64, 0x7f, 0xe9, 0xc8, 0xa7,
+	0xcb, 0x77, 0x2f, 0xce, 0x62, 0x92, 0xf6, 0x2a, 0xea, 0x74, 0x0f, 0xff, 0x0c, 0x00, 0x66, 0x21,
+	0x92, 0xdd, 0x70, 0x04, 0x00, 0x00,
+}
+
+func (m *ChannelRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unit != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Window != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxOutflowBps != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.MaxOutflowBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxInflowBps != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.MaxInflowBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketStart != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.BucketStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannelRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannelRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	if m.MaxInflowBps != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.MaxInflowBps))
+	}
+	if m.MaxOutflowBps != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.MaxOutflowBps))
+	}
+	if m.Window != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Window))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Unit))
+	}
+	return n
+}
+
+func (m *ChannelFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovChannelRateLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Start))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	return n
+}
+
+func (m *PendingOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Sequence))
+	}
+	if m.BucketStart != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.BucketStart))
+	}
+	return n
+}
+
+func sovChannelRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannelRateLimit(x uint64) (n int) {
+	return sovChannelRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflowBps", wireType)
+			}
+			m.MaxInflowBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInflowBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflowBps", wireType)
+			}
+			m.MaxOutflowBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutflowBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= WindowUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, FlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStart", wireType)
+			}
+			m.BucketStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannelRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannelRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannelRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannelRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannelRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannelRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannelRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
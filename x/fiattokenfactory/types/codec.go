@@ -43,6 +43,32 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpause{}, "fiattokenfactory/Unpause", nil)
 	cdc.RegisterConcrete(&MsgConfigureMinterController{}, "fiattokenfactory/ConfigureMinterController", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinterController{}, "fiattokenfactory/RemoveMinterController", nil)
+	cdc.RegisterConcrete(&MsgRegisterFiatToken{}, "fiattokenfactory/RegisterFiatToken", nil)
+	cdc.RegisterConcrete(&MsgAcceptMasterMinter{}, "fiattokenfactory/AcceptMasterMinter", nil)
+	cdc.RegisterConcrete(&MsgAcceptPauser{}, "fiattokenfactory/AcceptPauser", nil)
+	cdc.RegisterConcrete(&MsgAcceptBlacklister{}, "fiattokenfactory/AcceptBlacklister", nil)
+	cdc.RegisterConcrete(&MsgCancelPendingRole{}, "fiattokenfactory/CancelPendingRole", nil)
+	cdc.RegisterConcrete(&MsgAddMinterToController{}, "fiattokenfactory/AddMinterToController", nil)
+	cdc.RegisterConcrete(&MsgRemoveMinterFromController{}, "fiattokenfactory/RemoveMinterFromController", nil)
+	cdc.RegisterConcrete(&MsgIncreaseMinterAllowance{}, "fiattokenfactory/IncreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgDecreaseMinterAllowance{}, "fiattokenfactory/DecreaseMinterAllowance", nil)
+	cdc.RegisterConcrete(&MsgSetMintRateLimit{}, "fiattokenfactory/SetMintRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, "fiattokenfactory/SetMaxSupply", nil)
+	cdc.RegisterConcrete(&MsgBlacklistBatch{}, "fiattokenfactory/BlacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgUnblacklistBatch{}, "fiattokenfactory/UnblacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateWiper{}, "fiattokenfactory/UpdateWiper", nil)
+	cdc.RegisterConcrete(&MsgAcceptWiper{}, "fiattokenfactory/AcceptWiper", nil)
+	cdc.RegisterConcrete(&MsgWipeBlacklistedBalance{}, "fiattokenfactory/WipeBlacklistedBalance", nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, "fiattokenfactory/Freeze", nil)
+	cdc.RegisterConcrete(&MsgUnfreeze{}, "fiattokenfactory/Unfreeze", nil)
+	cdc.RegisterConcrete(&MsgReleaseQuarantinedRefund{}, "fiattokenfactory/ReleaseQuarantinedRefund", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedChannel{}, "fiattokenfactory/AddAllowedChannel", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedChannel{}, "fiattokenfactory/RemoveAllowedChannel", nil)
+	cdc.RegisterConcrete(&MsgSetChannelAllowlist{}, "fiattokenfactory/SetChannelAllowlist", nil)
+	cdc.RegisterConcrete(&MsgSetChannelRateLimit{}, "fiattokenfactory/SetChannelRateLimit", nil)
+	cdc.RegisterConcrete(&MsgAuthorityUpdateRole{}, "fiattokenfactory/AuthorityUpdateRole", nil)
+	cdc.RegisterConcrete(&MsgAuthorityPause{}, "fiattokenfactory/AuthorityPause", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "fiattokenfactory/UpdateParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -61,6 +87,32 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnpause{},
 		&MsgConfigureMinterController{},
 		&MsgRemoveMinterController{},
+		&MsgRegisterFiatToken{},
+		&MsgAcceptMasterMinter{},
+		&MsgAcceptPauser{},
+		&MsgAcceptBlacklister{},
+		&MsgCancelPendingRole{},
+		&MsgAddMinterToController{},
+		&MsgRemoveMinterFromController{},
+		&MsgIncreaseMinterAllowance{},
+		&MsgDecreaseMinterAllowance{},
+		&MsgSetMintRateLimit{},
+		&MsgSetMaxSupply{},
+		&MsgBlacklistBatch{},
+		&MsgUnblacklistBatch{},
+		&MsgUpdateWiper{},
+		&MsgAcceptWiper{},
+		&MsgWipeBlacklistedBalance{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgReleaseQuarantinedRefund{},
+		&MsgAddAllowedChannel{},
+		&MsgRemoveAllowedChannel{},
+		&MsgSetChannelAllowlist{},
+		&MsgSetChannelRateLimit{},
+		&MsgAuthorityUpdateRole{},
+		&MsgAuthorityPause{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
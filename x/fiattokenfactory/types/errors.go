@@ -34,8 +34,28 @@ var (
 	ErrUserBlacklisted    = errors.Register(ModuleName, 10, "user is already blacklisted")
 	ErrAlreadyPrivileged  = errors.Register(ModuleName, 11, "address is already assigned to privileged role")
 	ErrDenomNotRegistered = errors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrFiatTokenNotFound  = errors.Register(ModuleName, 13, "fiat token not found")
+	ErrFiatTokenExists    = errors.Register(ModuleName, 14, "fiat token already exists")
+	ErrHandoverDelay      = errors.Register(ModuleName, 15, "role handover delay has not elapsed")
+	ErrMinterLinked       = errors.Register(ModuleName, 16, "minter is already managed by controller")
+	ErrMintRateLimited    = errors.Register(ModuleName, 17, "mint rate limit exceeded")
+	ErrMaxSupplyExceeded  = errors.Register(ModuleName, 18, "max supply exceeded")
+	ErrWipe               = errors.Register(ModuleName, 19, "balance can not be wiped")
+	ErrUserFrozen         = errors.Register(ModuleName, 20, "user is already frozen")
+	ErrChannelNotAllowed  = errors.Register(ModuleName, 21, "channel is not allowed")
+	ErrChannelAllowed     = errors.Register(ModuleName, 22, "channel is already allowed")
+	ErrChannelRateLimited = errors.Register(ModuleName, 23, "channel rate limit exceeded")
+	ErrMaxControllers     = errors.Register(ModuleName, 24, "max controllers per minter exceeded")
+	ErrDenomHasSupply     = errors.Register(ModuleName, 25, "denom already has a supply")
 
 	ErrInvalidAddress = errors.Register(ModuleName, 100, "invalid address")
 	ErrInvalidCoins   = errors.Register(ModuleName, 101, "invalid coins")
 	ErrInvalidType    = errors.Register(ModuleName, 102, "invalid type")
+	ErrInvalidReason  = errors.Register(ModuleName, 103, "invalid reason")
+	ErrInvalidExpiry  = errors.Register(ModuleName, 104, "invalid expiry")
+	ErrInvalidChannel = errors.Register(ModuleName, 105, "invalid channel")
+	ErrInvalidNesting = errors.Register(ModuleName, 106, "invalid message nesting")
+	ErrInvalidParams  = errors.Register(ModuleName, 107, "invalid params")
+	ErrInvalidWindow  = errors.Register(ModuleName, 108, "invalid window")
+	ErrInvalidDelay   = errors.Register(ModuleName, 109, "invalid delay")
 )
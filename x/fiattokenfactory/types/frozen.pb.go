@@ -0,0 +1,528 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/frozen.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Frozen is an address that can still receive the fiat token but can not send it.
+type Frozen struct {
+	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	// block height at which the address was frozen
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the address was frozen
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// blacklister that froze the address
+	Blacklister string `protobuf:"bytes,4,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	// optional reason or external reference for freezing the address
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Frozen) Reset()         { *m = Frozen{} }
+func (m *Frozen) String() string { return proto.CompactTextString(m) }
+func (*Frozen) ProtoMessage()    {}
+func (*Frozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70e55b615e32c49e, []int{0}
+}
+func (m *Frozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Frozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Frozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Frozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Frozen.Merge(m, src)
+}
+func (m *Frozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *Frozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_Frozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Frozen proto.InternalMessageInfo
+
+func (m *Frozen) GetAddressBz() []byte {
+	if m != nil {
+		return m.AddressBz
+	}
+	return nil
+}
+
+func (m *Frozen) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Frozen) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Frozen) GetBlacklister() string {
+	if m != nil {
+		return m.Blacklister
+	}
+	return ""
+}
+
+func (m *Frozen) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Frozen)(nil), "circle.fiattokenfactory.v1.Frozen")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/frozen.proto", fileDescriptor_70e55b615e32c49e)
+}
+
+var fileDescriptor_70e55b615e32c49e = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0xfd, 0x2b, 0x9a, 0x32, 0x45, 0x15, 0x0a, 0x11, 0x4a, 0x23, 0x16, 0xb2,
+	0xd4, 0x56, 0x61, 0x41, 0x6c, 0x44, 0x88, 0x07, 0x08, 0x4c, 0x0c, 0xa0, 0x24, 0x75, 0x5c, 0xab,
+	0x49, 0x6e, 0x65, 0xbb, 0x15, 0xed, 0x53, 0xf4, 0x61, 0x78, 0x88, 0x0e, 0x0c, 0x15, 0x13, 0x13,
+	0xa0, 0xf6, 0x45, 0x50, 0xe2, 0x54, 0x20, 0xba, 0xf9, 0x1c, 0x9d, 0xe3, 0xcf, 0xd7, 0xd7, 0x3c,
+	0x4b, 0xb8, 0x48, 0x32, 0x4a, 0x52, 0x1e, 0x29, 0x05, 0x63, 0x5a, 0xa4, 0x51, 0xa2, 0x40, 0xcc,
+	0xc9, 0x6c, 0x40, 0x52, 0x01, 0x0b, 0x5a, 0xe0, 0x89, 0x00, 0x05, 0x96, 0xa3, 0x83, 0xf8, 0x6f,
+	0x10, 0xcf, 0x06, 0xce, 0x71, 0x02, 0x32, 0x07, 0xf9, 0x54, 0x25, 0x89, 0x16, 0xba, 0xe6, 0x74,
+	0x19, 0x30, 0xd0, 0x7e, 0x79, 0xaa, 0xdd, 0x1e, 0x03, 0x60, 0x19, 0x25, 0x95, 0x8a, 0xa7, 0x29,
+	0x51, 0x3c, 0xa7, 0x52, 0x45, 0xf9, 0x44, 0x07, 0x4e, 0x5f, 0x91, 0xd9, 0xba, 0xad, 0xf0, 0xd6,
+	0x89, 0xd9, 0x8e, 0x86, 0x43, 0x41, 0xa5, 0x0c, 0x16, 0x36, 0xf2, 0x90, 0x7f, 0x18, 0xfe, 0x18,
+	0xd6, 0x91, 0xd9, 0x1a, 0x51, 0xce, 0x46, 0xca, 0xfe, 0xe7, 0x21, 0xbf, 0x11, 0xd6, 0xca, 0xba,
+	0x34, 0x9b, 0xe5, 0x9d, 0x76, 0xc3, 0x43, 0x7e, 0xe7, 0xdc, 0xc1, 0x1a, 0x88, 0x77, 0x40, 0x7c,
+	0xbf, 0x03, 0x06, 0x07, 0xab, 0x8f, 0x9e, 0xb1, 0xfc, 0xec, 0xa1, 0xb0, 0x6a, 0x58, 0x57, 0x66,
+	0x27, 0xce, 0xa2, 0x64, 0x9c, 0x71, 0xa9, 0xa8, 0xb0, 0x9b, 0x1e, 0xf2, 0xdb, 0x81, 0xfd, 0xf6,
+	0xd2, 0xef, 0xd6, 0x83, 0x5d, 0x6b, 0xf8, 0x9d, 0x12, 0xbc, 0x60, 0xe1, 0xef, 0x70, 0xf9, 0x1a,
+	0x41, 0x23, 0x09, 0x85, 0xfd, 0xbf, 0xac, 0x85, 0xb5, 0x0a, 0x1e, 0x57, 0x1b, 0x17, 0xad, 0x37,
+	0x2e, 0xfa, 0xda, 0xb8, 0x68, 0xb9, 0x75, 0x8d, 0xf5, 0xd6, 0x35, 0xde, 0xb7, 0xae, 0xf1, 0x70,
+	0xc3, 0xb8, 0x1a, 0x4d, 0x63, 0x9c, 0x40, 0x4e, 0xf4, 0x0f, 0xa7, 0xbc, 0x20, 0x05, 0xc4, 0x19,
+	0xed, 0xef, 0xed, 0xe4, 0x79, 0x7f, 0x4d, 0x6a, 0x3e, 0xa1, 0x32, 0x6e, 0x55, 0x73, 0x5d, 0x7c,
+	0x0f, 0x00, 0xd9, 0xe0, 0x5c, 0x30, 0xce, 0x01, 0x00, 0x00,
+}
+
+func (m *Frozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Frozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Frozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Blacklister) > 0 {
+		i -= len(m.Blacklister)
+		copy(dAtA[i:], m.Blacklister)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.Blacklister)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFrozen(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintFrozen(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AddressBz) > 0 {
+		i -= len(m.AddressBz)
+		copy(dAtA[i:], m.AddressBz)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.AddressBz)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFrozen(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFrozen(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Frozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressBz)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFrozen(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFrozen(uint64(l))
+	l = len(m.Blacklister)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	return n
+}
+
+func sovFrozen(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFrozen(x uint64) (n int) {
+	return sovFrozen(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Frozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrozen
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Frozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Frozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBz", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBz = append(m.AddressBz[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBz == nil {
+				m.AddressBz = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrozen(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFrozen(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFrozen
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFrozen
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFrozen
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFrozen
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFrozen        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFrozen          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFrozen = fmt.Errorf("proto: unexpected end of group")
+)
@@ -22,12 +22,21 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+
 	return &GenesisState{
 		BlacklistedList:      []Blacklisted{},
+		FrozenList:           []Frozen{},
+		AllowedChannelList:   []AllowedChannel{},
+		ChannelRateLimits:    []ChannelRateLimit{},
+		ChannelFlows:         []ChannelFlow{},
+		PendingOutflows:      []PendingOutflow{},
+		QuarantinedRefunds:   []QuarantinedRefund{},
 		Paused:               nil,
 		MasterMinter:         nil,
 		MintersList:          []Minters{},
@@ -36,13 +45,68 @@ func DefaultGenesis() *GenesisState {
 		Owner:                nil,
 		MinterControllerList: []MinterController{},
 		MintingDenom:         nil,
+		FiatTokens:           []GenesisState{},
+		Params:               &params,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// Check for duplicated index in blacklisted
+	// the default params apply when none are given
+	params := DefaultParams()
+	if gs.Params != nil {
+		if err := gs.Params.Validate(); err != nil {
+			return err
+		}
+		params = *gs.Params
+	}
+
+	if err := gs.validateFiatToken(params); err != nil {
+		return err
+	}
+
+	// Check for duplicated or invalid denoms in fiat tokens and validate each of their states
+	fiatTokenIndexMap := make(map[string]struct{})
+	if gs.MintingDenom != nil {
+		fiatTokenIndexMap[gs.MintingDenom.Denom] = struct{}{}
+	}
+	for _, elem := range gs.FiatTokens {
+		if elem.MintingDenom == nil || elem.MintingDenom.Denom == "" {
+			return fmt.Errorf("fiat token minting denom cannot be empty")
+		}
+
+		denom := elem.MintingDenom.Denom
+		if _, ok := fiatTokenIndexMap[denom]; ok {
+			return fmt.Errorf("duplicated index for fiat token %s", denom)
+		}
+		fiatTokenIndexMap[denom] = struct{}{}
+
+		if len(elem.FiatTokens) > 0 {
+			return fmt.Errorf("fiat token %s cannot contain nested fiat tokens", denom)
+		}
+
+		if elem.Params != nil {
+			return fmt.Errorf("fiat token %s cannot contain params, they are shared by every fiat token", denom)
+		}
+
+		if err := elem.validateFiatToken(params); err != nil {
+			return errors.Wrapf(err, "invalid fiat token %s", denom)
+		}
+	}
+
+	return nil
+}
+
+// validateFiatToken validates the roles, minters and state of a single fiat token.
+func (gs GenesisState) validateFiatToken(params Params) error {
+	if gs.Paused != nil {
+		if err := gs.Paused.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in blacklisted and validate the recorded blacklister and reason
 	blacklistedIndexMap := make(map[string]struct{})
 	for _, elem := range gs.BlacklistedList {
 		index := string(BlacklistedKey(elem.AddressBz))
@@ -50,6 +114,56 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for blacklisted")
 		}
 		blacklistedIndexMap[index] = struct{}{}
+
+		// entries recorded before v2 have no blacklister
+		if elem.Blacklister != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Blacklister); err != nil {
+				return errors.Wrapf(ErrInvalidAddress, "blacklisted entry has invalid blacklister address (%s)", err)
+			}
+		}
+
+		if len(elem.Reason) > MaxBlacklistReasonLength {
+			return errors.Wrapf(ErrInvalidReason, "blacklisted entry reason cannot be longer than %d characters", MaxBlacklistReasonLength)
+		}
+	}
+
+	// Check for duplicated index in frozen and validate the recorded blacklister and reason
+	frozenIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FrozenList {
+		index := string(FrozenKey(elem.AddressBz))
+		if _, ok := frozenIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for frozen")
+		}
+		frozenIndexMap[index] = struct{}{}
+
+		if elem.Blacklister != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Blacklister); err != nil {
+				return errors.Wrapf(ErrInvalidAddress, "frozen entry has invalid blacklister address (%s)", err)
+			}
+		}
+
+		if len(elem.Reason) > MaxBlacklistReasonLength {
+			return errors.Wrapf(ErrInvalidReason, "frozen entry reason cannot be longer than %d characters", MaxBlacklistReasonLength)
+		}
+	}
+
+	// Check for duplicated index in allowed channels and validate the port and channel identifiers
+	allowedChannelIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AllowedChannelList {
+		// the identifiers are validated first, as an invalid one cannot be encoded in an index
+		if err := host.PortIdentifierValidator(elem.PortId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "allowed channel has invalid port id (%s)", err)
+		}
+
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "allowed channel has invalid channel id (%s)", err)
+		}
+
+		index := string(AllowedChannelKey(elem.PortId, elem.ChannelId))
+		if _, ok := allowedChannelIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for allowed channel")
+		}
+		allowedChannelIndexMap[index] = struct{}{}
 	}
 
 	// Check for duplicated index in minters and validate minter addr and allowance
@@ -72,20 +186,196 @@ func (gs GenesisState) Validate() error {
 
 	// Check for duplicated index in minterController and validate both controller and minter addresses
 	minterControllerIndexMap := make(map[string]struct{})
+	primaryMinters := make(map[string]string)
+	controllersPerMinter := make(map[string]int)
 	for _, elem := range gs.MinterControllerList {
+		if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "minter controller has invalid minter address (%s)", err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Controller); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "minter controller has invalid controller address (%s)", err)
+		}
+
 		index := string(MinterControllerKey(elem.Controller))
 		if _, ok := minterControllerIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minterController")
 		}
 		minterControllerIndexMap[index] = struct{}{}
+		primaryMinters[elem.Controller] = elem.Minter
+
+		controllersPerMinter[elem.Minter]++
+		if params.ExceedsMaxControllersPerMinter(controllersPerMinter[elem.Minter]) {
+			return errors.Wrapf(ErrMaxControllers, "minter %s cannot have more than %d controllers", elem.Minter, params.MaxControllersPerMinter)
+		}
+	}
 
+	// Check for duplicated or orphaned links between controllers and minters
+	minterControllerLinkIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MinterControllerLinks {
+		// the addresses are validated first, as an invalid one cannot be encoded in an index
 		if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "minter controller has invalid minter address (%s)", err)
+			return errors.Wrapf(ErrInvalidAddress, "minter controller link has invalid minter address (%s)", err)
 		}
 
 		if _, err := sdk.AccAddressFromBech32(elem.Controller); err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "minter controller has invalid controller address (%s)", err)
+			return errors.Wrapf(ErrInvalidAddress, "minter controller link has invalid controller address (%s)", err)
+		}
+
+		index := string(ControllerMintersKey(elem.Controller, elem.Minter))
+		if _, ok := minterControllerLinkIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for minterControllerLink")
+		}
+		minterControllerLinkIndexMap[index] = struct{}{}
+
+		if _, ok := minterControllerIndexMap[string(MinterControllerKey(elem.Controller))]; !ok {
+			return fmt.Errorf("minter controller link for minter %s references unknown controller %s", elem.Minter, elem.Controller)
+		}
+
+		// the link of a controller to its primary minter is already counted above
+		if primaryMinters[elem.Controller] != elem.Minter {
+			controllersPerMinter[elem.Minter]++
+			if params.ExceedsMaxControllersPerMinter(controllersPerMinter[elem.Minter]) {
+				return errors.Wrapf(ErrMaxControllers, "minter %s cannot have more than %d controllers", elem.Minter, params.MaxControllersPerMinter)
+			}
+		}
+	}
+
+	// Check for duplicated index in mintRateLimits and validate each limit
+	mintRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintRateLimits {
+		index := string(MintersKey(elem.Minter))
+		if _, ok := mintRateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mintRateLimit")
 		}
+		mintRateLimitIndexMap[index] = struct{}{}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Minter); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "mint rate limit has invalid minter address (%s)", err)
+		}
+
+		if elem.Window == 0 {
+			return fmt.Errorf("mint rate limit window cannot be zero")
+		}
+
+		if err := validateMintRateLimit(elem.Limit, elem.Window, elem.Unit); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in mintWindows and that every window belongs to a rate limit
+	mintWindowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MintWindows {
+		index := string(MintersKey(elem.Minter))
+		if _, ok := mintWindowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mintWindow")
+		}
+		mintWindowIndexMap[index] = struct{}{}
+
+		if _, ok := mintRateLimitIndexMap[index]; !ok {
+			return fmt.Errorf("mint window for minter %s has no mint rate limit", elem.Minter)
+		}
+
+		for _, bucket := range elem.Buckets {
+			if bucket.Minted.IsNil() || bucket.Minted.IsNegative() {
+				return errors.Wrap(ErrInvalidCoins, "mint window amount cannot be nil or negative")
+			}
+		}
+	}
+
+	// Check for duplicated index in channelRateLimits and validate each limit
+	channelRateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ChannelRateLimits {
+		// the identifiers are validated first, as an invalid one cannot be encoded in an index
+		if err := host.PortIdentifierValidator(elem.PortId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "channel rate limit has invalid port id (%s)", err)
+		}
+
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "channel rate limit has invalid channel id (%s)", err)
+		}
+
+		index := string(ChannelRateLimitKey(elem.PortId, elem.ChannelId))
+		if _, ok := channelRateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for channelRateLimit")
+		}
+		channelRateLimitIndexMap[index] = struct{}{}
+
+		if elem.Window == 0 {
+			return fmt.Errorf("channel rate limit window cannot be zero")
+		}
+
+		if err := validateChannelRateLimit(elem.MaxInflow, elem.MaxOutflow, elem.MaxInflowBps, elem.MaxOutflowBps, elem.Window, elem.Unit); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in channelFlows and that every flow belongs to a rate limit
+	channelFlowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ChannelFlows {
+		if err := host.PortIdentifierValidator(elem.PortId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "channel flow has invalid port id (%s)", err)
+		}
+
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "channel flow has invalid channel id (%s)", err)
+		}
+
+		index := string(ChannelRateLimitKey(elem.PortId, elem.ChannelId))
+		if _, ok := channelFlowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for channelFlow")
+		}
+		channelFlowIndexMap[index] = struct{}{}
+
+		if _, ok := channelRateLimitIndexMap[index]; !ok {
+			return fmt.Errorf("channel flow for %s/%s has no channel rate limit", elem.PortId, elem.ChannelId)
+		}
+
+		for _, bucket := range elem.Buckets {
+			if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() || bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+				return errors.Wrap(ErrInvalidCoins, "channel flow amounts cannot be nil or negative")
+			}
+		}
+	}
+
+	// Check for duplicated index in pendingOutflows and validate the port and channel identifiers
+	pendingOutflowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingOutflows {
+		if err := host.PortIdentifierValidator(elem.PortId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "pending outflow has invalid port id (%s)", err)
+		}
+
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return errors.Wrapf(ErrInvalidChannel, "pending outflow has invalid channel id (%s)", err)
+		}
+
+		index := string(PendingOutflowKey(elem.PortId, elem.ChannelId, elem.Sequence))
+		if _, ok := pendingOutflowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingOutflow")
+		}
+		pendingOutflowIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in quarantinedRefunds and validate each refund
+	quarantinedRefundIndexMap := make(map[string]struct{})
+	for _, elem := range gs.QuarantinedRefunds {
+		index := string(MintersKey(elem.Address))
+		if _, ok := quarantinedRefundIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for quarantinedRefund")
+		}
+		quarantinedRefundIndexMap[index] = struct{}{}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "quarantined refund has invalid address (%s)", err)
+		}
+
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() {
+			return errors.Wrap(ErrInvalidCoins, "quarantined refund amount must be positive")
+		}
+	}
+
+	if gs.MaxSupply != nil && (gs.MaxSupply.Amount.IsNil() || !gs.MaxSupply.Amount.IsPositive()) {
+		return errors.Wrap(ErrInvalidCoins, "max supply must be positive")
 	}
 
 	var addresses []sdk.AccAddress
@@ -122,10 +412,42 @@ func (gs GenesisState) Validate() error {
 		addresses = append(addresses, blacklister)
 	}
 
+	if gs.Wiper != nil {
+		wiper, err := sdk.AccAddressFromBech32(gs.Wiper.Address)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid wiper address (%s)", err)
+		}
+		addresses = append(addresses, wiper)
+	}
+
 	if err := validatePrivileges(addresses); err != nil {
 		return err
 	}
 
+	pendingRoles := []struct {
+		name string
+		role *PendingRole
+	}{
+		{"master minter", gs.PendingMasterMinter},
+		{"pauser", gs.PendingPauser},
+		{"black lister", gs.PendingBlacklister},
+		{"owner", gs.PendingOwner},
+		{"wiper", gs.PendingWiper},
+	}
+	for _, pending := range pendingRoles {
+		if pending.role == nil {
+			continue
+		}
+
+		if _, err := sdk.AccAddressFromBech32(pending.role.Address); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid pending %s address (%s)", pending.name, err)
+		}
+
+		if pending.role.ActivationHeight < 0 {
+			return fmt.Errorf("pending %s activation height cannot be negative", pending.name)
+		}
+	}
+
 	if gs.MintingDenom != nil && gs.MintingDenom.Denom == "" {
 		return fmt.Errorf("minting denom cannot be an empty string")
 	}
@@ -34,6 +34,29 @@ type GenesisState struct {
 	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	MinterControllerList []MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
 	MintingDenom         *MintingDenom      `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	// fiatTokens holds the state of every additionally registered fiat token,
+	// each identified by its mintingDenom.
+	FiatTokens          []GenesisState `protobuf:"bytes,11,rep,name=fiatTokens,proto3" json:"fiatTokens"`
+	PendingMasterMinter *PendingRole   `protobuf:"bytes,12,opt,name=pendingMasterMinter,proto3" json:"pendingMasterMinter,omitempty"`
+	PendingPauser       *PendingRole   `protobuf:"bytes,13,opt,name=pendingPauser,proto3" json:"pendingPauser,omitempty"`
+	PendingBlacklister  *PendingRole   `protobuf:"bytes,14,opt,name=pendingBlacklister,proto3" json:"pendingBlacklister,omitempty"`
+	PendingOwner        *PendingRole   `protobuf:"bytes,15,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
+	// every (controller, minter) link, including each controller's primary minter
+	MinterControllerLinks []MinterController `protobuf:"bytes,16,rep,name=minterControllerLinks,proto3" json:"minterControllerLinks"`
+	MintRateLimits        []MintRateLimit    `protobuf:"bytes,17,rep,name=mintRateLimits,proto3" json:"mintRateLimits"`
+	MintWindows           []MintWindow       `protobuf:"bytes,18,rep,name=mintWindows,proto3" json:"mintWindows"`
+	MaxSupply             *MaxSupply         `protobuf:"bytes,19,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	Wiper                 *Wiper             `protobuf:"bytes,20,opt,name=wiper,proto3" json:"wiper,omitempty"`
+	PendingWiper          *PendingRole       `protobuf:"bytes,21,opt,name=pendingWiper,proto3" json:"pendingWiper,omitempty"`
+	FrozenList            []Frozen           `protobuf:"bytes,22,rep,name=frozenList,proto3" json:"frozenList"`
+	AllowedChannelList    []AllowedChannel   `protobuf:"bytes,23,rep,name=allowedChannelList,proto3" json:"allowedChannelList"`
+	ChannelRateLimits     []ChannelRateLimit `protobuf:"bytes,24,rep,name=channelRateLimits,proto3" json:"channelRateLimits"`
+	ChannelFlows          []ChannelFlow      `protobuf:"bytes,25,rep,name=channelFlows,proto3" json:"channelFlows"`
+	PendingOutflows       []PendingOutflow   `protobuf:"bytes,26,rep,name=pendingOutflows,proto3" json:"pendingOutflows"`
+	// module wide parameters, only set on the top level genesis state
+	Params             *Params             `protobuf:"bytes,27,opt,name=params,proto3" json:"params,omitempty"`
+	QuarantinedRefunds []QuarantinedRefund `protobuf:"bytes,28,rep,name=quarantinedRefunds,proto3" json:"quarantinedRefunds"`
+	ChannelAllowlist   *ChannelAllowlist   `protobuf:"bytes,29,opt,name=channelAllowlist,proto3" json:"channelAllowlist,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +155,139 @@ func (m *GenesisState) GetMintingDenom() *MintingDenom {
 	return nil
 }
 
+func (m *GenesisState) GetFiatTokens() []GenesisState {
+	if m != nil {
+		return m.FiatTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingMasterMinter() *PendingRole {
+	if m != nil {
+		return m.PendingMasterMinter
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPauser() *PendingRole {
+	if m != nil {
+		return m.PendingPauser
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingBlacklister() *PendingRole {
+	if m != nil {
+		return m.PendingBlacklister
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwner() *PendingRole {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterControllerLinks() []MinterController {
+	if m != nil {
+		return m.MinterControllerLinks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRateLimits() []MintRateLimit {
+	if m != nil {
+		return m.MintRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintWindows() []MintWindow {
+	if m != nil {
+		return m.MintWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxSupply() *MaxSupply {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWiper() *Wiper {
+	if m != nil {
+		return m.Wiper
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWiper() *PendingRole {
+	if m != nil {
+		return m.PendingWiper
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenList() []Frozen {
+	if m != nil {
+		return m.FrozenList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedChannelList() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelFlows() []ChannelFlow {
+	if m != nil {
+		return m.ChannelFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOutflows() []PendingOutflow {
+	if m != nil {
+		return m.PendingOutflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedRefunds() []QuarantinedRefund {
+	if m != nil {
+		return m.QuarantinedRefunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelAllowlist() *ChannelAllowlist {
+	if m != nil {
+		return m.ChannelAllowlist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "circle.fiattokenfactory.v1.GenesisState")
 }
@@ -141,36 +297,64 @@ func init() {
 }
 
 var fileDescriptor_9bceddd93438df2a = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc5, 0x93, 0xad, 0x2d, 0xc3, 0x99, 0x04, 0xb2, 0x76, 0x88, 0x76, 0x08, 0x63, 0x48, 0x2c,
-	0x87, 0x91, 0x68, 0xe5, 0x80, 0xc4, 0xb1, 0x4c, 0x42, 0x40, 0x2b, 0x50, 0x38, 0x20, 0x71, 0xa0,
-	0x4a, 0x52, 0x27, 0x58, 0x4d, 0xec, 0xca, 0x76, 0x0b, 0xfd, 0x16, 0x7c, 0x26, 0x4e, 0x3d, 0xf6,
-	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0xd8, 0x21, 0x4d, 0x5b, 0xe6, 0xe6, 0x66, 0x45, 0xbf, 0xf7,
-	0xe2, 0xff, 0x7b, 0xfe, 0x03, 0x37, 0xc6, 0x2c, 0xce, 0x90, 0x9f, 0xe0, 0x50, 0x08, 0x3a, 0x46,
-	0x24, 0x09, 0x63, 0x41, 0xd9, 0xdc, 0x9f, 0xdd, 0xf8, 0x29, 0x22, 0x88, 0x63, 0xee, 0x4d, 0x18,
-	0x15, 0x14, 0x9e, 0x2b, 0xd2, 0xdb, 0x25, 0xbd, 0xd9, 0xcd, 0xf9, 0xb5, 0xc6, 0x25, 0xca, 0xc2,
-	0x78, 0x9c, 0x61, 0x2e, 0xd0, 0x48, 0x39, 0x35, 0xa4, 0x59, 0x49, 0x7b, 0x1a, 0x3a, 0x0f, 0x0b,
-	0x70, 0x98, 0x63, 0xb2, 0xe1, 0xbb, 0x3a, 0x5e, 0x82, 0xc3, 0x98, 0x12, 0xc1, 0x68, 0x96, 0x55,
-	0x1a, 0xf7, 0xa0, 0x86, 0x37, 0xb9, 0x0d, 0x26, 0x02, 0x93, 0x74, 0x38, 0x42, 0x84, 0xe6, 0x25,
-	0xff, 0x54, 0xc3, 0xd3, 0x6f, 0xa4, 0xba, 0xc1, 0x95, 0x86, 0x9b, 0x84, 0x53, 0x5e, 0x85, 0x77,
-	0x10, 0xfc, 0xe7, 0x78, 0x96, 0xd2, 0x94, 0xca, 0xa3, 0x5f, 0x9c, 0xd4, 0xd7, 0xcb, 0x9f, 0x6d,
-	0x70, 0xfa, 0x5a, 0xf5, 0xfa, 0x51, 0x84, 0x02, 0xc1, 0x4f, 0xe0, 0x41, 0xad, 0xa1, 0x3e, 0xe6,
-	0xc2, 0x3e, 0xba, 0x38, 0x76, 0xad, 0xee, 0x95, 0x77, 0x77, 0xe1, 0x5e, 0x6f, 0x23, 0xe9, 0xb5,
-	0x16, 0xbf, 0x1f, 0x19, 0xc1, 0xae, 0x0b, 0x7c, 0x09, 0x3a, 0xea, 0xe2, 0xf6, 0xf1, 0x85, 0xe9,
-	0x5a, 0xdd, 0x4b, 0x9d, 0xdf, 0x07, 0x49, 0x06, 0xa5, 0x02, 0xf6, 0xc1, 0xa9, 0xaa, 0x76, 0x20,
-	0xc3, 0xb7, 0x5b, 0xd2, 0xc1, 0xd5, 0x39, 0x0c, 0x6a, 0x7c, 0xb0, 0xa5, 0x86, 0xef, 0x80, 0x55,
-	0x96, 0x28, 0xc7, 0x6b, 0xcb, 0xf1, 0x9e, 0x68, 0xcd, 0x14, 0x5e, 0x8e, 0x56, 0x57, 0x57, 0x63,
-	0x31, 0xbb, 0xd3, 0x70, 0x2c, 0x56, 0x8e, 0xc5, 0xe0, 0x1b, 0x60, 0xd5, 0xde, 0xb7, 0x7d, 0x4f,
-	0x1a, 0x34, 0xcc, 0x99, 0x05, 0x75, 0x2d, 0x7c, 0x01, 0xda, 0xf2, 0xf9, 0xd8, 0x27, 0xd2, 0xe4,
-	0xb1, 0xce, 0xe4, 0x7d, 0x01, 0x06, 0x8a, 0x87, 0x09, 0x38, 0x53, 0xe3, 0xbc, 0xaa, 0x96, 0x40,
-	0xa6, 0x72, 0x5f, 0xa6, 0x72, 0x7d, 0x38, 0x95, 0x8d, 0xae, 0x8c, 0xe7, 0xbf, 0x7e, 0xb2, 0x42,
-	0xb5, 0x0f, 0xb7, 0xc5, 0x3a, 0xd8, 0xa0, 0x41, 0x85, 0x35, 0x3e, 0xd8, 0x52, 0xbf, 0x6d, 0x9d,
-	0x98, 0x0f, 0x8f, 0x8a, 0x1c, 0x59, 0x98, 0xf3, 0xde, 0x97, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7,
-	0xfc, 0xb3, 0x72, 0xcc, 0x1f, 0x6b, 0xc7, 0x58, 0xae, 0x1d, 0xe3, 0xd7, 0xda, 0x31, 0x3e, 0xdf,
-	0xa6, 0x58, 0x7c, 0x9d, 0x46, 0x5e, 0x4c, 0x73, 0x5f, 0xfd, 0x29, 0xc1, 0xc4, 0x27, 0x34, 0xca,
-	0xd0, 0xb3, 0xbd, 0x8d, 0xf9, 0xbe, 0xbf, 0x44, 0x62, 0x3e, 0x41, 0x3c, 0xea, 0xc8, 0x5d, 0x79,
-	0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x6a, 0x13, 0x66, 0x1d, 0x05, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdb, 0x52, 0x3b, 0x35,
+	0x1c, 0xc7, 0xdb, 0x7f, 0xf9, 0x23, 0xa4, 0xe5, 0x14, 0x40, 0x63, 0xd5, 0x5a, 0x71, 0x84, 0xaa,
+	0xd0, 0x0a, 0x5c, 0x38, 0xe3, 0x9d, 0x94, 0xc1, 0x03, 0x07, 0x61, 0x71, 0xa6, 0xca, 0x85, 0xcb,
+	0x76, 0x9b, 0x96, 0x1d, 0xb6, 0x49, 0xcd, 0xa6, 0x14, 0x7c, 0x0a, 0x1f, 0x8b, 0x4b, 0x2e, 0xbd,
+	0x72, 0x1c, 0x78, 0x01, 0x1f, 0xc1, 0xc9, 0xa1, 0xdb, 0xed, 0xc1, 0xec, 0x96, 0xbb, 0x4e, 0xfa,
+	0xfd, 0x7e, 0x36, 0xf9, 0x1d, 0x92, 0x1f, 0x28, 0xb9, 0x1e, 0x73, 0x7d, 0x5c, 0x69, 0x7a, 0x0e,
+	0xe7, 0xf4, 0x16, 0x93, 0xa6, 0xe3, 0x72, 0xca, 0x1e, 0x2a, 0x77, 0xbb, 0x95, 0x16, 0x26, 0x38,
+	0xf0, 0x82, 0x72, 0x87, 0x51, 0x4e, 0x61, 0x5e, 0x29, 0xcb, 0xa3, 0xca, 0xf2, 0xdd, 0x6e, 0xfe,
+	0x2b, 0x03, 0xc5, 0xf1, 0x7d, 0xda, 0xc3, 0x0d, 0xdb, 0xbd, 0x71, 0x08, 0xc1, 0xbe, 0xa2, 0xe5,
+	0xb7, 0x0d, 0x8e, 0xba, 0xef, 0xb8, 0xb7, 0xbe, 0x17, 0x70, 0xdc, 0x98, 0x4a, 0xcd, 0xb4, 0x7a,
+	0xdf, 0xa0, 0xd6, 0xbb, 0xb0, 0x99, 0xc3, 0xb1, 0xed, 0x7b, 0x6d, 0x8f, 0x6b, 0xd3, 0x96, 0xc1,
+	0xd4, 0x64, 0xf4, 0x0f, 0x4c, 0xb4, 0xb0, 0x6c, 0x10, 0xb6, 0x1d, 0xb1, 0x0d, 0xbb, 0xed, 0x91,
+	0xc1, 0x6e, 0xbe, 0x34, 0xea, 0xef, 0xed, 0xa0, 0xdb, 0xe9, 0xf8, 0x0f, 0x5a, 0x6c, 0x0a, 0xa4,
+	0xa0, 0x8e, 0xef, 0x7b, 0x2f, 0xc6, 0x81, 0x99, 0xed, 0x52, 0xc2, 0x19, 0xf5, 0xfd, 0x70, 0x4b,
+	0xa5, 0x58, 0x4f, 0x90, 0xe4, 0xb0, 0x1e, 0xe1, 0x1e, 0x69, 0xd9, 0x0d, 0x4c, 0x68, 0x5b, 0xeb,
+	0x37, 0x0d, 0x7a, 0xda, 0x23, 0x98, 0x25, 0x88, 0x76, 0xc7, 0x61, 0x4e, 0x3b, 0x48, 0x24, 0xec,
+	0x06, 0x61, 0x89, 0xec, 0x98, 0x84, 0x98, 0x34, 0xc4, 0x4e, 0x19, 0xf5, 0x71, 0x52, 0x6e, 0x92,
+	0x62, 0xfa, 0xbd, 0xeb, 0x30, 0x47, 0x04, 0x01, 0x37, 0x6c, 0x86, 0x9b, 0x5d, 0xd2, 0x48, 0x10,
+	0x86, 0x9e, 0xd7, 0x09, 0xe1, 0x6b, 0x2d, 0xda, 0xa2, 0xf2, 0x67, 0x45, 0xfc, 0x52, 0xab, 0x1b,
+	0xff, 0xae, 0x80, 0xdc, 0x77, 0xaa, 0xf7, 0x2e, 0xb9, 0xc3, 0x31, 0xac, 0x81, 0xa5, 0x48, 0x4f,
+	0x9c, 0x78, 0x01, 0x47, 0x6f, 0x8a, 0x99, 0x52, 0x76, 0x6f, 0xab, 0xfc, 0xff, 0x4d, 0x59, 0x3e,
+	0x18, 0x58, 0x0e, 0x66, 0x1e, 0xff, 0xfe, 0x38, 0x65, 0x8d, 0x52, 0xe0, 0x37, 0x60, 0x56, 0x05,
+	0x11, 0x65, 0x8a, 0xe9, 0x52, 0x76, 0x6f, 0xc3, 0xc4, 0x3b, 0x97, 0x4a, 0x4b, 0x3b, 0xe0, 0x09,
+	0xc8, 0xa9, 0x72, 0x3f, 0x95, 0x15, 0x83, 0x66, 0x24, 0xa1, 0x64, 0x22, 0x9c, 0x46, 0xf4, 0xd6,
+	0x90, 0x1b, 0x1e, 0x83, 0xac, 0xae, 0x3c, 0x79, 0xbc, 0xb7, 0xf2, 0x78, 0x9f, 0x1a, 0x61, 0x4a,
+	0xae, 0x8f, 0x16, 0x75, 0x87, 0xc7, 0x62, 0x68, 0x36, 0xe1, 0xb1, 0x98, 0x3e, 0x16, 0x83, 0x3f,
+	0x80, 0x6c, 0xe4, 0x46, 0x41, 0xef, 0x14, 0xd3, 0xc9, 0xe3, 0xcc, 0xac, 0xa8, 0x17, 0x7e, 0x0d,
+	0xde, 0xca, 0x9a, 0x47, 0x73, 0x12, 0xf2, 0x89, 0x09, 0xf2, 0x93, 0x10, 0x5a, 0x4a, 0x0f, 0x9b,
+	0x60, 0x4d, 0x1d, 0xa7, 0x1a, 0x76, 0xae, 0x8c, 0xca, 0xbc, 0x8c, 0xca, 0x76, 0x7c, 0x54, 0x06,
+	0x3e, 0x1d, 0x9e, 0x89, 0x3c, 0x99, 0x42, 0xd5, 0xc4, 0x87, 0xa2, 0x87, 0x11, 0x48, 0x90, 0xc2,
+	0x88, 0xde, 0x1a, 0x72, 0xc3, 0x33, 0x00, 0x84, 0xe3, 0x67, 0xe1, 0x08, 0x50, 0xb6, 0x98, 0x89,
+	0x63, 0x45, 0x6b, 0x5c, 0xef, 0x33, 0x42, 0x80, 0xbf, 0x82, 0x55, 0xdd, 0xb8, 0xd1, 0xba, 0x41,
+	0xb9, 0xf8, 0x8c, 0x9c, 0x2b, 0x9b, 0x45, 0x7d, 0x6c, 0x4d, 0x62, 0xc0, 0x53, 0xb0, 0xa0, 0x97,
+	0x55, 0xf6, 0xd1, 0xc2, 0x74, 0xd0, 0x61, 0x37, 0xac, 0x01, 0xa8, 0x17, 0x22, 0xb5, 0x80, 0x16,
+	0xa7, 0x63, 0x4e, 0x40, 0xc0, 0x63, 0x90, 0xd3, 0xab, 0xb2, 0x3e, 0xd0, 0xd2, 0x74, 0xc8, 0x21,
+	0x33, 0xbc, 0x01, 0xeb, 0xe3, 0x55, 0x40, 0x6e, 0x03, 0xb4, 0xfc, 0xea, 0xb2, 0x9a, 0x0c, 0x84,
+	0x35, 0xb0, 0x28, 0xfe, 0xb0, 0x1c, 0x8e, 0x4f, 0xc4, 0x53, 0x15, 0xa0, 0x15, 0xf9, 0x89, 0xcf,
+	0xe3, 0x3e, 0x11, 0x3a, 0x34, 0x7f, 0x04, 0x03, 0xcf, 0xd4, 0x2d, 0x51, 0xf3, 0x48, 0x83, 0xf6,
+	0x02, 0x04, 0x25, 0x75, 0x33, 0x8e, 0xaa, 0xe4, 0xd1, 0x8b, 0x42, 0x03, 0x60, 0x15, 0xcc, 0xb7,
+	0x9d, 0xfb, 0x4b, 0xf9, 0x02, 0xa3, 0x55, 0x19, 0xdc, 0xcf, 0x8c, 0xb4, 0xbe, 0xd8, 0x1a, 0xf8,
+	0x44, 0x9b, 0xcb, 0x3b, 0x1d, 0xad, 0xc5, 0xb7, 0x79, 0x4d, 0x08, 0x2d, 0xa5, 0x8f, 0x64, 0x57,
+	0x2e, 0xa3, 0xf5, 0xd7, 0x65, 0x57, 0x9a, 0xe1, 0xf7, 0x00, 0xa8, 0x31, 0x45, 0xde, 0x14, 0xef,
+	0x16, 0x33, 0x71, 0xf7, 0xde, 0x91, 0x54, 0x87, 0x7d, 0x17, 0x7a, 0xe1, 0x35, 0x80, 0x7a, 0x66,
+	0xab, 0xaa, 0x61, 0x49, 0x12, 0xdf, 0x93, 0xc4, 0x2f, 0x4c, 0xc4, 0x6f, 0x87, 0x5c, 0x9a, 0x3c,
+	0x81, 0x05, 0xaf, 0xc1, 0x8a, 0x9e, 0xc3, 0x22, 0x25, 0x82, 0xe2, 0xab, 0xb0, 0x3a, 0x62, 0xd2,
+	0x9f, 0x18, 0x87, 0xc1, 0x0b, 0x90, 0xd3, 0x8b, 0x47, 0xbe, 0xa8, 0x94, 0xf7, 0xe3, 0x9f, 0xcb,
+	0xea, 0x40, 0xaf, 0xb9, 0x43, 0x08, 0x78, 0x05, 0x96, 0xfa, 0xed, 0xd4, 0xe5, 0x4d, 0x49, 0xcd,
+	0xc7, 0xc7, 0xe4, 0x7c, 0xc8, 0xd2, 0x7f, 0x87, 0x47, 0x40, 0xea, 0xc1, 0x12, 0x53, 0x0f, 0xfa,
+	0x20, 0xc9, 0x83, 0x25, 0x94, 0x96, 0x76, 0x40, 0x17, 0xc0, 0xc8, 0x1c, 0x62, 0xc9, 0x31, 0x24,
+	0x40, 0x1f, 0xca, 0xad, 0xed, 0x98, 0x38, 0x17, 0xa3, 0xae, 0x7e, 0xc6, 0xc6, 0x71, 0xf0, 0x17,
+	0xb0, 0xac, 0x83, 0x21, 0x93, 0x2c, 0xae, 0x27, 0xf4, 0x51, 0x31, 0x9d, 0x30, 0x61, 0xa1, 0xc7,
+	0x1a, 0xa3, 0xfc, 0x38, 0x33, 0x97, 0x5e, 0x7e, 0x73, 0xf0, 0xdb, 0xe3, 0x73, 0x21, 0xfd, 0xf4,
+	0x5c, 0x48, 0xff, 0xf3, 0x5c, 0x48, 0xff, 0xf9, 0x52, 0x48, 0x3d, 0xbd, 0x14, 0x52, 0x7f, 0xbd,
+	0x14, 0x52, 0x57, 0x87, 0x2d, 0x8f, 0xdf, 0x74, 0xeb, 0x65, 0x97, 0xb6, 0x2b, 0xea, 0x4b, 0x4d,
+	0x8f, 0x54, 0x08, 0xad, 0xfb, 0x78, 0x67, 0x6c, 0xbc, 0xba, 0x1f, 0x9f, 0xb8, 0xf8, 0x43, 0x07,
+	0x07, 0xf5, 0x59, 0x39, 0x59, 0xed, 0xff, 0x37, 0x00, 0xff, 0xdf, 0x6f, 0x4e, 0xef, 0x0c, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,9 +377,9 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintingDenom != nil {
+	if m.ChannelAllowlist != nil {
 		{
-			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChannelAllowlist.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -203,12 +387,14 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	if len(m.MinterControllerList) > 0 {
-		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.QuarantinedRefunds) > 0 {
+		for iNdEx := len(m.QuarantinedRefunds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.QuarantinedRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -216,12 +402,14 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if m.Owner != nil {
+	if m.Params != nil {
 		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -229,11 +417,93 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.Blacklister != nil {
+	if len(m.PendingOutflows) > 0 {
+		for iNdEx := len(m.PendingOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.ChannelFlows) > 0 {
+		for iNdEx := len(m.ChannelFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.AllowedChannelList) > 0 {
+		for iNdEx := len(m.AllowedChannelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.FrozenList) > 0 {
+		for iNdEx := len(m.FrozenList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.PendingWiper != nil {
 		{
-			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PendingWiper.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -241,11 +511,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.Pauser != nil {
+	if m.Wiper != nil {
 		{
-			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Wiper.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -253,12 +525,28 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if len(m.MintersList) > 0 {
-		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+	if m.MaxSupply != nil {
+		{
+			size, err := m.MaxSupply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MintWindows) > 0 {
+		for iNdEx := len(m.MintWindows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MintWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -266,150 +554,1016 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if m.MasterMinter != nil {
+	if len(m.MintRateLimits) > 0 {
+		for iNdEx := len(m.MintRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MinterControllerLinks) > 0 {
+		for iNdEx := len(m.MinterControllerLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterControllerLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PendingOwner != nil {
 		{
-			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PendingOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.PendingBlacklister != nil {
+		{
+			size, err := m.PendingBlacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PendingPauser != nil {
+		{
+			size, err := m.PendingPauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PendingMasterMinter != nil {
+		{
+			size, err := m.PendingMasterMinter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Paused != nil {
-		{
-			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FiatTokens) > 0 {
+		for iNdEx := len(m.FiatTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FiatTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MintingDenom != nil {
+		{
+			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MinterControllerList) > 0 {
+		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Blacklister != nil {
+		{
+			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pauser != nil {
+		{
+			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MintersList) > 0 {
+		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MasterMinter != nil {
+		{
+			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused != nil {
+		{
+			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlacklistedList) > 0 {
+		for _, e := range m.BlacklistedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused != nil {
+		l = m.Paused.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MasterMinter != nil {
+		l = m.MasterMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintersList) > 0 {
+		for _, e := range m.MintersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Pauser != nil {
+		l = m.Pauser.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Blacklister != nil {
+		l = m.Blacklister.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterControllerList) > 0 {
+		for _, e := range m.MinterControllerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintingDenom != nil {
+		l = m.MintingDenom.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FiatTokens) > 0 {
+		for _, e := range m.FiatTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingMasterMinter != nil {
+		l = m.PendingMasterMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingPauser != nil {
+		l = m.PendingPauser.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingBlacklister != nil {
+		l = m.PendingBlacklister.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingOwner != nil {
+		l = m.PendingOwner.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterControllerLinks) > 0 {
+		for _, e := range m.MinterControllerLinks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRateLimits) > 0 {
+		for _, e := range m.MintRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintWindows) > 0 {
+		for _, e := range m.MintWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.Wiper != nil {
+		l = m.Wiper.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingWiper != nil {
+		l = m.PendingWiper.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FrozenList) > 0 {
+		for _, e := range m.FrozenList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedChannelList) > 0 {
+		for _, e := range m.AllowedChannelList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelFlows) > 0 {
+		for _, e := range m.ChannelFlows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOutflows) > 0 {
+		for _, e := range m.PendingOutflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.QuarantinedRefunds) > 0 {
+		for _, e := range m.QuarantinedRefunds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChannelAllowlist != nil {
+		l = m.ChannelAllowlist.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
+			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Paused == nil {
+				m.Paused = &Paused{}
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MasterMinter == nil {
+				m.MasterMinter = &MasterMinter{}
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintersList = append(m.MintersList, Minters{})
+			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pauser == nil {
+				m.Pauser = &Pauser{}
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blacklister == nil {
+				m.Blacklister = &Blacklister{}
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &Owner{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
+			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintingDenom == nil {
+				m.MintingDenom = &MintingDenom{}
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiatTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FiatTokens = append(m.FiatTokens, GenesisState{})
+			if err := m.FiatTokens[len(m.FiatTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingMasterMinter == nil {
+				m.PendingMasterMinter = &PendingRole{}
+			}
+			if err := m.PendingMasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingPauser == nil {
+				m.PendingPauser = &PendingRole{}
+			}
+			if err := m.PendingPauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBlacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingBlacklister == nil {
+				m.PendingBlacklister = &PendingRole{}
+			}
+			if err := m.PendingBlacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingOwner == nil {
+				m.PendingOwner = &PendingRole{}
+			}
+			if err := m.PendingOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerLinks = append(m.MinterControllerLinks, MinterController{})
+			if err := m.MinterControllerLinks[len(m.MinterControllerLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlacklistedList) > 0 {
-		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			m.MintRateLimits = append(m.MintRateLimits, MintRateLimit{})
+			if err := m.MintRateLimits[len(m.MintRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BlacklistedList) > 0 {
-		for _, e := range m.BlacklistedList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Paused != nil {
-		l = m.Paused.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.MasterMinter != nil {
-		l = m.MasterMinter.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MintersList) > 0 {
-		for _, e := range m.MintersList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Pauser != nil {
-		l = m.Pauser.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Blacklister != nil {
-		l = m.Blacklister.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MinterControllerList) > 0 {
-		for _, e := range m.MinterControllerList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.MintingDenom != nil {
-		l = m.MintingDenom.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MintWindows = append(m.MintWindows, MintWindow{})
+			if err := m.MintWindows[len(m.MintWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -436,14 +1590,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
-			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxSupply == nil {
+				m.MaxSupply = &MaxSupply{}
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wiper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -470,16 +1626,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Paused == nil {
-				m.Paused = &Paused{}
+			if m.Wiper == nil {
+				m.Wiper = &Wiper{}
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Wiper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWiper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -506,16 +1662,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterMinter == nil {
-				m.MasterMinter = &MasterMinter{}
+			if m.PendingWiper == nil {
+				m.PendingWiper = &PendingRole{}
 			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingWiper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -542,14 +1698,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintersList = append(m.MintersList, Minters{})
-			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FrozenList = append(m.FrozenList, Frozen{})
+			if err := m.FrozenList[len(m.FrozenList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannelList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -576,16 +1732,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pauser == nil {
-				m.Pauser = &Pauser{}
+			m.AllowedChannelList = append(m.AllowedChannelList, AllowedChannel{})
+			if err := m.AllowedChannelList[len(m.AllowedChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFlows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -612,16 +1800,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blacklister == nil {
-				m.Blacklister = &Blacklister{}
+			m.ChannelFlows = append(m.ChannelFlows, ChannelFlow{})
+			if err := m.ChannelFlows[len(m.ChannelFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOutflows = append(m.PendingOutflows, PendingOutflow{})
+			if err := m.PendingOutflows[len(m.PendingOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -648,16 +1868,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &Owner{}
+			if m.Params == nil {
+				m.Params = &Params{}
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRefunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -684,14 +1904,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
-			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuarantinedRefunds = append(m.QuarantinedRefunds, QuarantinedRefund{})
+			if err := m.QuarantinedRefunds[len(m.QuarantinedRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -718,10 +1938,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MintingDenom == nil {
-				m.MintingDenom = &MintingDenom{}
+			if m.ChannelAllowlist == nil {
+				m.ChannelAllowlist = &ChannelAllowlist{}
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChannelAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
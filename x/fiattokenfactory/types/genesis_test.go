@@ -37,8 +37,19 @@ func createValidGenesis() *types.GenesisState {
 				AddressBz: sample.AddressBz(),
 			},
 		},
+		FrozenList: []types.Frozen{
+			{
+				AddressBz: sample.AddressBz(),
+			},
+		},
+		AllowedChannelList: []types.AllowedChannel{
+			{PortId: "transfer", ChannelId: "channel-0"},
+		},
 		Paused: &types.Paused{
-			Paused: true,
+			Mint:     true,
+			Burn:     true,
+			Transfer: true,
+			Ibc:      true,
 		},
 		MasterMinter: &types.MasterMinter{
 			Address: sample.AccAddress(),
@@ -78,6 +89,17 @@ func createValidGenesis() *types.GenesisState {
 	}
 }
 
+func createValidFiatTokenGenesis(denom string) *types.GenesisState {
+	genesis := createValidGenesis()
+	genesis.MintingDenom = &types.MintingDenom{
+		Denom: denom,
+	}
+	for i := range genesis.MintersList {
+		genesis.MintersList[i].Allowance.Denom = denom
+	}
+	return genesis
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	for _, tc := range []struct {
 		desc     string
@@ -268,111 +290,913 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid: false,
 			error: "minter controller has invalid controller address",
 		},
-		// {
-		// 	desc: "owner address is not provided",
-		// 	genState: func() *types.GenesisState {
-		// 		genesis := createValidGenesis()
-		// 		genesis.Owner = nil
-		// 		return genesis
-		// 	},
-		// 	valid: false,
-		// 	error: "owner address must be provided",
-		// },
 		{
-			desc: "owner address is invalid",
+			desc: "minter exceeds max controllers",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				genesis.Owner = &types.Owner{
-					Address: "not an address",
+				params := types.DefaultParams()
+				params.MaxControllersPerMinter = 1
+				genesis.Params = &params
+				minter := sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					}, {
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					},
 				}
 				return genesis
 			},
 			valid: false,
-			error: "invalid owner address",
+			error: "cannot have more than 1 controllers",
 		},
 		{
-			desc: "master minter address is invalid",
+			desc: "minter within raised max controllers",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				genesis.MasterMinter = &types.MasterMinter{
-					Address: "not an address",
+				params := types.DefaultParams()
+				params.MaxControllersPerMinter = 2
+				genesis.Params = &params
+				minter := sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					}, {
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "linked minter exceeds max controllers",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				params := types.DefaultParams()
+				params.MaxControllersPerMinter = 1
+				genesis.Params = &params
+				minter, controller, otherController := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     minter,
+					}, {
+						Controller: otherController,
+						Minter:     sample.AccAddress(),
+					},
+				}
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     minter,
+					}, {
+						Controller: otherController,
+						Minter:     minter,
+					},
 				}
 				return genesis
 			},
 			valid: false,
-			error: "invalid master minter address",
+			error: "cannot have more than 1 controllers",
 		},
 		{
-			desc: "pauser address is invalid",
+			desc: "minter linked to its primary controller within max controllers",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				genesis.Pauser = &types.Pauser{
-					Address: "not an address",
+				params := types.DefaultParams()
+				params.MaxControllersPerMinter = 1
+				genesis.Params = &params
+				minterController := types.MinterController{
+					Controller: sample.AccAddress(),
+					Minter:     sample.AccAddress(),
+				}
+				genesis.MinterControllerList = []types.MinterController{minterController}
+				genesis.MinterControllerLinks = []types.MinterController{minterController}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "minter controller link controller cannot be encoded",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: "controller\x00",
+						Minter:     sample.AccAddress(),
+					},
 				}
 				return genesis
 			},
 			valid: false,
-			error: "invalid pauser address",
+			error: "minter controller link has invalid controller address",
 		},
-		// {
-		// 	desc: "pause state is not provided",
-		// 	genState: func() *types.GenesisState {
-		// 		genesis := createValidGenesis()
-		// 		genesis.Paused = nil
-		// 		return genesis
-		// 	},
-		// 	valid: false,
-		// 	error: "paused state must be provided",
-		// },
 		{
-			desc: "blacklister address is invalid",
+			desc: "fiat token minter exceeds max controllers",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				genesis.Blacklister = &types.Blacklister{
-					Address: "not an address",
+				params := types.DefaultParams()
+				params.MaxControllersPerMinter = 1
+				genesis.Params = &params
+				fiatToken := createValidFiatTokenGenesis("ueurc")
+				minter := sample.AccAddress()
+				fiatToken.MinterControllerList = []types.MinterController{
+					{
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					}, {
+						Controller: sample.AccAddress(),
+						Minter:     minter,
+					},
 				}
+				genesis.FiatTokens = []types.GenesisState{*fiatToken}
 				return genesis
 			},
 			valid: false,
-			error: "invalid black lister address",
+			error: "cannot have more than 1 controllers",
 		},
 		{
-			desc: "insufficient separation of privileges",
+			desc: "minter controller links",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				address := sample.AccAddress()
-				genesis.Owner = &types.Owner{
-					Address: address,
+				controller := sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     sample.AccAddress(),
+					},
 				}
-				genesis.MasterMinter = &types.MasterMinter{
-					Address: address,
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     sample.AccAddress(),
+					}, {
+						Controller: controller,
+						Minter:     sample.AccAddress(),
+					},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated minter controller links",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				controller, minter := sample.AccAddress(), sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     sample.AccAddress(),
+					},
+				}
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     minter,
+					}, {
+						Controller: controller,
+						Minter:     minter,
+					},
 				}
 				return genesis
 			},
 			valid: false,
-			error: "address is already assigned to privileged role",
+			error: "duplicated index for minterControllerLink",
 		},
-		// {
-		// 	desc: "minting denom is not provided",
-		// 	genState: func() *types.GenesisState {
-		// 		genesis := createValidGenesis()
-		// 		genesis.MintingDenom = nil
-		// 		return genesis
-		// 	},
-		// 	valid: false,
-		// 	error: "minting denom must be provided",
-		// },
 		{
-			desc: "minting denom is empty",
+			desc: "minter controller link has invalid minter address",
 			genState: func() *types.GenesisState {
 				genesis := createValidGenesis()
-				genesis.MintingDenom = &types.MintingDenom{
-					Denom: "",
+				controller := sample.AccAddress()
+				genesis.MinterControllerList = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     sample.AccAddress(),
+					},
+				}
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: controller,
+						Minter:     "not an address",
+					},
 				}
 				return genesis
 			},
 			valid: false,
-			error: "minting denom cannot be an empty string",
+			error: "minter controller link has invalid minter address",
+		},
+		{
+			desc: "orphaned minter controller link",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MinterControllerLinks = []types.MinterController{
+					{
+						Controller: sample.AccAddress(),
+						Minter:     sample.AccAddress(),
+					},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "references unknown controller",
+		},
+		{
+			desc: "mint rate limits",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				minter := sample.AccAddress()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: minter, Limit: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				genesis.MintWindows = []types.MintWindow{
+					{Minter: minter, Buckets: []types.MintBucket{{Start: 5, Minted: math.NewInt(50)}}},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "mint window has negative amount",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				minter := sample.AccAddress()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: minter, Limit: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				genesis.MintWindows = []types.MintWindow{
+					{Minter: minter, Buckets: []types.MintBucket{{Start: 5, Minted: math.NewInt(-1)}}},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "mint window amount cannot be nil or negative",
+		},
+		{
+			desc: "duplicated mint rate limits",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				minter := sample.AccAddress()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: minter, Limit: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+					{Minter: minter, Limit: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_SECONDS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for mintRateLimit",
+		},
+		{
+			desc: "mint rate limit has zero window",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: sample.AccAddress(), Limit: math.NewInt(100), Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "mint rate limit window cannot be zero",
+		},
+		{
+			desc: "mint rate limit window overflows",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: sample.AccAddress(), Limit: math.NewInt(100), Window: types.MaxWindow + 1, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "window cannot be longer than",
+		},
+		{
+			desc: "mint rate limit has unspecified unit",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintRateLimits = []types.MintRateLimit{
+					{Minter: sample.AccAddress(), Limit: math.NewInt(100), Window: 10},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid window unit",
+		},
+		{
+			desc: "mint window without rate limit",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintWindows = []types.MintWindow{
+					{Minter: sample.AccAddress(), Buckets: []types.MintBucket{{Minted: math.NewInt(1)}}},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "has no mint rate limit",
+		},
+		{
+			desc: "channel rate limits",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					{PortId: "transfer", ChannelId: "channel-0", MaxInflow: math.NewInt(100), MaxOutflow: math.ZeroInt(), MaxOutflowBps: 500, Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				genesis.ChannelFlows = []types.ChannelFlow{
+					{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Start: 5, Inflow: math.NewInt(50), Outflow: math.NewInt(1)}}},
+				}
+				genesis.PendingOutflows = []types.PendingOutflow{
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, BucketStart: 5},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated channel rate limits",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					{PortId: "transfer", ChannelId: "channel-0", MaxInflow: math.NewInt(100), MaxOutflow: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+					{PortId: "transfer", ChannelId: "channel-0", MaxInflow: math.NewInt(100), MaxOutflow: math.NewInt(100), Window: 10, Unit: types.WINDOW_UNIT_SECONDS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for channelRateLimit",
+		},
+		{
+			desc: "channel rate limit has zero window",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					{PortId: "transfer", ChannelId: "channel-0", MaxInflow: math.NewInt(100), MaxOutflow: math.NewInt(100), Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "channel rate limit window cannot be zero",
+		},
+		{
+			desc: "channel rate limit exceeds the supply",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					{PortId: "transfer", ChannelId: "channel-0", MaxInflow: math.ZeroInt(), MaxOutflow: math.ZeroInt(), MaxInflowBps: types.MaxBps + 1, Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "basis points of the supply",
+		},
+		{
+			desc: "channel flow without rate limit",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelFlows = []types.ChannelFlow{
+					{PortId: "transfer", ChannelId: "channel-0", Buckets: []types.FlowBucket{{Inflow: math.NewInt(1), Outflow: math.ZeroInt()}}},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "has no channel rate limit",
+		},
+		{
+			desc: "duplicated pending outflows",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingOutflows = []types.PendingOutflow{
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1},
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, BucketStart: 5},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for pendingOutflow",
+		},
+		{
+			desc: "channel rate limit port id cannot be encoded",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					{PortId: "trans\x00fer", ChannelId: "channel-0", MaxInflow: math.ZeroInt(), MaxOutflow: math.ZeroInt(), Window: 10, Unit: types.WINDOW_UNIT_BLOCKS},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "channel rate limit has invalid port id",
+		},
+		{
+			desc: "channel flow port id cannot be encoded",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.ChannelFlows = []types.ChannelFlow{
+					{PortId: "trans\x00fer", ChannelId: "channel-0"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "channel flow has invalid port id",
+		},
+		{
+			desc: "pending outflow port id cannot be encoded",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingOutflows = []types.PendingOutflow{
+					{PortId: "trans\x00fer", ChannelId: "channel-0", Sequence: 1},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "pending outflow has invalid port id",
+		},
+		{
+			desc: "max supply",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MaxSupply = &types.MaxSupply{Amount: math.NewInt(1)}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "max supply is zero",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MaxSupply = &types.MaxSupply{Amount: math.ZeroInt()}
+				return genesis
+			},
+			valid: false,
+			error: "max supply must be positive",
+		},
+		{
+			desc: "quarantined refunds",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.QuarantinedRefunds = []types.QuarantinedRefund{{Address: sample.AccAddress(), Amount: math.NewInt(1)}}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated quarantined refunds",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				address := sample.AccAddress()
+				genesis.QuarantinedRefunds = []types.QuarantinedRefund{
+					{Address: address, Amount: math.NewInt(1)},
+					{Address: address, Amount: math.NewInt(2)},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for quarantinedRefund",
+		},
+		{
+			desc: "quarantined refund amount is zero",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.QuarantinedRefunds = []types.QuarantinedRefund{{Address: sample.AccAddress(), Amount: math.ZeroInt()}}
+				return genesis
+			},
+			valid: false,
+			error: "quarantined refund amount must be positive",
+		},
+		{
+			desc: "pause expiries",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Paused.Expiries = []types.PauseExpiry{{Scope: types.PAUSE_SCOPE_MINT, Height: 10}}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "pause expiry for a scope that is not paused",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Paused = &types.Paused{Expiries: []types.PauseExpiry{{Scope: types.PAUSE_SCOPE_MINT, Height: 10}}}
+				return genesis
+			},
+			valid: false,
+			error: "has an expiry but is not paused",
+		},
+		{
+			desc: "duplicated pause expiries",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Paused.Expiries = []types.PauseExpiry{
+					{Scope: types.PAUSE_SCOPE_MINT, Height: 10},
+					{Scope: types.PAUSE_SCOPE_MINT, Time: 10},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicate expiry for pause scope",
+		},
+		{
+			desc: "blacklisted entry with audit fields",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.BlacklistedList = []types.Blacklisted{
+					{AddressBz: sample.AddressBz(), Height: 10, Blacklister: sample.AccAddress(), Reason: "case 1234"},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "blacklisted entry has invalid blacklister",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.BlacklistedList = []types.Blacklisted{
+					{AddressBz: sample.AddressBz(), Blacklister: "invalid"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "blacklisted entry has invalid blacklister address",
+		},
+		{
+			desc: "duplicated frozen entries",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				addressBz := sample.AddressBz()
+				genesis.FrozenList = []types.Frozen{
+					{
+						AddressBz: addressBz,
+					}, {
+						AddressBz: addressBz,
+					},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for frozen",
+		},
+		{
+			desc: "frozen entry has invalid blacklister",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.FrozenList = []types.Frozen{
+					{AddressBz: sample.AddressBz(), Blacklister: "invalid"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "frozen entry has invalid blacklister address",
+		},
+		{
+			desc: "duplicated allowed channel entries",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.AllowedChannelList = []types.AllowedChannel{
+					{PortId: "transfer", ChannelId: "channel-0"},
+					{PortId: "transfer", ChannelId: "channel-0"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for allowed channel",
+		},
+		{
+			desc: "allowed channel has invalid channel id",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.AllowedChannelList = []types.AllowedChannel{
+					{PortId: "transfer", ChannelId: ""},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "allowed channel has invalid channel id",
+		},
+		{
+			desc: "allowed channel port id cannot be encoded",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.AllowedChannelList = []types.AllowedChannel{
+					{PortId: "trans\x00fer", ChannelId: "channel-0"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "allowed channel has invalid port id",
+		},
+		// {
+		// 	desc: "owner address is not provided",
+		// 	genState: func() *types.GenesisState {
+		// 		genesis := createValidGenesis()
+		// 		genesis.Owner = nil
+		// 		return genesis
+		// 	},
+		// 	valid: false,
+		// 	error: "owner address must be provided",
+		// },
+		{
+			desc: "owner address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Owner = &types.Owner{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid owner address",
+		},
+		{
+			desc: "master minter address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MasterMinter = &types.MasterMinter{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid master minter address",
+		},
+		{
+			desc: "pauser address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Pauser = &types.Pauser{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pauser address",
+		},
+		{
+			desc: "pending pauser address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingPauser = &types.PendingRole{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pending pauser address",
+		},
+		{
+			desc: "pending owner address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingOwner = &types.PendingRole{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pending owner address",
+		},
+		{
+			desc: "pending master minter activation height is negative",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingMasterMinter = &types.PendingRole{
+					Address:          sample.AccAddress(),
+					ActivationHeight: -1,
+				}
+				return genesis
+			},
+			valid: false,
+			error: "pending master minter activation height cannot be negative",
+		},
+		// {
+		// 	desc: "pause state is not provided",
+		// 	genState: func() *types.GenesisState {
+		// 		genesis := createValidGenesis()
+		// 		genesis.Paused = nil
+		// 		return genesis
+		// 	},
+		// 	valid: false,
+		// 	error: "paused state must be provided",
+		// },
+		{
+			desc: "blacklister address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Blacklister = &types.Blacklister{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid black lister address",
+		},
+		{
+			desc: "wiper address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Wiper = &types.Wiper{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid wiper address",
+		},
+		{
+			desc: "pending wiper address is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PendingWiper = &types.PendingRole{
+					Address: "not an address",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pending wiper address",
+		},
+		{
+			desc: "wiper shares an address with the blacklister",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Wiper = &types.Wiper{
+					Address: genesis.Blacklister.Address,
+				}
+				return genesis
+			},
+			valid: false,
+			error: "address is already assigned to privileged role",
+		},
+		{
+			desc: "insufficient separation of privileges",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				address := sample.AccAddress()
+				genesis.Owner = &types.Owner{
+					Address: address,
+				}
+				genesis.MasterMinter = &types.MasterMinter{
+					Address: address,
+				}
+				return genesis
+			},
+			valid: false,
+			error: "address is already assigned to privileged role",
+		},
+		// {
+		// 	desc: "minting denom is not provided",
+		// 	genState: func() *types.GenesisState {
+		// 		genesis := createValidGenesis()
+		// 		genesis.MintingDenom = nil
+		// 		return genesis
+		// 	},
+		// 	valid: false,
+		// 	error: "minting denom must be provided",
+		// },
+		{
+			desc: "minting denom is empty",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintingDenom = &types.MintingDenom{
+					Denom: "",
+				}
+				return genesis
+			},
+			valid: false,
+			error: "minting denom cannot be an empty string",
+		},
+		{
+			desc: "fiat token happy path",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.FiatTokens = []types.GenesisState{*createValidFiatTokenGenesis("ueurc")}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "fiat token minting denom is not provided",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				fiatToken := createValidFiatTokenGenesis("ueurc")
+				fiatToken.MintingDenom = nil
+				genesis.FiatTokens = []types.GenesisState{*fiatToken}
+				return genesis
+			},
+			valid: false,
+			error: "fiat token minting denom cannot be empty",
+		},
+		{
+			desc: "fiat token uses the minting denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.FiatTokens = []types.GenesisState{*createValidFiatTokenGenesis("uusdc")}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for fiat token uusdc",
+		},
+		{
+			desc: "duplicated fiat token entries",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.FiatTokens = []types.GenesisState{
+					*createValidFiatTokenGenesis("ueurc"),
+					*createValidFiatTokenGenesis("ueurc"),
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for fiat token ueurc",
+		},
+		{
+			desc: "params",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.Params = &types.Params{
+					MaxBatchSize:            10,
+					MaxMinterAllowance:      math.NewInt(1_000_000),
+					RequireChannelAllowlist: true,
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "params with zero max batch size",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				params := types.DefaultParams()
+				params.MaxBatchSize = 0
+				genesis.Params = &params
+				return genesis
+			},
+			valid: false,
+			error: "max batch size must be between 1 and 500",
+		},
+		{
+			desc: "params with max batch size above the limit",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				params := types.DefaultParams()
+				params.MaxBatchSize = types.MaxBatchSize + 1
+				genesis.Params = &params
+				return genesis
+			},
+			valid: false,
+			error: "max batch size must be between 1 and 500",
+		},
+		{
+			desc: "params with negative max minter allowance",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				params := types.DefaultParams()
+				params.MaxMinterAllowance = math.NewInt(-1)
+				genesis.Params = &params
+				return genesis
+			},
+			valid: false,
+			error: "max minter allowance cannot be nil or negative",
+		},
+		{
+			desc: "fiat token with params",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				fiatToken := createValidFiatTokenGenesis("ueurc")
+				params := types.DefaultParams()
+				fiatToken.Params = &params
+				genesis.FiatTokens = []types.GenesisState{*fiatToken}
+				return genesis
+			},
+			valid: false,
+			error: "fiat token ueurc cannot contain params",
+		},
+		{
+			desc: "nested fiat tokens",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				fiatToken := createValidFiatTokenGenesis("ueurc")
+				fiatToken.FiatTokens = []types.GenesisState{*createValidFiatTokenGenesis("ujpyc")}
+				genesis.FiatTokens = []types.GenesisState{*fiatToken}
+				return genesis
+			},
+			valid: false,
+			error: "fiat token ueurc cannot contain nested fiat tokens",
+		},
+		{
+			desc: "fiat token state is invalid",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				fiatToken := createValidFiatTokenGenesis("ueurc")
+				fiatToken.Owner = &types.Owner{
+					Address: "not an address",
+				}
+				genesis.FiatTokens = []types.GenesisState{*fiatToken}
+				return genesis
+			},
+			valid: false,
+			error: "invalid fiat token ueurc",
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -16,6 +16,11 @@
 
 package types
 
+import (
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "fiat-tokenfactory"
@@ -29,38 +34,143 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_" + StoreKey
 
-	PausedKey                 = "Paused/value/"
-	MasterMinterKey           = "MasterMinter/value/"
-	PauserKey                 = "Pauser/value/"
-	BlacklisterKey            = "Blacklister/value/"
-	OwnerKey                  = "Owner/value/"
-	PendingOwnerKey           = "PendingOwner/value/"
-	BlacklistedKeyPrefix      = "Blacklisted/value/"
-	MintersKeyPrefix          = "Minters/value/"
-	MinterControllerKeyPrefix = "MinterController/value/"
-
 	GranteeKey = "SendRestrictionGrantees"
+	// WipeKey holds the blacklisted address whose balance is being wiped into the module account
+	WipeKey = "SendRestrictionWipe"
 )
 
-func KeyPrefix(p string) []byte {
-	return []byte(p)
-}
+// Store prefixes of the collections of the keeper. Keys below a prefix are
+// encoded with the collections key codecs, see the key functions below.
+var (
+	PausedKey              = collections.NewPrefix(1)
+	MasterMinterKey        = collections.NewPrefix(2)
+	PauserKey              = collections.NewPrefix(3)
+	BlacklisterKey         = collections.NewPrefix(4)
+	OwnerKey               = collections.NewPrefix(5)
+	PendingOwnerKey        = collections.NewPrefix(6)
+	PendingMasterMinterKey = collections.NewPrefix(7)
+	PendingPauserKey       = collections.NewPrefix(8)
+	PendingBlacklisterKey  = collections.NewPrefix(9)
+	WiperKey               = collections.NewPrefix(10)
+	PendingWiperKey        = collections.NewPrefix(11)
+	MaxSupplyKey           = collections.NewPrefix(12)
+	MintingDenomKey        = collections.NewPrefix(13)
+	ParamsKey              = collections.NewPrefix(14)
+	ChannelAllowlistKey    = collections.NewPrefix(15)
+
+	BlacklistedKeyPrefix       = collections.NewPrefix(16)
+	FrozenKeyPrefix            = collections.NewPrefix(17)
+	AllowedChannelKeyPrefix    = collections.NewPrefix(18)
+	ChannelRateLimitKeyPrefix  = collections.NewPrefix(19)
+	ChannelFlowKeyPrefix       = collections.NewPrefix(20)
+	PendingOutflowKeyPrefix    = collections.NewPrefix(21)
+	MintersKeyPrefix           = collections.NewPrefix(22)
+	MinterControllerKeyPrefix  = collections.NewPrefix(23)
+	ControllerMintersKeyPrefix = collections.NewPrefix(24)
+	MinterControllersKeyPrefix = collections.NewPrefix(25)
+	MintRateLimitKeyPrefix     = collections.NewPrefix(26)
+	MintWindowKeyPrefix        = collections.NewPrefix(27)
+	QuarantinedRefundKeyPrefix = collections.NewPrefix(28)
+
+	FiatTokenKeyPrefix      = collections.NewPrefix(32)
+	FiatTokenStoreKeyPrefix = collections.NewPrefix(33)
+)
 
 // BlacklistedKey returns the store key to retrieve a Blacklisted from the index fields
 func BlacklistedKey(addressBz []byte) []byte {
-	return append(addressBz, []byte("/")...)
+	return encodeKey(collections.BytesKey, addressBz)
+}
+
+// FrozenKey returns the store key to retrieve a Frozen from the index fields
+func FrozenKey(addressBz []byte) []byte {
+	return encodeKey(collections.BytesKey, addressBz)
+}
+
+// AllowedChannelKey returns the store key to retrieve an AllowedChannel from the index fields
+func AllowedChannelKey(portID, channelID string) []byte {
+	return ChannelRateLimitKey(portID, channelID)
+}
+
+// ChannelRateLimitKey returns the store key to retrieve a ChannelRateLimit or ChannelFlow from the index fields
+func ChannelRateLimitKey(portID, channelID string) []byte {
+	return encodeKey(
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		collections.Join(portID, channelID),
+	)
+}
+
+// PendingOutflowKey returns the store key to retrieve a PendingOutflow from the index fields
+func PendingOutflowKey(portID, channelID string, sequence uint64) []byte {
+	return encodeKey(
+		collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+		collections.Join3(portID, channelID, sequence),
+	)
 }
 
 // MintersKey returns the store key to retrieve a Minters from the index fields
 func MintersKey(address string) []byte {
-	return append([]byte(address), []byte("/")...)
+	return encodeKey(collections.StringKey, address)
 }
 
 // MinterControllerKey returns the store key to retrieve a MinterController from the index fields
 func MinterControllerKey(controllerAddress string) []byte {
-	return append([]byte(controllerAddress), []byte("/")...)
+	return encodeKey(collections.StringKey, controllerAddress)
 }
 
-const (
-	MintingDenomKey = "MintingDenom/value/"
-)
+// ControllerMintersKey returns the store key of a link from a controller to one of its minters
+func ControllerMintersKey(controllerAddress string, minterAddress string) []byte {
+	return encodeKey(
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		collections.Join(controllerAddress, minterAddress),
+	)
+}
+
+// ControllerMintersPrefix returns the store prefix under which all minters of a controller are linked
+func ControllerMintersPrefix(controllerAddress string) []byte {
+	return encodeNonTerminalKey(collections.StringKey, controllerAddress)
+}
+
+// MinterControllersKey returns the store key of a link from a minter to one of its controllers
+func MinterControllersKey(minterAddress string, controllerAddress string) []byte {
+	return encodeKey(
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		collections.Join(minterAddress, controllerAddress),
+	)
+}
+
+// MinterControllersPrefix returns the store prefix under which all controllers of a minter are linked
+func MinterControllersPrefix(minterAddress string) []byte {
+	return encodeNonTerminalKey(collections.StringKey, minterAddress)
+}
+
+func FiatTokenKey(denom string) []byte {
+	return encodeKey(collections.StringKey, denom)
+}
+
+// FiatTokenStoreKey returns the prefix under which all state of a registered
+// fiat token is stored. The denom is length-prefixed so that no token's
+// prefix is a prefix of another's.
+func FiatTokenStoreKey(denom string) []byte {
+	key := append([]byte{}, FiatTokenStoreKeyPrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, []byte(denom)...)
+}
+
+// encodeKey encodes a key as the last part of a store key. Keys are validated
+// before they reach the store, so an encoding error is a programming error.
+func encodeKey[K any](kc collcodec.KeyCodec[K], key K) []byte {
+	bz, err := collections.EncodeKeyWithPrefix(nil, kc, key)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// encodeNonTerminalKey encodes a key as the leading part of a store key.
+func encodeNonTerminalKey[K any](kc collcodec.KeyCodec[K], key K) []byte {
+	bz := make([]byte, kc.SizeNonTerminal(key))
+	if _, err := kc.EncodeNonTerminal(bz, key); err != nil {
+		panic(err)
+	}
+	return bz
+}
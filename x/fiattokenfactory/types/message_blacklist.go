@@ -21,6 +21,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxBlacklistReasonLength is the maximum length of the reason recorded with a blacklist entry.
+const MaxBlacklistReasonLength = 256
+
 func (msg *MsgBlacklist) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
@@ -30,5 +33,9 @@ func (msg *MsgBlacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return errors.Wrap(ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+
+	if len(msg.Reason) > MaxBlacklistReasonLength {
+		return errors.Wrapf(ErrInvalidReason, "reason cannot be longer than %d characters", MaxBlacklistReasonLength)
+	}
 	return nil
 }
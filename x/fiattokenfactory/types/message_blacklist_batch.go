@@ -0,0 +1,70 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBatchSize is the maximum number of addresses in a single batch message, the
+	// max_batch_size param can only lower it.
+	MaxBatchSize = 500
+	// BatchAddressGas is the flat gas charged for every address in a batch message,
+	// on top of the gas of the store reads and writes it causes.
+	BatchAddressGas uint64 = 1_000
+)
+
+func (msg *MsgBlacklistBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if err := validateBatchAddresses(msg.Addresses); err != nil {
+		return err
+	}
+
+	if len(msg.Reason) > MaxBlacklistReasonLength {
+		return errors.Wrapf(ErrInvalidReason, "reason cannot be longer than %d characters", MaxBlacklistReasonLength)
+	}
+	return nil
+}
+
+func validateBatchAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return errors.Wrap(ErrInvalidAddress, "addresses cannot be empty")
+	}
+
+	if len(addresses) > MaxBatchSize {
+		return errors.Wrapf(ErrInvalidAddress, "cannot process more than %d addresses at once", MaxBatchSize)
+	}
+
+	seen := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		if len(address) <= 0 {
+			return errors.Wrap(ErrInvalidAddress, "address length cannot be less than or equal to 0")
+		}
+
+		if _, ok := seen[address]; ok {
+			return errors.Wrapf(ErrInvalidAddress, "duplicate address %s", address)
+		}
+		seen[address] = struct{}{}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -45,6 +46,15 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "reason too long",
+			msg: MsgBlacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Reason:  strings.Repeat("a", MaxBlacklistReasonLength+1),
+			},
+			err: ErrInvalidReason,
+		},
 		{
 			name: "happy path",
 			msg: MsgBlacklist{
@@ -52,6 +62,14 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 				Address: sample.AccAddress(),
 			},
 		},
+		{
+			name: "happy path with reason",
+			msg: MsgBlacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Reason:  "OFAC SDN list 2024-06-01",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
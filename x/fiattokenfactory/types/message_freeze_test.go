@@ -0,0 +1,84 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFreeze_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFreeze
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgFreeze{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "empty address",
+			msg: MsgFreeze{
+				From:    sample.AccAddress(),
+				Address: "",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "reason too long",
+			msg: MsgFreeze{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Reason:  strings.Repeat("a", MaxBlacklistReasonLength+1),
+			},
+			err: ErrInvalidReason,
+		},
+		{
+			name: "happy path",
+			msg: MsgFreeze{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+		},
+		{
+			name: "happy path with reason",
+			msg: MsgFreeze{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Reason:  "court order 2024-123",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
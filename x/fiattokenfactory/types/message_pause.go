@@ -26,5 +26,10 @@ func (msg *MsgPause) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	return nil
+
+	if msg.ExpiryHeight < 0 || msg.ExpiryTime < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "expiry cannot be negative")
+	}
+
+	return validatePauseScopes(msg.Scopes)
 }
@@ -36,6 +36,14 @@ func TestMsgPause_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "negative expiry",
+			msg: MsgPause{
+				From:         sample.AccAddress(),
+				ExpiryHeight: -1,
+			},
+			err: ErrInvalidExpiry,
+		},
 		{
 			name: "happy path",
 			msg: MsgPause{
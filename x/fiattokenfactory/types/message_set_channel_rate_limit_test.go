@@ -0,0 +1,159 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetChannelRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetChannelRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetChannelRateLimit{
+				From:      "invalid_address",
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid channel",
+			msg: MsgSetChannelRateLimit{
+				From:      sample.AccAddress(),
+				PortId:    "transfer",
+				ChannelId: "channel/0",
+			},
+			err: ErrInvalidChannel,
+		},
+		{
+			name: "limit is missing",
+			msg: MsgSetChannelRateLimit{
+				From:      sample.AccAddress(),
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+				Window:    10,
+				Unit:      WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "limit is negative",
+			msg: MsgSetChannelRateLimit{
+				From:       sample.AccAddress(),
+				PortId:     "transfer",
+				ChannelId:  "channel-0",
+				MaxInflow:  math.NewInt(-1),
+				MaxOutflow: math.NewInt(10),
+				Window:     10,
+				Unit:       WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "share of the supply is too large",
+			msg: MsgSetChannelRateLimit{
+				From:          sample.AccAddress(),
+				PortId:        "transfer",
+				ChannelId:     "channel-0",
+				MaxInflow:     math.ZeroInt(),
+				MaxOutflow:    math.ZeroInt(),
+				MaxOutflowBps: MaxBps + 1,
+				Window:        10,
+				Unit:          WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "window overflows",
+			msg: MsgSetChannelRateLimit{
+				From:       sample.AccAddress(),
+				PortId:     "transfer",
+				ChannelId:  "channel-0",
+				MaxInflow:  math.NewInt(10),
+				MaxOutflow: math.NewInt(10),
+				Window:     ^uint64(0),
+				Unit:       WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "window is too long",
+			msg: MsgSetChannelRateLimit{
+				From:       sample.AccAddress(),
+				PortId:     "transfer",
+				ChannelId:  "channel-0",
+				MaxInflow:  math.NewInt(10),
+				MaxOutflow: math.NewInt(10),
+				Window:     MaxWindow + 1,
+				Unit:       WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "unit is unspecified",
+			msg: MsgSetChannelRateLimit{
+				From:       sample.AccAddress(),
+				PortId:     "transfer",
+				ChannelId:  "channel-0",
+				MaxInflow:  math.NewInt(10),
+				MaxOutflow: math.NewInt(10),
+				Window:     10,
+			},
+			err: ErrInvalidType,
+		},
+		{
+			name: "removing a limit",
+			msg: MsgSetChannelRateLimit{
+				From:      sample.AccAddress(),
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+			},
+		},
+		{
+			name: "happy path",
+			msg: MsgSetChannelRateLimit{
+				From:          sample.AccAddress(),
+				PortId:        "transfer",
+				ChannelId:     "channel-0",
+				MaxInflow:     math.NewInt(10),
+				MaxOutflow:    math.ZeroInt(),
+				MaxOutflowBps: 500,
+				Window:        10,
+				Unit:          WINDOW_UNIT_SECONDS,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMintRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetMintRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetMintRateLimit{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter address",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: "invalid_address",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "limit is missing",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Window:  10,
+				Unit:    WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "limit is negative",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(-1),
+				Window:  10,
+				Unit:    WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "unit is unspecified",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(10),
+				Window:  10,
+			},
+			err: ErrInvalidType,
+		},
+		{
+			name: "window overflows",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(10),
+				Window:  ^uint64(0),
+				Unit:    WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "window is too long",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(10),
+				Window:  MaxWindow + 1,
+				Unit:    WINDOW_UNIT_BLOCKS,
+			},
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "longest window",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(10),
+				Window:  MaxWindow,
+				Unit:    WINDOW_UNIT_BLOCKS,
+			},
+		},
+		{
+			name: "removing a limit",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+		},
+		{
+			name: "happy path",
+			msg: MsgSetMintRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Limit:   math.NewInt(10),
+				Window:  10,
+				Unit:    WINDOW_UNIT_SECONDS,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -26,5 +26,6 @@ func (msg *MsgUnpause) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	return nil
+
+	return validatePauseScopes(msg.Scopes)
 }
@@ -30,5 +30,5 @@ func (msg *MsgUpdateBlacklister) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid blacklister address (%s)", err)
 	}
-	return nil
+	return validateHandoverDelay(msg.Delay)
 }
@@ -53,6 +53,23 @@ func TestMsgUpdateBlacklister_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "delay overflows the activation height",
+			msg: MsgUpdateBlacklister{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   ^uint64(0),
+			},
+			err: ErrInvalidDelay,
+		},
+		{
+			name: "longest delay",
+			msg: MsgUpdateBlacklister{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   MaxHandoverDelay,
+			},
+		},
 		{
 			name: "happy path",
 			msg: MsgUpdateBlacklister{
@@ -30,5 +30,5 @@ func (msg *MsgUpdateMasterMinter) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid master minter address (%s)", err)
 	}
-	return nil
+	return validateHandoverDelay(msg.Delay)
 }
@@ -53,6 +53,23 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "delay overflows the activation height",
+			msg: MsgUpdateMasterMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   ^uint64(0),
+			},
+			err: ErrInvalidDelay,
+		},
+		{
+			name: "longest delay",
+			msg: MsgUpdateMasterMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   MaxHandoverDelay,
+			},
+		},
 		{
 			name: "happy path",
 			msg: MsgUpdateMasterMinter{
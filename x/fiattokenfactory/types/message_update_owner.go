@@ -30,5 +30,5 @@ func (msg *MsgUpdateOwner) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
 	}
-	return nil
+	return validateHandoverDelay(msg.Delay)
 }
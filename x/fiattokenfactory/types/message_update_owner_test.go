@@ -53,6 +53,23 @@ func TestMsgUpdateOwner_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "delay overflows the activation height",
+			msg: MsgUpdateOwner{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   ^uint64(0),
+			},
+			err: ErrInvalidDelay,
+		},
+		{
+			name: "longest delay",
+			msg: MsgUpdateOwner{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Delay:   MaxHandoverDelay,
+			},
+		},
 		{
 			name: "happy path",
 			msg: MsgUpdateOwner{
@@ -30,5 +30,5 @@ func (msg *MsgUpdatePauser) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid pauser address (%s)", err)
 	}
-	return nil
+	return validateHandoverDelay(msg.Delay)
 }
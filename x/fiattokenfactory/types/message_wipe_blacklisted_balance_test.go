@@ -0,0 +1,84 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgWipeBlacklistedBalance_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name string
+		msg  types.MsgWipeBlacklistedBalance
+		err  error
+	}{
+		{
+			name: "burn",
+			msg: types.MsgWipeBlacklistedBalance{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+		},
+		{
+			name: "send to recipient",
+			msg: types.MsgWipeBlacklistedBalance{
+				From:      sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+		},
+		{
+			name: "invalid from",
+			msg: types.MsgWipeBlacklistedBalance{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: types.ErrInvalidAddress,
+		},
+		{
+			name: "empty address",
+			msg: types.MsgWipeBlacklistedBalance{
+				From: sample.AccAddress(),
+			},
+			err: types.ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			msg: types.MsgWipeBlacklistedBalance{
+				From:      sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: types.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
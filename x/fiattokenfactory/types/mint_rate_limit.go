@@ -0,0 +1,122 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+func validateMintRateLimit(limit math.Int, window uint64, unit WindowUnit) error {
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+
+	if limit.IsNil() || limit.IsNegative() {
+		return errors.Wrap(ErrInvalidCoins, "mint rate limit cannot be nil or negative")
+	}
+
+	if unit != WINDOW_UNIT_BLOCKS && unit != WINDOW_UNIT_SECONDS {
+		return errors.Wrapf(ErrInvalidType, "invalid window unit (%s)", unit)
+	}
+
+	return nil
+}
+
+// WindowBuckets is the number of buckets a rolling rate limit window is split into.
+const WindowBuckets = 10
+
+// MaxWindow is the longest a rolling rate limit window can be, so that positions
+// within and past the window cannot overflow.
+const MaxWindow = 1<<62 - 1
+
+func validateWindow(window uint64) error {
+	if window > MaxWindow {
+		return errors.Wrapf(ErrInvalidWindow, "window cannot be longer than %d", uint64(MaxWindow))
+	}
+
+	return nil
+}
+
+// BucketStart returns the start of the bucket of a rolling window that contains
+// position now. Buckets are a tenth of the window long, rounded up.
+func BucketStart(now int64, window uint64) int64 {
+	return now - now%bucketLength(window)
+}
+
+// InWindow reports whether the bucket started at start still counts towards a
+// rolling window at position now. A bucket counts until the length of the window
+// has elapsed since its last position, so an amount is never released early.
+func InWindow(start int64, now int64, window uint64) bool {
+	return start+bucketLength(window)-1 > now-int64(window)
+}
+
+// releaseAt returns the position at which the bucket started at start stops
+// counting towards a rolling window.
+func releaseAt(start int64, window uint64) int64 {
+	return start + bucketLength(window) - 1 + int64(window)
+}
+
+func bucketLength(window uint64) int64 {
+	return max(int64((window+WindowBuckets-1)/WindowBuckets), 1)
+}
+
+// Current returns the window without the buckets that no longer count towards it
+// at position now.
+func (w MintWindow) Current(now int64, window uint64) MintWindow {
+	current := MintWindow{Minter: w.Minter}
+	for _, bucket := range w.Buckets {
+		if InWindow(bucket.Start, now, window) {
+			current.Buckets = append(current.Buckets, bucket)
+		}
+	}
+
+	return current
+}
+
+// Minted returns the amount minted within the window.
+func (w MintWindow) Minted() math.Int {
+	minted := math.ZeroInt()
+	for _, bucket := range w.Buckets {
+		minted = minted.Add(bucket.Minted)
+	}
+
+	return minted
+}
+
+// Add records amount as minted at position now. An amount minted before the
+// newest bucket, which only happens when the window length changed, is added
+// to the newest bucket so that it is not released earlier.
+func (w *MintWindow) Add(now int64, window uint64, amount math.Int) {
+	start := BucketStart(now, window)
+	if n := len(w.Buckets); n > 0 && w.Buckets[n-1].Start >= start {
+		w.Buckets[n-1].Minted = w.Buckets[n-1].Minted.Add(amount)
+		return
+	}
+
+	w.Buckets = append(w.Buckets, MintBucket{Start: start, Minted: amount})
+}
+
+// NextRelease returns the position at which the oldest amount minted leaves the
+// window, or zero when nothing was minted within the window.
+func (w MintWindow) NextRelease(window uint64) int64 {
+	if len(w.Buckets) == 0 {
+		return 0
+	}
+
+	return releaseAt(w.Buckets[0].Start, window)
+}
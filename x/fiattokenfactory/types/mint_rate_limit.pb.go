@@ -0,0 +1,926 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/mint_rate_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WindowUnit is the unit a mint rate limit window is measured in.
+type WindowUnit int32
+
+const (
+	WINDOW_UNIT_UNSPECIFIED WindowUnit = 0
+	WINDOW_UNIT_BLOCKS      WindowUnit = 1
+	WINDOW_UNIT_SECONDS     WindowUnit = 2
+)
+
+var WindowUnit_name = map[int32]string{
+	0: "WINDOW_UNIT_UNSPECIFIED",
+	1: "WINDOW_UNIT_BLOCKS",
+	2: "WINDOW_UNIT_SECONDS",
+}
+
+var WindowUnit_value = map[string]int32{
+	"WINDOW_UNIT_UNSPECIFIED": 0,
+	"WINDOW_UNIT_BLOCKS":      1,
+	"WINDOW_UNIT_SECONDS":     2,
+}
+
+func (x WindowUnit) String() string {
+	return proto.EnumName(WindowUnit_name, int32(x))
+}
+
+func (WindowUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5f85303084ada054, []int{0}
+}
+
+// MintRateLimit caps the amount a minter can mint within any rolling window.
+type MintRateLimit struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	// maximum amount of the minting denom minted within any window
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// length of the window, measured in unit
+	Window uint64     `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	Unit   WindowUnit `protobuf:"varint,4,opt,name=unit,proto3,enum=circle.fiattokenfactory.v1.WindowUnit" json:"unit,omitempty"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f85303084ada054, []int{0}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *MintRateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *MintRateLimit) GetUnit() WindowUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return WINDOW_UNIT_UNSPECIFIED
+}
+
+// MintWindow tracks the amounts minted by a minter within the rolling window of its rate limit.
+// The window is split into buckets, an amount counts towards the window until the length of the
+// window has elapsed since the end of the bucket it was minted in.
+type MintWindow struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	// buckets still counting towards the window, oldest first
+	Buckets []MintBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *MintWindow) Reset()         { *m = MintWindow{} }
+func (m *MintWindow) String() string { return proto.CompactTextString(m) }
+func (*MintWindow) ProtoMessage()    {}
+func (*MintWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f85303084ada054, []int{1}
+}
+func (m *MintWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintWindow.Merge(m, src)
+}
+func (m *MintWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintWindow proto.InternalMessageInfo
+
+func (m *MintWindow) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *MintWindow) GetBuckets() []MintBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// MintBucket is the amount minted within one bucket of a rolling window.
+type MintBucket struct {
+	// block height or unix time, depending on the unit of the rate limit, at which the bucket started
+	Start  int64                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+}
+
+func (m *MintBucket) Reset()         { *m = MintBucket{} }
+func (m *MintBucket) String() string { return proto.CompactTextString(m) }
+func (*MintBucket) ProtoMessage()    {}
+func (*MintBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f85303084ada054, []int{2}
+}
+func (m *MintBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBucket.Merge(m, src)
+}
+func (m *MintBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBucket proto.InternalMessageInfo
+
+func (m *MintBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("circle.fiattokenfactory.v1.WindowUnit", WindowUnit_name, WindowUnit_value)
+	proto.RegisterType((*MintRateLimit)(nil), "circle.fiattokenfactory.v1.MintRateLimit")
+	proto.RegisterType((*MintWindow)(nil), "circle.fiattokenfactory.v1.MintWindow")
+	proto.RegisterType((*MintBucket)(nil), "circle.fiattokenfactory.v1.MintBucket")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/mint_rate_limit.proto", fileDescriptor_5f85303084ada054)
+}
+
+var fileDescriptor_5f85303084ada054 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0x26, 0x4e, 0x11, 0x83, 0x40, 0xd1, 0x52, 0x5a, 0x13, 0x24, 0x37, 0xca, 0x01, 0x45,
+	0xa0, 0xda, 0x14, 0x6e, 0xdc, 0x70, 0x92, 0x4a, 0x16, 0x25, 0x41, 0x0e, 0x51, 0x24, 0x0e, 0x18,
+	0xc7, 0xd9, 0xb8, 0xab, 0xd8, 0xbb, 0x95, 0x3d, 0x69, 0xe9, 0x1f, 0x70, 0xe4, 0x1f, 0xf8, 0x05,
+	0xce, 0x9c, 0x7b, 0xac, 0x38, 0x21, 0x0e, 0x15, 0x4a, 0x7e, 0x04, 0xd9, 0xeb, 0xaa, 0x15, 0x55,
+	0x38, 0x70, 0xdb, 0xf7, 0x76, 0xde, 0xbc, 0x37, 0xa3, 0x81, 0x67, 0x21, 0x4f, 0xc3, 0x98, 0xd9,
+	0x33, 0x1e, 0x20, 0xca, 0x39, 0x13, 0xb3, 0x20, 0x44, 0x99, 0x9e, 0xda, 0xc7, 0x7b, 0x76, 0xc2,
+	0x05, 0xfa, 0x69, 0x80, 0xcc, 0x8f, 0x79, 0xc2, 0xd1, 0x3a, 0x4a, 0x25, 0x4a, 0xda, 0x50, 0x0a,
+	0xeb, 0x6f, 0x85, 0x75, 0xbc, 0xd7, 0x78, 0x18, 0xca, 0x2c, 0x91, 0x99, 0x5f, 0x54, 0xda, 0x0a,
+	0x28, 0x59, 0x63, 0x33, 0x92, 0x91, 0x54, 0x7c, 0xfe, 0x52, 0x6c, 0xeb, 0x3b, 0x81, 0xbb, 0x6f,
+	0xb8, 0x40, 0x2f, 0x40, 0x76, 0x90, 0x9b, 0xd0, 0x2d, 0xd8, 0xc8, 0x7d, 0x59, 0x6a, 0x90, 0x26,
+	0x69, 0xdf, 0xf6, 0x4a, 0x44, 0x5f, 0x41, 0xad, 0x48, 0x61, 0x54, 0x72, 0xda, 0x79, 0x7a, 0x76,
+	0xb1, 0xa3, 0xfd, 0xba, 0xd8, 0x79, 0xa0, 0x4c, 0xb2, 0xe9, 0xdc, 0xe2, 0xd2, 0x4e, 0x02, 0x3c,
+	0xb4, 0x5c, 0x81, 0x3f, 0xbe, 0xed, 0x42, 0xe9, 0xee, 0x0a, 0xf4, 0x6a, 0xf1, 0x65, 0xeb, 0x13,
+	0x2e, 0xa6, 0xf2, 0xc4, 0xa8, 0x36, 0x49, 0x5b, 0xf7, 0x4a, 0x44, 0x5f, 0x82, 0xbe, 0x10, 0x1c,
+	0x0d, 0xbd, 0x49, 0xda, 0xf7, 0x9e, 0x3f, 0xb6, 0xd6, 0x0f, 0x68, 0x8d, 0x0b, 0xc5, 0x48, 0x70,
+	0xf4, 0x0a, 0x4d, 0x2b, 0x06, 0xc8, 0xf3, 0x2b, 0x7e, 0x6d, 0xf8, 0x7d, 0xb8, 0x35, 0x59, 0x84,
+	0x73, 0x86, 0x99, 0x51, 0x69, 0x56, 0xdb, 0x77, 0xfe, 0x6d, 0x92, 0x37, 0x74, 0x8a, 0x72, 0x47,
+	0xcf, 0xc7, 0xf4, 0x2e, 0xc5, 0xad, 0x08, 0xe0, 0xea, 0x93, 0x6e, 0x42, 0x2d, 0xc3, 0x20, 0xc5,
+	0xc2, 0xac, 0xea, 0x29, 0x40, 0x3b, 0x65, 0x86, 0xe9, 0xff, 0x6c, 0xaa, 0x94, 0x3e, 0xf9, 0x08,
+	0x70, 0x35, 0x2a, 0x7d, 0x04, 0xdb, 0x63, 0xb7, 0xdf, 0x1d, 0x8c, 0xfd, 0x51, 0xdf, 0x7d, 0xe7,
+	0x8f, 0xfa, 0xc3, 0xb7, 0xbd, 0x8e, 0xbb, 0xef, 0xf6, 0xba, 0x75, 0x8d, 0x6e, 0x01, 0xbd, 0xfe,
+	0xe9, 0x1c, 0x0c, 0x3a, 0xaf, 0x87, 0x75, 0x42, 0xb7, 0xe1, 0xfe, 0x75, 0x7e, 0xd8, 0xeb, 0x0c,
+	0xfa, 0xdd, 0x61, 0xbd, 0xd2, 0xd0, 0x3f, 0x7f, 0x35, 0x35, 0xe7, 0xc3, 0xd9, 0xd2, 0x24, 0xe7,
+	0x4b, 0x93, 0xfc, 0x5e, 0x9a, 0xe4, 0xcb, 0xca, 0xd4, 0xce, 0x57, 0xa6, 0xf6, 0x73, 0x65, 0x6a,
+	0xef, 0xbb, 0x11, 0xc7, 0xc3, 0xc5, 0xc4, 0x0a, 0x65, 0x62, 0xab, 0x2d, 0xcd, 0xb8, 0xb0, 0x85,
+	0x9c, 0xc4, 0x6c, 0xf7, 0xc6, 0x99, 0x7e, 0xba, 0x79, 0xb9, 0x78, 0x7a, 0xc4, 0xb2, 0xc9, 0x46,
+	0x71, 0x60, 0x2f, 0xfe, 0x0c, 0x00, 0x2e, 0x7a, 0x34, 0xf2, 0xe1, 0x02, 0x00, 0x00,
+}
+
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unit != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Window != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Window))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Unit))
+	}
+	return n
+}
+
+func (m *MintWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovMintRateLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Start))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	return n
+}
+
+func sovMintRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintRateLimit(x uint64) (n int) {
+	return sovMintRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= WindowUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, MintBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
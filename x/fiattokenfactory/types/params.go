@@ -0,0 +1,64 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxControllersPerMinter is the largest limit on the number of controllers of a minter, zero
+// leaves the number of controllers of a minter unlimited.
+const MaxControllersPerMinter = 100
+
+// DefaultParams returns the default module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxBatchSize:            MaxBatchSize,
+		MaxMinterAllowance:      math.ZeroInt(),
+		RequireChannelAllowlist: false,
+		MaxControllersPerMinter: 1,
+	}
+}
+
+// Validate performs basic validation of the module parameters.
+func (p Params) Validate() error {
+	if p.MaxBatchSize == 0 || p.MaxBatchSize > MaxBatchSize {
+		return errors.Wrapf(ErrInvalidParams, "max batch size must be between 1 and %d", MaxBatchSize)
+	}
+
+	if p.MaxControllersPerMinter > MaxControllersPerMinter {
+		return errors.Wrapf(ErrInvalidParams, "max controllers per minter cannot be more than %d, or zero when unlimited", MaxControllersPerMinter)
+	}
+
+	if p.MaxMinterAllowance.IsNil() || p.MaxMinterAllowance.IsNegative() {
+		return errors.Wrap(ErrInvalidParams, "max minter allowance cannot be nil or negative")
+	}
+
+	return nil
+}
+
+// ExceedsMaxMinterAllowance returns whether an allowance is above the maximum minter allowance, if any.
+func (p Params) ExceedsMaxMinterAllowance(allowance math.Int) bool {
+	return !p.MaxMinterAllowance.IsZero() && allowance.GT(p.MaxMinterAllowance)
+}
+
+// ExceedsMaxControllersPerMinter returns whether a number of controllers is above the maximum a minter
+// can be configured with, if any.
+func (p Params) ExceedsMaxControllersPerMinter(controllers int) bool {
+	return p.MaxControllersPerMinter > 0 && controllers > int(p.MaxControllersPerMinter)
+}
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the module wide parameters, shared by every fiat token.
+type Params struct {
+	// maximum number of addresses in a single blacklist or unblacklist batch
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// maximum allowance a minter can be configured with, zero when unlimited
+	MaxMinterAllowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_minter_allowance,json=maxMinterAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"max_minter_allowance"`
+	// whether the channel allowlist of every fiat token is enforced, even where
+	// the owner has not enabled it
+	RequireChannelAllowlist bool `protobuf:"varint,3,opt,name=require_channel_allowlist,json=requireChannelAllowlist,proto3" json:"require_channel_allowlist,omitempty"`
+	// maximum number of controllers a minter can be configured with, at most 100.
+	// Zero leaves the number of controllers of a minter unlimited, so any number
+	// of controllers can share a minter.
+	MaxControllersPerMinter uint32 `protobuf:"varint,4,opt,name=max_controllers_per_minter,json=maxControllersPerMinter,proto3" json:"max_controllers_per_minter,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7328f0f936de304b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetRequireChannelAllowlist() bool {
+	if m != nil {
+		return m.RequireChannelAllowlist
+	}
+	return false
+}
+
+func (m *Params) GetMaxControllersPerMinter() uint32 {
+	if m != nil {
+		return m.MaxControllersPerMinter
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "circle.fiattokenfactory.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/params.proto", fileDescriptor_7328f0f936de304b)
+}
+
+var fileDescriptor_7328f0f936de304b = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4b, 0x02, 0x41,
+	0x18, 0xc6, 0x77, 0x2d, 0xa4, 0x96, 0xea, 0xb0, 0x18, 0xae, 0x7b, 0x58, 0x25, 0x82, 0x84, 0x70,
+	0x17, 0xe9, 0x56, 0x27, 0xb5, 0x8b, 0x87, 0x40, 0xec, 0x16, 0xd4, 0x32, 0x3b, 0x8d, 0xee, 0xe0,
+	0xfc, 0xd9, 0x66, 0x46, 0x5b, 0xfd, 0x00, 0x9d, 0xfb, 0x30, 0x7d, 0x08, 0x8f, 0xd2, 0x29, 0x3a,
+	0x48, 0xe8, 0x17, 0x89, 0xdd, 0x51, 0x82, 0xbc, 0xcd, 0x3c, 0xef, 0x6f, 0x9e, 0xf7, 0x99, 0xf7,
+	0xb5, 0x2e, 0x20, 0x16, 0x90, 0xa0, 0x60, 0x80, 0x81, 0x52, 0x7c, 0x84, 0xd8, 0x00, 0x40, 0xc5,
+	0xc5, 0x34, 0x98, 0x34, 0x83, 0x04, 0x08, 0x40, 0xa5, 0x9f, 0x08, 0xae, 0xb8, 0xed, 0x6a, 0xd0,
+	0xff, 0x0f, 0xfa, 0x93, 0xa6, 0x5b, 0x81, 0x5c, 0x52, 0x2e, 0xc3, 0x9c, 0x0c, 0xf4, 0x45, 0x3f,
+	0x73, 0x4b, 0x43, 0x3e, 0xe4, 0x5a, 0xcf, 0x4e, 0x5a, 0x3d, 0x7b, 0x2b, 0x58, 0xc5, 0x5e, 0xee,
+	0x6e, 0x9f, 0x5b, 0x27, 0x14, 0xa4, 0x61, 0x04, 0x14, 0x8c, 0x43, 0x89, 0x67, 0xc8, 0x31, 0x6b,
+	0x66, 0xfd, 0xb8, 0x7f, 0x44, 0x41, 0xda, 0xce, 0xc4, 0x7b, 0x3c, 0x43, 0xf6, 0xa3, 0x55, 0xca,
+	0x28, 0x8a, 0x99, 0x42, 0x22, 0x04, 0x84, 0xf0, 0x57, 0xc0, 0x20, 0x72, 0x0a, 0x35, 0xb3, 0x7e,
+	0xd8, 0xbe, 0x9c, 0x2f, 0xab, 0xc6, 0xf7, 0xb2, 0x7a, 0xaa, 0x5b, 0xcb, 0xe7, 0x91, 0x8f, 0x79,
+	0x40, 0x81, 0x8a, 0xfd, 0x2e, 0x53, 0x9f, 0x1f, 0x0d, 0x6b, 0x93, 0xa9, 0xcb, 0x54, 0xdf, 0xa6,
+	0x20, 0xbd, 0xcb, 0x7d, 0x5a, 0x5b, 0x1b, 0xfb, 0xda, 0xaa, 0x08, 0xf4, 0x32, 0xc6, 0x02, 0x85,
+	0x30, 0x06, 0x8c, 0x21, 0xa2, 0x7b, 0x10, 0x2c, 0x95, 0xb3, 0x57, 0x33, 0xeb, 0x07, 0xfd, 0xf2,
+	0x06, 0xe8, 0xe8, 0x7a, 0x6b, 0x5b, 0xb6, 0x6f, 0x2c, 0x37, 0x8b, 0x06, 0x39, 0x53, 0x82, 0x13,
+	0x82, 0x84, 0x0c, 0x13, 0x24, 0x36, 0x51, 0x9d, 0xfd, 0xfc, 0x33, 0x65, 0x0a, 0xd2, 0xce, 0x1f,
+	0xd0, 0x43, 0x42, 0x27, 0x68, 0x3f, 0xcd, 0x57, 0x9e, 0xb9, 0x58, 0x79, 0xe6, 0xcf, 0xca, 0x33,
+	0xdf, 0xd7, 0x9e, 0xb1, 0x58, 0x7b, 0xc6, 0xd7, 0xda, 0x33, 0x1e, 0x6e, 0x87, 0x58, 0xc5, 0xe3,
+	0xc8, 0x87, 0x9c, 0x06, 0x7a, 0xf4, 0x03, 0xcc, 0x02, 0xc6, 0x23, 0x82, 0x1a, 0x3b, 0xcb, 0x4a,
+	0x77, 0xf7, 0xa7, 0xa6, 0x09, 0x92, 0x51, 0x31, 0x9f, 0xf7, 0xd5, 0xef, 0x00, 0x4c, 0xb0, 0xd6,
+	0xc4, 0xe7, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxControllersPerMinter != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxControllersPerMinter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequireChannelAllowlist {
+		i--
+		if m.RequireChannelAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxMinterAllowance.Size()
+		i -= size
+		if _, err := m.MaxMinterAllowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
+	l = m.MaxMinterAllowance.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RequireChannelAllowlist {
+		n += 2
+	}
+	if m.MaxControllersPerMinter != 0 {
+		n += 1 + sovParams(uint64(m.MaxControllersPerMinter))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMinterAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMinterAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireChannelAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireChannelAllowlist = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxControllersPerMinter", wireType)
+			}
+			m.MaxControllersPerMinter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxControllersPerMinter |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
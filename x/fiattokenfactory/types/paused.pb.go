@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,8 +23,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PauseScope enumerates the capabilities of a fiat token that can be paused
+// independently of each other.
+type PauseScope int32
+
+const (
+	PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// minting and raising minter allowances
+	PAUSE_SCOPE_MINT PauseScope = 1
+	// burning
+	PAUSE_SCOPE_BURN PauseScope = 2
+	// bank transfers between accounts on this chain
+	PAUSE_SCOPE_TRANSFER PauseScope = 3
+	// inbound and outbound ICS-20 transfers
+	PAUSE_SCOPE_IBC PauseScope = 4
+)
+
+var PauseScope_name = map[int32]string{
+	0: "PAUSE_SCOPE_UNSPECIFIED",
+	1: "PAUSE_SCOPE_MINT",
+	2: "PAUSE_SCOPE_BURN",
+	3: "PAUSE_SCOPE_TRANSFER",
+	4: "PAUSE_SCOPE_IBC",
+}
+
+var PauseScope_value = map[string]int32{
+	"PAUSE_SCOPE_UNSPECIFIED": 0,
+	"PAUSE_SCOPE_MINT":        1,
+	"PAUSE_SCOPE_BURN":        2,
+	"PAUSE_SCOPE_TRANSFER":    3,
+	"PAUSE_SCOPE_IBC":         4,
+}
+
+func (x PauseScope) String() string {
+	return proto.EnumName(PauseScope_name, int32(x))
+}
+
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e36d0fde6b443e83, []int{0}
+}
+
 type Paused struct {
-	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Deprecated: single flag that paused every capability. It is only read to
+	// migrate state and genesis files written before the per-scope flags.
+	Paused   bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"` // Deprecated: Do not use.
+	Mint     bool `protobuf:"varint,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Burn     bool `protobuf:"varint,3,opt,name=burn,proto3" json:"burn,omitempty"`
+	Transfer bool `protobuf:"varint,4,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Ibc      bool `protobuf:"varint,5,opt,name=ibc,proto3" json:"ibc,omitempty"`
+	// scheduled expiry of each paused scope, a scope paused indefinitely has no entry
+	Expiries []PauseExpiry `protobuf:"bytes,8,rep,name=expiries,proto3" json:"expiries"`
 }
 
 func (m *Paused) Reset()         { *m = Paused{} }
@@ -59,6 +108,7 @@ func (m *Paused) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Paused proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Paused) GetPaused() bool {
 	if m != nil {
 		return m.Paused
@@ -66,8 +116,108 @@ func (m *Paused) GetPaused() bool {
 	return false
 }
 
+func (m *Paused) GetMint() bool {
+	if m != nil {
+		return m.Mint
+	}
+	return false
+}
+
+func (m *Paused) GetBurn() bool {
+	if m != nil {
+		return m.Burn
+	}
+	return false
+}
+
+func (m *Paused) GetTransfer() bool {
+	if m != nil {
+		return m.Transfer
+	}
+	return false
+}
+
+func (m *Paused) GetIbc() bool {
+	if m != nil {
+		return m.Ibc
+	}
+	return false
+}
+
+func (m *Paused) GetExpiries() []PauseExpiry {
+	if m != nil {
+		return m.Expiries
+	}
+	return nil
+}
+
+// PauseExpiry schedules the unpause of a single scope.
+type PauseExpiry struct {
+	Scope PauseScope `protobuf:"varint,1,opt,name=scope,proto3,enum=circle.fiattokenfactory.v1.PauseScope" json:"scope,omitempty"`
+	// block height at which the scope is unpaused, zero when it does not expire by height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// unix time in seconds at which the scope is unpaused, zero when it does not expire by time
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *PauseExpiry) Reset()         { *m = PauseExpiry{} }
+func (m *PauseExpiry) String() string { return proto.CompactTextString(m) }
+func (*PauseExpiry) ProtoMessage()    {}
+func (*PauseExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36d0fde6b443e83, []int{1}
+}
+func (m *PauseExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseExpiry.Merge(m, src)
+}
+func (m *PauseExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseExpiry proto.InternalMessageInfo
+
+func (m *PauseExpiry) GetScope() PauseScope {
+	if m != nil {
+		return m.Scope
+	}
+	return PAUSE_SCOPE_UNSPECIFIED
+}
+
+func (m *PauseExpiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PauseExpiry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("circle.fiattokenfactory.v1.PauseScope", PauseScope_name, PauseScope_value)
 	proto.RegisterType((*Paused)(nil), "circle.fiattokenfactory.v1.Paused")
+	proto.RegisterType((*PauseExpiry)(nil), "circle.fiattokenfactory.v1.PauseExpiry")
 }
 
 func init() {
@@ -75,18 +225,34 @@ func init() {
 }
 
 var fileDescriptor_e36d0fde6b443e83 = []byte{
-	// 166 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x2c, 0x4a,
-	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
-	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x2d, 0x4e, 0x4d, 0xd1, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0x28, 0xd4, 0x43, 0x57, 0xa8, 0x57, 0x66, 0xa8, 0xa4, 0xc0,
-	0xc5, 0x16, 0x00, 0x56, 0x2b, 0x24, 0xc6, 0xc5, 0x06, 0xd1, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x11, 0x04, 0xe5, 0x39, 0xc5, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x4b, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc4, 0x8a, 0xb4, 0xcc,
-	0x3c, 0xfd, 0xbc, 0xfc, 0xa4, 0x9c, 0x54, 0x5d, 0x0c, 0x47, 0x55, 0x60, 0xba, 0xb3, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x48, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0x94,
-	0x80, 0xc1, 0xcf, 0x00, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0x9b, 0x34, 0x0d, 0xaf, 0xa0, 0xc3, 0xb8, 0xe8, 0x10, 0x21, 0x96, 0x1e, 0x6c,
+	0x11, 0x4c, 0x68, 0xbd, 0x7a, 0x69, 0xb6, 0x29, 0xa4, 0xe0, 0xba, 0x4c, 0xba, 0x17, 0x0f, 0x96,
+	0x4d, 0x9c, 0xdd, 0x1d, 0xec, 0x66, 0x42, 0x32, 0x5b, 0xbb, 0xdf, 0x40, 0x3c, 0xf9, 0x1d, 0xfc,
+	0x32, 0x3d, 0xd6, 0x9b, 0x27, 0x91, 0xdd, 0x2f, 0x22, 0x33, 0x29, 0x75, 0x69, 0x91, 0xde, 0xfe,
+	0xef, 0xf7, 0xfe, 0xef, 0x25, 0xff, 0xe1, 0xc1, 0x6e, 0x21, 0xea, 0xe2, 0x9c, 0x47, 0x63, 0x31,
+	0x52, 0x4a, 0x7e, 0xe6, 0xe5, 0x78, 0x54, 0x28, 0x59, 0x2f, 0xa2, 0x8b, 0xfd, 0xa8, 0x1a, 0xcd,
+	0x1b, 0xfe, 0x29, 0xac, 0x6a, 0xa9, 0x24, 0xf1, 0x5b, 0x63, 0x78, 0xd7, 0x18, 0x5e, 0xec, 0xfb,
+	0xdd, 0x89, 0x9c, 0x48, 0x63, 0x8b, 0xb4, 0x6a, 0x27, 0x76, 0x7e, 0x22, 0x70, 0x07, 0x66, 0x05,
+	0xf1, 0xc1, 0x6d, 0x97, 0x51, 0xb4, 0x8d, 0xf6, 0xbc, 0xb8, 0x43, 0x11, 0xbb, 0x21, 0x84, 0x80,
+	0x33, 0x13, 0xa5, 0xa2, 0x1d, 0xdd, 0x61, 0x46, 0x6b, 0x96, 0xcf, 0xeb, 0x92, 0xda, 0x2d, 0xd3,
+	0x9a, 0xf8, 0xe0, 0xa9, 0x7a, 0x54, 0x36, 0x63, 0x5e, 0x53, 0xc7, 0xf0, 0xdb, 0x9a, 0x60, 0xb0,
+	0x45, 0x5e, 0xd0, 0x0d, 0x83, 0xb5, 0x24, 0x29, 0x78, 0xfc, 0xb2, 0x12, 0xb5, 0xe0, 0x0d, 0xf5,
+	0xb6, 0xed, 0xbd, 0xad, 0x83, 0xdd, 0xf0, 0xff, 0x09, 0x42, 0xf3, 0x9f, 0x89, 0x1e, 0x58, 0xc4,
+	0xce, 0xd5, 0xef, 0x17, 0x16, 0xbb, 0x1d, 0x3f, 0x71, 0x3c, 0x17, 0x6f, 0x9e, 0x38, 0xde, 0x26,
+	0xf6, 0x76, 0xbe, 0xc0, 0xd6, 0x9a, 0x95, 0xbc, 0x85, 0x8d, 0xa6, 0x90, 0x15, 0x37, 0xb1, 0x1e,
+	0x1d, 0xbc, 0x7c, 0xf0, 0x13, 0x99, 0x76, 0xb3, 0x76, 0x88, 0x3c, 0x05, 0x77, 0xca, 0xc5, 0x64,
+	0xda, 0x66, 0xb7, 0xd9, 0x4d, 0xa5, 0xd3, 0x2b, 0x31, 0xe3, 0x26, 0xbd, 0xcd, 0x8c, 0x7e, 0xf5,
+	0x0d, 0x01, 0xfc, 0xdb, 0x40, 0x9e, 0xc3, 0xb3, 0xc1, 0xe1, 0x30, 0x4b, 0xce, 0xb2, 0xde, 0xfb,
+	0x41, 0x72, 0x36, 0xec, 0x67, 0x83, 0xa4, 0x97, 0x1e, 0xa7, 0xc9, 0x11, 0xb6, 0x48, 0x17, 0xf0,
+	0x7a, 0xf3, 0x5d, 0xda, 0x3f, 0xc5, 0xe8, 0x2e, 0x8d, 0x87, 0xac, 0x8f, 0x3b, 0x84, 0x42, 0x77,
+	0x9d, 0x9e, 0xb2, 0xc3, 0x7e, 0x76, 0x9c, 0x30, 0x6c, 0x93, 0x27, 0xf0, 0x78, 0xbd, 0x93, 0xc6,
+	0x3d, 0xec, 0xf8, 0xce, 0xd7, 0x1f, 0x81, 0x15, 0x7f, 0xbc, 0x5a, 0x06, 0xe8, 0x7a, 0x19, 0xa0,
+	0x3f, 0xcb, 0x00, 0x7d, 0x5f, 0x05, 0xd6, 0xf5, 0x2a, 0xb0, 0x7e, 0xad, 0x02, 0xeb, 0xc3, 0xd1,
+	0x44, 0xa8, 0xe9, 0x3c, 0x0f, 0x0b, 0x39, 0x8b, 0xda, 0xb7, 0x18, 0x8b, 0x32, 0x2a, 0x65, 0x7e,
+	0xce, 0x5f, 0xdf, 0x3b, 0xb1, 0xcb, 0xfb, 0x57, 0xa7, 0x16, 0x15, 0x6f, 0x72, 0xd7, 0x1c, 0xd0,
+	0x9b, 0xbf, 0x03, 0x00, 0x19, 0x91, 0x60, 0xae, 0x9d, 0x02, 0x00, 0x00,
 }
 
 func (m *Paused) Marshal() (dAtA []byte, err error) {
@@ -109,6 +275,60 @@ func (m *Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaused(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Ibc {
+		i--
+		if m.Ibc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Transfer {
+		i--
+		if m.Transfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -122,6 +342,44 @@ func (m *Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PauseExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintPaused(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintPaused(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Scope != 0 {
+		i = encodeVarintPaused(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPaused(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPaused(v)
 	base := offset
@@ -142,6 +400,42 @@ func (m *Paused) Size() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	if m.Mint {
+		n += 2
+	}
+	if m.Burn {
+		n += 2
+	}
+	if m.Transfer {
+		n += 2
+	}
+	if m.Ibc {
+		n += 2
+	}
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovPaused(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		n += 1 + sovPaused(uint64(m.Scope))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPaused(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovPaused(uint64(m.Time))
+	}
 	return n
 }
 
@@ -200,6 +494,227 @@ func (m *Paused) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mint = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transfer = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ibc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ibc = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaused
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaused
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiries = append(m.Expiries, PauseExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaused(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaused
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaused
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPaused(dAtA[iNdEx:])
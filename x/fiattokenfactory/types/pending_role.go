@@ -0,0 +1,31 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/errors"
+
+// MaxHandoverDelay is the largest number of blocks a role handover can be delayed by, so that the
+// activation height of a pending role cannot overflow.
+const MaxHandoverDelay = 100_000_000
+
+func validateHandoverDelay(delay uint64) error {
+	if delay > MaxHandoverDelay {
+		return errors.Wrapf(ErrInvalidDelay, "handover delay cannot be longer than %d blocks", MaxHandoverDelay)
+	}
+
+	return nil
+}
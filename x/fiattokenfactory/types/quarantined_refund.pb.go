@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/quarantined_refund.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuarantinedRefund is the total amount of refunds of failed or timed out outbound transfers that could not be
+// returned to their sender and are held by the module account until they are released or wiped.
+type QuarantinedRefund struct {
+	// original sender of the transfers the refunds are owed to
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *QuarantinedRefund) Reset()         { *m = QuarantinedRefund{} }
+func (m *QuarantinedRefund) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedRefund) ProtoMessage()    {}
+func (*QuarantinedRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dabf300bd481bd07, []int{0}
+}
+func (m *QuarantinedRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedRefund.Merge(m, src)
+}
+func (m *QuarantinedRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedRefund proto.InternalMessageInfo
+
+func (m *QuarantinedRefund) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QuarantinedRefund)(nil), "circle.fiattokenfactory.v1.QuarantinedRefund")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/quarantined_refund.proto", fileDescriptor_dabf300bd481bd07)
+}
+
+var fileDescriptor_dabf300bd481bd07 = []byte{
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x18, 0x85, 0x63, 0x86, 0x22, 0xb2, 0x51, 0x15, 0xa9, 0x74, 0x70, 0x11, 0x13, 0x12, 0x8a, 0xad,
+	0xd2, 0x13, 0x50, 0x58, 0x3a, 0x12, 0x36, 0x06, 0x2a, 0x27, 0x71, 0x52, 0xab, 0x8d, 0xff, 0x62,
+	0xff, 0xa9, 0xe8, 0x1d, 0x18, 0x38, 0x4c, 0x0f, 0xd1, 0xb1, 0xea, 0x84, 0x18, 0x2a, 0x94, 0x5c,
+	0x04, 0x11, 0x07, 0x21, 0xd1, 0xcd, 0x7e, 0x7e, 0xdf, 0x7b, 0xf2, 0xf3, 0x87, 0xb1, 0x32, 0xf1,
+	0x5c, 0xf2, 0x54, 0x09, 0x44, 0x98, 0x49, 0x9d, 0x8a, 0x18, 0xc1, 0xac, 0xf8, 0x72, 0xc0, 0x5f,
+	0x0a, 0x61, 0x84, 0x46, 0xa5, 0x65, 0x32, 0x31, 0x32, 0x2d, 0x74, 0xc2, 0x16, 0x06, 0x10, 0xda,
+	0x3d, 0x07, 0xb1, 0xff, 0x10, 0x5b, 0x0e, 0x7a, 0xe7, 0x31, 0xd8, 0x1c, 0xec, 0xa4, 0x76, 0x72,
+	0x77, 0x71, 0x58, 0xaf, 0x93, 0x41, 0x06, 0x4e, 0xff, 0x39, 0x39, 0xf5, 0xf2, 0x8d, 0xf8, 0xa7,
+	0x0f, 0x7f, 0x4d, 0x61, 0x5d, 0xd4, 0xbe, 0xf1, 0x8f, 0x45, 0x92, 0x18, 0x69, 0x6d, 0x97, 0x5c,
+	0x90, 0xab, 0x93, 0x51, 0x77, 0xb7, 0x0e, 0x3a, 0x4d, 0xdc, 0xad, 0x7b, 0x79, 0x44, 0xa3, 0x74,
+	0x16, 0xfe, 0x1a, 0xdb, 0x77, 0x7e, 0x4b, 0xe4, 0x50, 0x68, 0xec, 0x1e, 0xd5, 0xc8, 0xf5, 0x66,
+	0xdf, 0xf7, 0x3e, 0xf7, 0xfd, 0x33, 0x87, 0xd9, 0x64, 0xc6, 0x14, 0xf0, 0x5c, 0xe0, 0x94, 0x8d,
+	0x35, 0xee, 0xd6, 0x81, 0xdf, 0xe4, 0x8d, 0x35, 0x86, 0x0d, 0x3a, 0x7a, 0xde, 0x94, 0x94, 0x6c,
+	0x4b, 0x4a, 0xbe, 0x4a, 0x4a, 0xde, 0x2b, 0xea, 0x6d, 0x2b, 0xea, 0x7d, 0x54, 0xd4, 0x7b, 0xba,
+	0xcf, 0x14, 0x4e, 0x8b, 0x88, 0xc5, 0x90, 0x73, 0x37, 0x40, 0xaa, 0x34, 0xd7, 0x10, 0xcd, 0x65,
+	0x70, 0x30, 0xdf, 0xeb, 0xe1, 0xa2, 0xb8, 0x5a, 0x48, 0x1b, 0xb5, 0xea, 0x5f, 0x0f, 0xbf, 0x07,
+	0x00, 0xd5, 0x56, 0x07, 0xe3, 0x79, 0x01, 0x00, 0x00,
+}
+
+func (m *QuarantinedRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuarantinedRefund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuarantinedRefund(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuarantinedRefund(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuarantinedRefund(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuarantinedRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuarantinedRefund(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuarantinedRefund(uint64(l))
+	return n
+}
+
+func sovQuarantinedRefund(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuarantinedRefund(x uint64) (n int) {
+	return sovQuarantinedRefund(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuarantinedRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantinedRefund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantinedRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantinedRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantinedRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantinedRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantinedRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantinedRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantinedRefund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantinedRefund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuarantinedRefund(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuarantinedRefund
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuarantinedRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuarantinedRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuarantinedRefund
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuarantinedRefund
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuarantinedRefund
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuarantinedRefund        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuarantinedRefund          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuarantinedRefund = fmt.Errorf("proto: unexpected end of group")
+)
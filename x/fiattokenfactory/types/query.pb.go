@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -32,6 +35,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryGetBlacklistedRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetBlacklistedRequest) Reset()         { *m = QueryGetBlacklistedRequest{} }
@@ -74,6 +78,13 @@ func (m *QueryGetBlacklistedRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryGetBlacklistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetBlacklistedResponse struct {
 	Blacklisted Blacklisted `protobuf:"bytes,1,opt,name=blacklisted,proto3" json:"blacklisted"`
 }
@@ -120,6 +131,7 @@ func (m *QueryGetBlacklistedResponse) GetBlacklisted() Blacklisted {
 
 type QueryAllBlacklistedRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllBlacklistedRequest) Reset()         { *m = QueryAllBlacklistedRequest{} }
@@ -162,6 +174,13 @@ func (m *QueryAllBlacklistedRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllBlacklistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryAllBlacklistedResponse struct {
 	Blacklisted []Blacklisted       `protobuf:"bytes,1,rep,name=blacklisted,proto3" json:"blacklisted"`
 	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -215,6 +234,7 @@ func (m *QueryAllBlacklistedResponse) GetPagination() *query.PageResponse {
 }
 
 type QueryGetPausedRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetPausedRequest) Reset()         { *m = QueryGetPausedRequest{} }
@@ -250,8 +270,17 @@ func (m *QueryGetPausedRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetPausedRequest proto.InternalMessageInfo
 
+func (m *QueryGetPausedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetPausedResponse struct {
 	Paused Paused `protobuf:"bytes,1,opt,name=paused,proto3" json:"paused"`
+	// time left until each scope with a scheduled expiry is unpaused
+	Remaining []PauseRemaining `protobuf:"bytes,2,rep,name=remaining,proto3" json:"remaining"`
 }
 
 func (m *QueryGetPausedResponse) Reset()         { *m = QueryGetPausedResponse{} }
@@ -294,14 +323,84 @@ func (m *QueryGetPausedResponse) GetPaused() Paused {
 	return Paused{}
 }
 
+func (m *QueryGetPausedResponse) GetRemaining() []PauseRemaining {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+type PauseRemaining struct {
+	Scope PauseScope `protobuf:"varint,1,opt,name=scope,proto3,enum=circle.fiattokenfactory.v1.PauseScope" json:"scope,omitempty"`
+	// blocks left until the scope expires by height, zero when it has no expiry height
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// seconds left until the scope expires by time, zero when it has no expiry time
+	Seconds int64 `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *PauseRemaining) Reset()         { *m = PauseRemaining{} }
+func (m *PauseRemaining) String() string { return proto.CompactTextString(m) }
+func (*PauseRemaining) ProtoMessage()    {}
+func (*PauseRemaining) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{6}
+}
+func (m *PauseRemaining) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseRemaining) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseRemaining.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseRemaining) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRemaining.Merge(m, src)
+}
+func (m *PauseRemaining) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseRemaining) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRemaining.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRemaining proto.InternalMessageInfo
+
+func (m *PauseRemaining) GetScope() PauseScope {
+	if m != nil {
+		return m.Scope
+	}
+	return PAUSE_SCOPE_UNSPECIFIED
+}
+
+func (m *PauseRemaining) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *PauseRemaining) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
 type QueryGetMasterMinterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetMasterMinterRequest) Reset()         { *m = QueryGetMasterMinterRequest{} }
 func (m *QueryGetMasterMinterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMasterMinterRequest) ProtoMessage()    {}
 func (*QueryGetMasterMinterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{6}
+	return fileDescriptor_0e79ac8fb1676620, []int{7}
 }
 func (m *QueryGetMasterMinterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,6 +429,13 @@ func (m *QueryGetMasterMinterRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetMasterMinterRequest proto.InternalMessageInfo
 
+func (m *QueryGetMasterMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMasterMinterResponse struct {
 	MasterMinter MasterMinter `protobuf:"bytes,1,opt,name=masterMinter,proto3" json:"masterMinter"`
 }
@@ -338,7 +444,7 @@ func (m *QueryGetMasterMinterResponse) Reset()         { *m = QueryGetMasterMint
 func (m *QueryGetMasterMinterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMasterMinterResponse) ProtoMessage()    {}
 func (*QueryGetMasterMinterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{7}
+	return fileDescriptor_0e79ac8fb1676620, []int{8}
 }
 func (m *QueryGetMasterMinterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,13 +482,14 @@ func (m *QueryGetMasterMinterResponse) GetMasterMinter() MasterMinter {
 
 type QueryGetMintersRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetMintersRequest) Reset()         { *m = QueryGetMintersRequest{} }
 func (m *QueryGetMintersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintersRequest) ProtoMessage()    {}
 func (*QueryGetMintersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{8}
+	return fileDescriptor_0e79ac8fb1676620, []int{9}
 }
 func (m *QueryGetMintersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,6 +525,13 @@ func (m *QueryGetMintersRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryGetMintersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMintersResponse struct {
 	Minters Minters `protobuf:"bytes,1,opt,name=minters,proto3" json:"minters"`
 }
@@ -426,7 +540,7 @@ func (m *QueryGetMintersResponse) Reset()         { *m = QueryGetMintersResponse
 func (m *QueryGetMintersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintersResponse) ProtoMessage()    {}
 func (*QueryGetMintersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{9}
+	return fileDescriptor_0e79ac8fb1676620, []int{10}
 }
 func (m *QueryGetMintersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,13 +578,14 @@ func (m *QueryGetMintersResponse) GetMinters() Minters {
 
 type QueryAllMintersRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllMintersRequest) Reset()         { *m = QueryAllMintersRequest{} }
 func (m *QueryAllMintersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMintersRequest) ProtoMessage()    {}
 func (*QueryAllMintersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{10}
+	return fileDescriptor_0e79ac8fb1676620, []int{11}
 }
 func (m *QueryAllMintersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +621,13 @@ func (m *QueryAllMintersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllMintersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryAllMintersResponse struct {
 	Minters    []Minters           `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -515,7 +637,7 @@ func (m *QueryAllMintersResponse) Reset()         { *m = QueryAllMintersResponse
 func (m *QueryAllMintersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMintersResponse) ProtoMessage()    {}
 func (*QueryAllMintersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{11}
+	return fileDescriptor_0e79ac8fb1676620, []int{12}
 }
 func (m *QueryAllMintersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,13 +681,14 @@ func (m *QueryAllMintersResponse) GetPagination() *query.PageResponse {
 }
 
 type QueryGetPauserRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetPauserRequest) Reset()         { *m = QueryGetPauserRequest{} }
 func (m *QueryGetPauserRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPauserRequest) ProtoMessage()    {}
 func (*QueryGetPauserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{12}
+	return fileDescriptor_0e79ac8fb1676620, []int{13}
 }
 func (m *QueryGetPauserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,6 +717,13 @@ func (m *QueryGetPauserRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetPauserRequest proto.InternalMessageInfo
 
+func (m *QueryGetPauserRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetPauserResponse struct {
 	Pauser Pauser `protobuf:"bytes,1,opt,name=pauser,proto3" json:"pauser"`
 }
@@ -602,7 +732,7 @@ func (m *QueryGetPauserResponse) Reset()         { *m = QueryGetPauserResponse{}
 func (m *QueryGetPauserResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPauserResponse) ProtoMessage()    {}
 func (*QueryGetPauserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{13}
+	return fileDescriptor_0e79ac8fb1676620, []int{14}
 }
 func (m *QueryGetPauserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,13 +769,14 @@ func (m *QueryGetPauserResponse) GetPauser() Pauser {
 }
 
 type QueryGetBlacklisterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetBlacklisterRequest) Reset()         { *m = QueryGetBlacklisterRequest{} }
 func (m *QueryGetBlacklisterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlacklisterRequest) ProtoMessage()    {}
 func (*QueryGetBlacklisterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{14}
+	return fileDescriptor_0e79ac8fb1676620, []int{15}
 }
 func (m *QueryGetBlacklisterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,6 +805,13 @@ func (m *QueryGetBlacklisterRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetBlacklisterRequest proto.InternalMessageInfo
 
+func (m *QueryGetBlacklisterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetBlacklisterResponse struct {
 	Blacklister Blacklister `protobuf:"bytes,1,opt,name=blacklister,proto3" json:"blacklister"`
 }
@@ -682,7 +820,7 @@ func (m *QueryGetBlacklisterResponse) Reset()         { *m = QueryGetBlacklister
 func (m *QueryGetBlacklisterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlacklisterResponse) ProtoMessage()    {}
 func (*QueryGetBlacklisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{15}
+	return fileDescriptor_0e79ac8fb1676620, []int{16}
 }
 func (m *QueryGetBlacklisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,13 +857,14 @@ func (m *QueryGetBlacklisterResponse) GetBlacklister() Blacklister {
 }
 
 type QueryGetOwnerRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetOwnerRequest) Reset()         { *m = QueryGetOwnerRequest{} }
 func (m *QueryGetOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerRequest) ProtoMessage()    {}
 func (*QueryGetOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{16}
+	return fileDescriptor_0e79ac8fb1676620, []int{17}
 }
 func (m *QueryGetOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,6 +893,13 @@ func (m *QueryGetOwnerRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetOwnerRequest proto.InternalMessageInfo
 
+func (m *QueryGetOwnerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetOwnerResponse struct {
 	Owner Owner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner"`
 }
@@ -762,7 +908,7 @@ func (m *QueryGetOwnerResponse) Reset()         { *m = QueryGetOwnerResponse{} }
 func (m *QueryGetOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerResponse) ProtoMessage()    {}
 func (*QueryGetOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{17}
+	return fileDescriptor_0e79ac8fb1676620, []int{18}
 }
 func (m *QueryGetOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,15 +944,104 @@ func (m *QueryGetOwnerResponse) GetOwner() Owner {
 	return Owner{}
 }
 
+type QueryGetPendingOwnerRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryGetPendingOwnerRequest) Reset()         { *m = QueryGetPendingOwnerRequest{} }
+func (m *QueryGetPendingOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOwnerRequest) ProtoMessage()    {}
+func (*QueryGetPendingOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{19}
+}
+func (m *QueryGetPendingOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOwnerRequest.Merge(m, src)
+}
+func (m *QueryGetPendingOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingOwnerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryGetPendingOwnerResponse struct {
+	PendingOwner PendingRole `protobuf:"bytes,1,opt,name=pendingOwner,proto3" json:"pendingOwner"`
+}
+
+func (m *QueryGetPendingOwnerResponse) Reset()         { *m = QueryGetPendingOwnerResponse{} }
+func (m *QueryGetPendingOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOwnerResponse) ProtoMessage()    {}
+func (*QueryGetPendingOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{20}
+}
+func (m *QueryGetPendingOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOwnerResponse.Merge(m, src)
+}
+func (m *QueryGetPendingOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingOwnerResponse) GetPendingOwner() PendingRole {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return PendingRole{}
+}
+
 type QueryGetMinterControllerRequest struct {
 	ControllerAddress string `protobuf:"bytes,1,opt,name=controllerAddress,proto3" json:"controllerAddress,omitempty"`
+	Denom             string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetMinterControllerRequest) Reset()         { *m = QueryGetMinterControllerRequest{} }
 func (m *QueryGetMinterControllerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMinterControllerRequest) ProtoMessage()    {}
 func (*QueryGetMinterControllerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{18}
+	return fileDescriptor_0e79ac8fb1676620, []int{21}
 }
 func (m *QueryGetMinterControllerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,6 +1077,13 @@ func (m *QueryGetMinterControllerRequest) GetControllerAddress() string {
 	return ""
 }
 
+func (m *QueryGetMinterControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMinterControllerResponse struct {
 	MinterController MinterController `protobuf:"bytes,1,opt,name=minterController,proto3" json:"minterController"`
 }
@@ -850,7 +1092,7 @@ func (m *QueryGetMinterControllerResponse) Reset()         { *m = QueryGetMinter
 func (m *QueryGetMinterControllerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMinterControllerResponse) ProtoMessage()    {}
 func (*QueryGetMinterControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{19}
+	return fileDescriptor_0e79ac8fb1676620, []int{22}
 }
 func (m *QueryGetMinterControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -888,13 +1130,14 @@ func (m *QueryGetMinterControllerResponse) GetMinterController() MinterControlle
 
 type QueryAllMinterControllerRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllMinterControllerRequest) Reset()         { *m = QueryAllMinterControllerRequest{} }
 func (m *QueryAllMinterControllerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMinterControllerRequest) ProtoMessage()    {}
 func (*QueryAllMinterControllerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{20}
+	return fileDescriptor_0e79ac8fb1676620, []int{23}
 }
 func (m *QueryAllMinterControllerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,6 +1173,13 @@ func (m *QueryAllMinterControllerRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllMinterControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryAllMinterControllerResponse struct {
 	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
 	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -939,7 +1189,7 @@ func (m *QueryAllMinterControllerResponse) Reset()         { *m = QueryAllMinter
 func (m *QueryAllMinterControllerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMinterControllerResponse) ProtoMessage()    {}
 func (*QueryAllMinterControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{21}
+	return fileDescriptor_0e79ac8fb1676620, []int{24}
 }
 func (m *QueryAllMinterControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,21 +1232,24 @@ func (m *QueryAllMinterControllerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryGetMintingDenomRequest struct {
+type QueryMintersOfControllerRequest struct {
+	ControllerAddress string             `protobuf:"bytes,1,opt,name=controllerAddress,proto3" json:"controllerAddress,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom             string             `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMintingDenomRequest) Reset()         { *m = QueryGetMintingDenomRequest{} }
-func (m *QueryGetMintingDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMintingDenomRequest) ProtoMessage()    {}
-func (*QueryGetMintingDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{22}
+func (m *QueryMintersOfControllerRequest) Reset()         { *m = QueryMintersOfControllerRequest{} }
+func (m *QueryMintersOfControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintersOfControllerRequest) ProtoMessage()    {}
+func (*QueryMintersOfControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{25}
 }
-func (m *QueryGetMintingDenomRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryMintersOfControllerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMintingDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMintersOfControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMintingDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMintersOfControllerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1006,34 +1259,56 @@ func (m *QueryGetMintingDenomRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMintingDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMintingDenomRequest.Merge(m, src)
+func (m *QueryMintersOfControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintersOfControllerRequest.Merge(m, src)
 }
-func (m *QueryGetMintingDenomRequest) XXX_Size() int {
+func (m *QueryMintersOfControllerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMintingDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMintingDenomRequest.DiscardUnknown(m)
+func (m *QueryMintersOfControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintersOfControllerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMintingDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryMintersOfControllerRequest proto.InternalMessageInfo
 
-type QueryGetMintingDenomResponse struct {
-	MintingDenom MintingDenom `protobuf:"bytes,1,opt,name=mintingDenom,proto3" json:"mintingDenom"`
+func (m *QueryMintersOfControllerRequest) GetControllerAddress() string {
+	if m != nil {
+		return m.ControllerAddress
+	}
+	return ""
 }
 
-func (m *QueryGetMintingDenomResponse) Reset()         { *m = QueryGetMintingDenomResponse{} }
-func (m *QueryGetMintingDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMintingDenomResponse) ProtoMessage()    {}
-func (*QueryGetMintingDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{23}
+func (m *QueryMintersOfControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryGetMintingDenomResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryMintersOfControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryMintersOfControllerResponse struct {
+	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintersOfControllerResponse) Reset()         { *m = QueryMintersOfControllerResponse{} }
+func (m *QueryMintersOfControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintersOfControllerResponse) ProtoMessage()    {}
+func (*QueryMintersOfControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{26}
+}
+func (m *QueryMintersOfControllerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMintingDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMintersOfControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMintingDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMintersOfControllerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1043,1711 +1318,12132 @@ func (m *QueryGetMintingDenomResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMintingDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMintingDenomResponse.Merge(m, src)
+func (m *QueryMintersOfControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintersOfControllerResponse.Merge(m, src)
 }
-func (m *QueryGetMintingDenomResponse) XXX_Size() int {
+func (m *QueryMintersOfControllerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMintingDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMintingDenomResponse.DiscardUnknown(m)
+func (m *QueryMintersOfControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintersOfControllerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMintingDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryMintersOfControllerResponse proto.InternalMessageInfo
 
-func (m *QueryGetMintingDenomResponse) GetMintingDenom() MintingDenom {
+func (m *QueryMintersOfControllerResponse) GetMinterController() []MinterController {
 	if m != nil {
-		return m.MintingDenom
+		return m.MinterController
 	}
-	return MintingDenom{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedRequest")
-	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedResponse")
-	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedRequest")
-	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedResponse")
-	proto.RegisterType((*QueryGetPausedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPausedRequest")
-	proto.RegisterType((*QueryGetPausedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPausedResponse")
-	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterRequest")
-	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterResponse")
-	proto.RegisterType((*QueryGetMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintersRequest")
-	proto.RegisterType((*QueryGetMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintersResponse")
-	proto.RegisterType((*QueryAllMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMintersRequest")
-	proto.RegisterType((*QueryAllMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMintersResponse")
-	proto.RegisterType((*QueryGetPauserRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPauserRequest")
-	proto.RegisterType((*QueryGetPauserResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPauserResponse")
-	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterRequest")
-	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterResponse")
-	proto.RegisterType((*QueryGetOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerRequest")
-	proto.RegisterType((*QueryGetOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerResponse")
-	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerRequest")
-	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerResponse")
-	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerRequest")
-	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerResponse")
-	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomRequest")
-	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomResponse")
+func (m *QueryMintersOfControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("circle/fiattokenfactory/v1/query.proto", fileDescriptor_0e79ac8fb1676620)
+type QueryControllersOfMinterRequest struct {
+	MinterAddress string             `protobuf:"bytes,1,opt,name=minterAddress,proto3" json:"minterAddress,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom         string             `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-var fileDescriptor_0e79ac8fb1676620 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0x34, 0x24, 0x11, 0x93, 0x0a, 0x85, 0xa1, 0xb4, 0x95, 0x49, 0x37, 0xe9, 0x54, 0xf9,
-	0x03, 0x4d, 0x6c, 0x76, 0x2b, 0x0a, 0x52, 0xa9, 0x20, 0x69, 0xa1, 0xa7, 0x2a, 0x65, 0x0f, 0x1c,
-	0x7a, 0x48, 0xf0, 0xee, 0x3a, 0xc6, 0xaa, 0xd7, 0xb3, 0x1d, 0x3b, 0x81, 0xa8, 0xea, 0xa5, 0x9f,
-	0x00, 0xa9, 0x07, 0x6e, 0xdc, 0x10, 0x07, 0x24, 0xc4, 0x01, 0xc4, 0x07, 0xe0, 0x52, 0x89, 0x4b,
-	0x24, 0x38, 0x70, 0x42, 0x28, 0xe1, 0x83, 0x20, 0xcf, 0x3c, 0xaf, 0xc7, 0xeb, 0x3f, 0x3b, 0x5e,
-	0xc2, 0x6d, 0x33, 0x7e, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0x37, 0xef, 0xbd, 0x18, 0xaf, 0x76, 0x3d,
-	0xde, 0xf5, 0x1d, 0x6b, 0xdf, 0xb3, 0xa3, 0x88, 0x3d, 0x72, 0x82, 0x7d, 0xbb, 0x1b, 0x31, 0x7e,
-	0x64, 0x1d, 0x36, 0xad, 0xc7, 0x07, 0x0e, 0x3f, 0x32, 0x07, 0x9c, 0x45, 0x8c, 0x18, 0xd2, 0xce,
-	0x1c, 0xb5, 0x33, 0x0f, 0x9b, 0xc6, 0x46, 0x85, 0x8f, 0x8e, 0x6f, 0x77, 0x1f, 0xf9, 0x5e, 0x18,
-	0x39, 0x3d, 0xe9, 0x49, 0xd3, 0x9a, 0x83, 0xb5, 0x59, 0x61, 0xdd, 0xb7, 0x63, 0xc3, 0xbd, 0xbe,
-	0x17, 0xa4, 0xf6, 0xad, 0x2a, 0x7b, 0x61, 0xb8, 0xd7, 0x65, 0x41, 0xc4, 0x99, 0xef, 0x0f, 0x31,
-	0xeb, 0x63, 0x31, 0xa1, 0x0e, 0x1b, 0x2f, 0x88, 0xbc, 0xc0, 0xdd, 0xeb, 0x39, 0x01, 0xeb, 0x83,
-	0x7d, 0x55, 0x75, 0xd9, 0x17, 0xc1, 0x90, 0xc1, 0x5a, 0x85, 0xdd, 0xc0, 0x3e, 0x08, 0x87, 0xc5,
-	0x1b, 0x6b, 0x98, 0x78, 0x7c, 0xab, 0xcb, 0xc2, 0x3e, 0x0b, 0xad, 0x8e, 0x1d, 0x3a, 0xf2, 0x45,
-	0x5a, 0x87, 0xcd, 0x8e, 0x13, 0xd9, 0xb1, 0x9d, 0xeb, 0x05, 0x76, 0xe4, 0xb1, 0x00, 0x6c, 0x2f,
-	0xb8, 0xcc, 0x65, 0xe2, 0xa7, 0x15, 0xff, 0x82, 0xd3, 0x45, 0x97, 0x31, 0xd7, 0x77, 0x2c, 0x7b,
-	0xe0, 0x59, 0x76, 0x10, 0xb0, 0x48, 0x40, 0xa0, 0x12, 0xf4, 0x26, 0x36, 0x3e, 0x89, 0xbd, 0xde,
-	0x73, 0xa2, 0xed, 0xf4, 0x15, 0xb7, 0x9d, 0xc7, 0x07, 0x4e, 0x18, 0x91, 0xcb, 0x78, 0xce, 0xee,
-	0xf5, 0xb8, 0x13, 0x86, 0x97, 0xd1, 0x32, 0x5a, 0x7f, 0xb9, 0x9d, 0xfc, 0x49, 0x03, 0xfc, 0x46,
-	0x21, 0x2e, 0x1c, 0xb0, 0x20, 0x74, 0xc8, 0x0e, 0x9e, 0x57, 0x14, 0x23, 0xc0, 0xf3, 0xad, 0x35,
-	0xb3, 0x5c, 0x7c, 0xa6, 0xe2, 0x65, 0xfb, 0xa5, 0x17, 0x7f, 0x2d, 0x4d, 0xb5, 0x55, 0x0f, 0xb4,
-	0x07, 0x3c, 0xb7, 0x7c, 0xbf, 0x80, 0xe7, 0xc7, 0x18, 0xa7, 0xd5, 0x80, 0x68, 0xab, 0xa6, 0x2c,
-	0x9d, 0x19, 0x97, 0xce, 0x94, 0x77, 0x00, 0x4a, 0x67, 0x3e, 0xb0, 0x5d, 0x07, 0xb0, 0x6d, 0x05,
-	0x49, 0x7f, 0x41, 0x90, 0xd6, 0x68, 0x98, 0xb2, 0xb4, 0xa6, 0xff, 0x5b, 0x5a, 0xe4, 0x5e, 0x86,
-	0xf8, 0xb9, 0xa4, 0x4c, 0x63, 0x88, 0x4b, 0x36, 0x19, 0xe6, 0x97, 0xf0, 0xeb, 0xc9, 0xfb, 0x78,
-	0x20, 0x84, 0x06, 0xe9, 0xd1, 0x87, 0xf8, 0xe2, 0xe8, 0x03, 0x48, 0xe6, 0x43, 0x3c, 0x2b, 0x35,
-	0x09, 0x05, 0xa3, 0x55, 0x79, 0x48, 0x2c, 0xa4, 0x00, 0x38, 0x7a, 0x25, 0x15, 0xc1, 0x7d, 0x71,
-	0x87, 0xef, 0x8b, 0x5b, 0x96, 0x84, 0xe6, 0x78, 0xb1, 0xf8, 0x31, 0x10, 0x68, 0xe3, 0xf3, 0x7d,
-	0xe5, 0x1c, 0x68, 0xac, 0x57, 0xd1, 0x50, 0xfd, 0x00, 0x99, 0x8c, 0x0f, 0xda, 0x4a, 0xd3, 0x95,
-	0x27, 0xe1, 0x78, 0x2d, 0xef, 0xe2, 0x4b, 0x39, 0x0c, 0x50, 0xbc, 0x83, 0xe7, 0xa0, 0x73, 0x00,
-	0xbb, 0x6b, 0x95, 0xec, 0xa4, 0x29, 0x10, 0x4b, 0x90, 0xf4, 0x33, 0xe0, 0xb4, 0xe5, 0xfb, 0x23,
-	0x9c, 0xce, 0x4a, 0xb7, 0xdf, 0x21, 0x48, 0x41, 0x0d, 0x51, 0x94, 0xc2, 0xf4, 0x64, 0x29, 0xfc,
-	0x7f, 0x3a, 0xe5, 0x65, 0x3a, 0xe5, 0x39, 0x9d, 0x72, 0x6d, 0x9d, 0xf2, 0x8c, 0x4e, 0x39, 0x5d,
-	0x2c, 0x6a, 0x72, 0xc3, 0xc8, 0x85, 0xad, 0x8c, 0x17, 0xdf, 0x79, 0x5e, 0xaf, 0x95, 0xf1, 0xfc,
-	0x9d, 0xe7, 0xf4, 0x22, 0xbe, 0x90, 0xc4, 0xdb, 0x89, 0x67, 0x47, 0xc2, 0xe3, 0xd3, 0xb4, 0x34,
-	0x70, 0x0e, 0x0c, 0x6e, 0xe3, 0x19, 0x31, 0x64, 0x20, 0xf6, 0xd5, 0xaa, 0xd8, 0x02, 0x09, 0x51,
-	0x25, 0x8a, 0xee, 0xe0, 0xa5, 0xac, 0xbc, 0xef, 0x0c, 0x07, 0x67, 0xa2, 0xc3, 0x0d, 0xfc, 0x6a,
-	0x3a, 0x4d, 0xb7, 0x32, 0xb7, 0x24, 0xff, 0x80, 0x3e, 0x43, 0x78, 0xb9, 0xdc, 0x23, 0x90, 0xde,
-	0xc5, 0x0b, 0xfd, 0x91, 0x67, 0xc0, 0x7f, 0x63, 0xbc, 0xfe, 0x52, 0x0c, 0xa4, 0x92, 0xf3, 0x45,
-	0x3d, 0xc8, 0x6a, 0xa8, 0xf8, 0x7c, 0x56, 0x67, 0x75, 0xbb, 0x7e, 0x4b, 0xf2, 0x2d, 0x8c, 0x55,
-	0x99, 0xef, 0xf4, 0x59, 0xe5, 0x7b, 0x76, 0x37, 0x50, 0x6d, 0xda, 0x72, 0xd5, 0xb9, 0x1b, 0x6f,
-	0x3a, 0x45, 0x4d, 0x3b, 0xf3, 0x58, 0x69, 0xda, 0xca, 0xb9, 0x56, 0xd3, 0x56, 0xec, 0x87, 0x4d,
-	0x5b, 0x39, 0x6b, 0xfd, 0xb4, 0x80, 0x67, 0x44, 0x50, 0xf2, 0x33, 0xc2, 0xf3, 0xca, 0xc8, 0x24,
-	0x37, 0xab, 0xfc, 0x96, 0x2f, 0x2e, 0xc6, 0xbb, 0xb5, 0x71, 0x32, 0x3d, 0xfa, 0xce, 0xb3, 0xdf,
-	0xff, 0x79, 0x7e, 0xce, 0x22, 0x9b, 0x56, 0xc0, 0x3a, 0x45, 0x0b, 0x9a, 0x32, 0xbd, 0xad, 0x27,
-	0x30, 0x41, 0x9e, 0x92, 0x1f, 0x10, 0x7e, 0x45, 0x71, 0xb7, 0xe5, 0xfb, 0x1a, 0xd4, 0x0b, 0x77,
-	0x19, 0x0d, 0xea, 0xc5, 0xcb, 0x09, 0xbd, 0x2e, 0xa8, 0xaf, 0x90, 0x6b, 0x1a, 0xd4, 0xc9, 0xd7,
-	0x08, 0xcf, 0xca, 0x99, 0x4e, 0x9a, 0x3a, 0xb5, 0xca, 0x2c, 0x15, 0x46, 0xab, 0x0e, 0x04, 0xe8,
-	0xad, 0x0a, 0x7a, 0xcb, 0xa4, 0x51, 0x46, 0x4f, 0x2e, 0x15, 0xe4, 0x47, 0x84, 0xcf, 0xab, 0x63,
-	0x9e, 0x68, 0xbd, 0xcb, 0x82, 0xfd, 0xc3, 0x78, 0xaf, 0x3e, 0x10, 0xb8, 0x6e, 0x0a, 0xae, 0x6b,
-	0x64, 0xa5, 0x8c, 0x6b, 0xe6, 0x5f, 0x16, 0xf2, 0x2d, 0xc2, 0x73, 0x30, 0x38, 0x89, 0x56, 0x69,
-	0xb2, 0x6b, 0x80, 0x71, 0xa3, 0x16, 0x06, 0x38, 0x36, 0x05, 0xc7, 0xeb, 0xe4, 0xcd, 0x52, 0x8e,
-	0x12, 0xa0, 0xa8, 0xf4, 0x1b, 0x84, 0x31, 0xb8, 0x89, 0x15, 0xda, 0xd2, 0x51, 0x5a, 0x6d, 0xaa,
-	0xf9, 0x15, 0x84, 0xae, 0x09, 0xaa, 0x57, 0xc9, 0xd2, 0x18, 0xaa, 0xa9, 0x2a, 0x79, 0x0d, 0x55,
-	0xf2, 0xfa, 0xaa, 0xe4, 0x35, 0x55, 0xc9, 0xc9, 0xf7, 0x99, 0xc6, 0xc4, 0xeb, 0x36, 0x26, 0x3e,
-	0x61, 0x63, 0xe2, 0x93, 0xdc, 0x6e, 0x4e, 0x9e, 0x23, 0x3c, 0x23, 0x36, 0x01, 0xf2, 0xb6, 0x4e,
-	0x3c, 0x75, 0x0d, 0x31, 0x9a, 0x35, 0x10, 0xc0, 0x6d, 0x45, 0x70, 0x5b, 0x22, 0x57, 0xca, 0xb8,
-	0x89, 0x45, 0x84, 0xfc, 0x81, 0xf0, 0xc2, 0xe8, 0xbc, 0x23, 0xb7, 0xf4, 0xb5, 0x9f, 0x9b, 0xf0,
-	0xc6, 0xfb, 0x93, 0x81, 0x81, 0xf6, 0x47, 0x82, 0xf6, 0x07, 0xe4, 0x76, 0xb5, 0x2c, 0x95, 0x0f,
-	0x0d, 0xd6, 0x93, 0xdc, 0x36, 0xf4, 0x94, 0xfc, 0x8a, 0xf0, 0x6b, 0xa3, 0x31, 0xe2, 0xeb, 0x75,
-	0x4b, 0xff, 0xaa, 0x4c, 0x92, 0x59, 0xc5, 0x32, 0xa2, 0xdb, 0x1b, 0x94, 0xcc, 0x64, 0xdb, 0x55,
-	0x86, 0xb2, 0x66, 0xdb, 0xcd, 0x6f, 0x10, 0x9a, 0x6d, 0xb7, 0x60, 0xb7, 0xd0, 0x68, 0xbb, 0xea,
-	0xb7, 0x99, 0xed, 0xdd, 0x17, 0x27, 0x0d, 0x74, 0x7c, 0xd2, 0x40, 0x7f, 0x9f, 0x34, 0xd0, 0x57,
-	0xa7, 0x8d, 0xa9, 0xe3, 0xd3, 0xc6, 0xd4, 0x9f, 0xa7, 0x8d, 0xa9, 0x87, 0x77, 0x5d, 0x2f, 0xfa,
-	0xfc, 0xa0, 0x63, 0x76, 0x59, 0xdf, 0x92, 0x64, 0xf6, 0xbd, 0x40, 0x3a, 0xdd, 0xcc, 0x39, 0xfd,
-	0x32, 0x1f, 0x27, 0x3a, 0x1a, 0x38, 0x61, 0x67, 0x56, 0x7c, 0x22, 0xb9, 0xf1, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xfe, 0x19, 0x02, 0xd5, 0x5c, 0x13, 0x00, 0x00,
+func (m *QueryControllersOfMinterRequest) Reset()         { *m = QueryControllersOfMinterRequest{} }
+func (m *QueryControllersOfMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryControllersOfMinterRequest) ProtoMessage()    {}
+func (*QueryControllersOfMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{27}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a Blacklisted by index.
-	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+func (m *QueryControllersOfMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryControllersOfMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllersOfMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryControllersOfMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllersOfMinterRequest.Merge(m, src)
 }
-
-func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
-	out := new(QueryGetBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklisted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryControllersOfMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllersOfMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllersOfMinterRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
-	out := new(QueryAllBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/BlacklistedAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryControllersOfMinterRequest proto.InternalMessageInfo
+
+func (m *QueryControllersOfMinterRequest) GetMinterAddress() string {
+	if m != nil {
+		return m.MinterAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
-	out := new(QueryGetPausedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Paused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryControllersOfMinterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
-	out := new(QueryGetMasterMinterResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MasterMinter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryControllersOfMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
-	out := new(QueryGetMintersResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Minters", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryControllersOfMinterResponse struct {
+	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
-	out := new(QueryAllMintersResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintersAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryControllersOfMinterResponse) Reset()         { *m = QueryControllersOfMinterResponse{} }
+func (m *QueryControllersOfMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryControllersOfMinterResponse) ProtoMessage()    {}
+func (*QueryControllersOfMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{28}
+}
+func (m *QueryControllersOfMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllersOfMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllersOfMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryControllersOfMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllersOfMinterResponse.Merge(m, src)
+}
+func (m *QueryControllersOfMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllersOfMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllersOfMinterResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
-	out := new(QueryGetPauserResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Pauser", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryControllersOfMinterResponse proto.InternalMessageInfo
+
+func (m *QueryControllersOfMinterResponse) GetMinterController() []MinterController {
+	if m != nil {
+		return m.MinterController
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
-	out := new(QueryGetBlacklisterResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklister", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryControllersOfMinterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
-	out := new(QueryGetOwnerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Owner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetMinterControllerByMinterRequest struct {
+	MinterAddress string             `protobuf:"bytes,1,opt,name=minterAddress,proto3" json:"minterAddress,omitempty"`
+	Denom         string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
-	out := new(QueryGetMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterController", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetMinterControllerByMinterRequest) Reset() {
+	*m = QueryGetMinterControllerByMinterRequest{}
+}
+func (m *QueryGetMinterControllerByMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterControllerByMinterRequest) ProtoMessage()    {}
+func (*QueryGetMinterControllerByMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{29}
+}
+func (m *QueryGetMinterControllerByMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMinterControllerByMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterControllerByMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetMinterControllerByMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterControllerByMinterRequest.Merge(m, src)
+}
+func (m *QueryGetMinterControllerByMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMinterControllerByMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterControllerByMinterRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
-	out := new(QueryAllMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterControllerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetMinterControllerByMinterRequest proto.InternalMessageInfo
+
+func (m *QueryGetMinterControllerByMinterRequest) GetMinterAddress() string {
+	if m != nil {
+		return m.MinterAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
-	out := new(QueryGetMintingDenomResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintingDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetMinterControllerByMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a Blacklisted by index.
-	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+func (m *QueryGetMinterControllerByMinterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGetMinterControllerByMinterResponse struct {
+	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
-}
-func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) Reset() {
+	*m = QueryGetMinterControllerByMinterResponse{}
 }
-func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterControllerByMinterResponse) ProtoMessage()    {}
+func (*QueryGetMinterControllerByMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{30}
 }
-func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterControllerByMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterControllerByMinterResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
-}
-func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
-}
-func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
-}
-func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
-}
-func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+func (m *QueryGetMinterControllerByMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterControllerByMinterResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGetMinterControllerByMinterResponse proto.InternalMessageInfo
 
-func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklisted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklisted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+func (m *QueryGetMinterControllerByMinterResponse) GetMinterController() []MinterController {
+	if m != nil {
+		return m.MinterController
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlacklistedAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/BlacklistedAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
+func (m *QueryGetMinterControllerByMinterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Paused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Paused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetMintingDenomRequest struct {
 }
 
-func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMasterMinterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MasterMinter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MasterMinter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+func (m *QueryGetMintingDenomRequest) Reset()         { *m = QueryGetMintingDenomRequest{} }
+func (m *QueryGetMintingDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintingDenomRequest) ProtoMessage()    {}
+func (*QueryGetMintingDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{31}
+}
+func (m *QueryGetMintingDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintingDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintingDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetMintingDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintingDenomRequest.Merge(m, src)
+}
+func (m *QueryGetMintingDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintingDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintingDenomRequest.DiscardUnknown(m)
 }
 
-func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Minters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Minters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryGetMintingDenomRequest proto.InternalMessageInfo
+
+type QueryGetMintingDenomResponse struct {
+	MintingDenom MintingDenom `protobuf:"bytes,1,opt,name=mintingDenom,proto3" json:"mintingDenom"`
 }
 
-func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintersAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MintersAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
+func (m *QueryGetMintingDenomResponse) Reset()         { *m = QueryGetMintingDenomResponse{} }
+func (m *QueryGetMintingDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintingDenomResponse) ProtoMessage()    {}
+func (*QueryGetMintingDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{32}
+}
+func (m *QueryGetMintingDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintingDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintingDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetMintingDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintingDenomResponse.Merge(m, src)
+}
+func (m *QueryGetMintingDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintingDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintingDenomResponse.DiscardUnknown(m)
 }
 
-func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPauserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pauser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Pauser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+var xxx_messageInfo_QueryGetMintingDenomResponse proto.InternalMessageInfo
+
+func (m *QueryGetMintingDenomResponse) GetMintingDenom() MintingDenom {
+	if m != nil {
+		return m.MintingDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return MintingDenom{}
 }
 
-func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklister(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklister",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetFiatTokenRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Owner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Owner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
+func (m *QueryGetFiatTokenRequest) Reset()         { *m = QueryGetFiatTokenRequest{} }
+func (m *QueryGetFiatTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFiatTokenRequest) ProtoMessage()    {}
+func (*QueryGetFiatTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{33}
+}
+func (m *QueryGetFiatTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFiatTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFiatTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetFiatTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFiatTokenRequest.Merge(m, src)
+}
+func (m *QueryGetFiatTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFiatTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFiatTokenRequest.DiscardUnknown(m)
 }
 
-func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterController(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterController",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+var xxx_messageInfo_QueryGetFiatTokenRequest proto.InternalMessageInfo
+
+func (m *QueryGetFiatTokenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterControllerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterControllerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetFiatTokenResponse struct {
+	FiatToken FiatToken `protobuf:"bytes,1,opt,name=fiatToken,proto3" json:"fiatToken"`
 }
 
-func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintingDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintingDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MintingDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+func (m *QueryGetFiatTokenResponse) Reset()         { *m = QueryGetFiatTokenResponse{} }
+func (m *QueryGetFiatTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFiatTokenResponse) ProtoMessage()    {}
+func (*QueryGetFiatTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{34}
+}
+func (m *QueryGetFiatTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFiatTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFiatTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "circle.fiattokenfactory.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Blacklisted",
-			Handler:    _Query_Blacklisted_Handler,
-		},
-		{
-			MethodName: "BlacklistedAll",
-			Handler:    _Query_BlacklistedAll_Handler,
-		},
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "MasterMinter",
-			Handler:    _Query_MasterMinter_Handler,
-		},
-		{
-			MethodName: "Minters",
-			Handler:    _Query_Minters_Handler,
-		},
-		{
-			MethodName: "MintersAll",
-			Handler:    _Query_MintersAll_Handler,
-		},
-		{
-			MethodName: "Pauser",
-			Handler:    _Query_Pauser_Handler,
-		},
-		{
-			MethodName: "Blacklister",
-			Handler:    _Query_Blacklister_Handler,
-		},
-		{
-			MethodName: "Owner",
-			Handler:    _Query_Owner_Handler,
-		},
-		{
-			MethodName: "MinterController",
-			Handler:    _Query_MinterController_Handler,
-		},
-		{
-			MethodName: "MinterControllerAll",
-			Handler:    _Query_MinterControllerAll_Handler,
-		},
-		{
-			MethodName: "MintingDenom",
-			Handler:    _Query_MintingDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "circle/fiattokenfactory/v1/query.proto",
+func (m *QueryGetFiatTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFiatTokenResponse.Merge(m, src)
+}
+func (m *QueryGetFiatTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFiatTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFiatTokenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetFiatTokenResponse proto.InternalMessageInfo
+
+func (m *QueryGetFiatTokenResponse) GetFiatToken() FiatToken {
+	if m != nil {
+		return m.FiatToken
 	}
-	return dAtA[:n], nil
+	return FiatToken{}
 }
 
-func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllFiatTokenRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllFiatTokenRequest) Reset()         { *m = QueryAllFiatTokenRequest{} }
+func (m *QueryAllFiatTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFiatTokenRequest) ProtoMessage()    {}
+func (*QueryAllFiatTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{35}
+}
+func (m *QueryAllFiatTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFiatTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFiatTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllFiatTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFiatTokenRequest.Merge(m, src)
+}
+func (m *QueryAllFiatTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFiatTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFiatTokenRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllFiatTokenRequest proto.InternalMessageInfo
+
+func (m *QueryAllFiatTokenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllFiatTokenResponse struct {
+	FiatToken  []FiatToken         `protobuf:"bytes,1,rep,name=fiatToken,proto3" json:"fiatToken"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllFiatTokenResponse) Reset()         { *m = QueryAllFiatTokenResponse{} }
+func (m *QueryAllFiatTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFiatTokenResponse) ProtoMessage()    {}
+func (*QueryAllFiatTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{36}
+}
+func (m *QueryAllFiatTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFiatTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFiatTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllFiatTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFiatTokenResponse.Merge(m, src)
+}
+func (m *QueryAllFiatTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFiatTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFiatTokenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllFiatTokenResponse proto.InternalMessageInfo
+
+func (m *QueryAllFiatTokenResponse) GetFiatToken() []FiatToken {
+	if m != nil {
+		return m.FiatToken
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllFiatTokenResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryMintRateLimitRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryMintRateLimitRequest) Reset()         { *m = QueryMintRateLimitRequest{} }
+func (m *QueryMintRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRateLimitRequest) ProtoMessage()    {}
+func (*QueryMintRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{37}
+}
+func (m *QueryMintRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMintRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRateLimitRequest.Merge(m, src)
+}
+func (m *QueryMintRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryMintRateLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Blacklisted) > 0 {
-		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryMintRateLimitResponse struct {
+	RateLimit MintRateLimit `protobuf:"bytes,1,opt,name=rateLimit,proto3" json:"rateLimit"`
+	// amount that can still be minted in the rolling window
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+	// block height or unix time at which the oldest amount minted leaves the window, zero when nothing
+	// was minted within the window
+	NextRelease int64 `protobuf:"varint,3,opt,name=nextRelease,proto3" json:"nextRelease,omitempty"`
 }
 
-func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintRateLimitResponse) Reset()         { *m = QueryMintRateLimitResponse{} }
+func (m *QueryMintRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRateLimitResponse) ProtoMessage()    {}
+func (*QueryMintRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{38}
+}
+func (m *QueryMintRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRateLimitResponse.Merge(m, src)
+}
+func (m *QueryMintRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMintRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryMintRateLimitResponse) GetRateLimit() MintRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return MintRateLimit{}
 }
 
-func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintRateLimitResponse) GetNextRelease() int64 {
+	if m != nil {
+		return m.NextRelease
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMaxSupplyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMaxSupplyRequest) Reset()         { *m = QueryMaxSupplyRequest{} }
+func (m *QueryMaxSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxSupplyRequest) ProtoMessage()    {}
+func (*QueryMaxSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{39}
+}
+func (m *QueryMaxSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMaxSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxSupplyRequest.Merge(m, src)
 }
-
-func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMaxSupplyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMaxSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxSupplyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMaxSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryMaxSupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMaxSupplyResponse struct {
+	MaxSupply MaxSupply  `protobuf:"bytes,1,opt,name=maxSupply,proto3" json:"maxSupply"`
+	Supply    types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+	// amount that can still be minted before the cap is reached
+	Headroom cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=headroom,proto3,customtype=cosmossdk.io/math.Int" json:"headroom"`
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMaxSupplyResponse) Reset()         { *m = QueryMaxSupplyResponse{} }
+func (m *QueryMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxSupplyResponse) ProtoMessage()    {}
+func (*QueryMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{40}
+}
+func (m *QueryMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxSupplyResponse.Merge(m, src)
 }
-
-func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxSupplyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMaxSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMaxSupplyResponse) GetMaxSupply() MaxSupply {
+	if m != nil {
+		return m.MaxSupply
 	}
-	return len(dAtA) - i, nil
+	return MaxSupply{}
 }
 
-func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMaxSupplyResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingMasterMinterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetPendingMasterMinterRequest) Reset()         { *m = QueryGetPendingMasterMinterRequest{} }
+func (m *QueryGetPendingMasterMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingMasterMinterRequest) ProtoMessage()    {}
+func (*QueryGetPendingMasterMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{41}
+}
+func (m *QueryGetPendingMasterMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingMasterMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingMasterMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPendingMasterMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingMasterMinterRequest.Merge(m, src)
 }
-
-func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPendingMasterMinterRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetPendingMasterMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingMasterMinterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingMasterMinterRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingMasterMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingMasterMinterResponse struct {
+	PendingMasterMinter PendingRole `protobuf:"bytes,1,opt,name=pendingMasterMinter,proto3" json:"pendingMasterMinter"`
 }
 
-func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Minters) > 0 {
-		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPendingMasterMinterResponse) Reset()         { *m = QueryGetPendingMasterMinterResponse{} }
+func (m *QueryGetPendingMasterMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingMasterMinterResponse) ProtoMessage()    {}
+func (*QueryGetPendingMasterMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{42}
+}
+func (m *QueryGetPendingMasterMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingMasterMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingMasterMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPendingMasterMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingMasterMinterResponse.Merge(m, src)
 }
-
-func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPendingMasterMinterResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetPendingMasterMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingMasterMinterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingMasterMinterResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingMasterMinterResponse) GetPendingMasterMinter() PendingRole {
+	if m != nil {
+		return m.PendingMasterMinter
 	}
-	return dAtA[:n], nil
+	return PendingRole{}
 }
 
-func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingPauserRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetPendingPauserRequest) Reset()         { *m = QueryGetPendingPauserRequest{} }
+func (m *QueryGetPendingPauserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingPauserRequest) ProtoMessage()    {}
+func (*QueryGetPendingPauserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{43}
+}
+func (m *QueryGetPendingPauserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingPauserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingPauserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingPauserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingPauserRequest.Merge(m, src)
+}
+func (m *QueryGetPendingPauserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingPauserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingPauserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingPauserRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingPauserRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingPauserResponse struct {
+	PendingPauser PendingRole `protobuf:"bytes,1,opt,name=pendingPauser,proto3" json:"pendingPauser"`
 }
 
-func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetPendingPauserResponse) Reset()         { *m = QueryGetPendingPauserResponse{} }
+func (m *QueryGetPendingPauserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingPauserResponse) ProtoMessage()    {}
+func (*QueryGetPendingPauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{44}
+}
+func (m *QueryGetPendingPauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingPauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingPauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingPauserResponse.Merge(m, src)
+}
+func (m *QueryGetPendingPauserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingPauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingPauserResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingPauserResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingPauserResponse) GetPendingPauser() PendingRole {
+	if m != nil {
+		return m.PendingPauser
 	}
-	return dAtA[:n], nil
+	return PendingRole{}
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingBlacklisterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetPendingBlacklisterRequest) Reset()         { *m = QueryGetPendingBlacklisterRequest{} }
+func (m *QueryGetPendingBlacklisterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingBlacklisterRequest) ProtoMessage()    {}
+func (*QueryGetPendingBlacklisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{45}
+}
+func (m *QueryGetPendingBlacklisterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingBlacklisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingBlacklisterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingBlacklisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingBlacklisterRequest.Merge(m, src)
+}
+func (m *QueryGetPendingBlacklisterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingBlacklisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingBlacklisterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingBlacklisterRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingBlacklisterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingBlacklisterResponse struct {
+	PendingBlacklister PendingRole `protobuf:"bytes,1,opt,name=pendingBlacklister,proto3" json:"pendingBlacklister"`
 }
 
-func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetPendingBlacklisterResponse) Reset()         { *m = QueryGetPendingBlacklisterResponse{} }
+func (m *QueryGetPendingBlacklisterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingBlacklisterResponse) ProtoMessage()    {}
+func (*QueryGetPendingBlacklisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{46}
+}
+func (m *QueryGetPendingBlacklisterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingBlacklisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingBlacklisterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingBlacklisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingBlacklisterResponse.Merge(m, src)
+}
+func (m *QueryGetPendingBlacklisterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingBlacklisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingBlacklisterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingBlacklisterResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingBlacklisterResponse) GetPendingBlacklister() PendingRole {
+	if m != nil {
+		return m.PendingBlacklister
 	}
-	return dAtA[:n], nil
+	return PendingRole{}
 }
 
-func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetWiperRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetWiperRequest) Reset()         { *m = QueryGetWiperRequest{} }
+func (m *QueryGetWiperRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWiperRequest) ProtoMessage()    {}
+func (*QueryGetWiperRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{47}
+}
+func (m *QueryGetWiperRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWiperRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWiperRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetWiperRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWiperRequest.Merge(m, src)
+}
+func (m *QueryGetWiperRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWiperRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWiperRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetWiperRequest proto.InternalMessageInfo
+
+func (m *QueryGetWiperRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetWiperResponse struct {
+	Wiper Wiper `protobuf:"bytes,1,opt,name=wiper,proto3" json:"wiper"`
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ControllerAddress) > 0 {
-		i -= len(m.ControllerAddress)
-		copy(dAtA[i:], m.ControllerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetWiperResponse) Reset()         { *m = QueryGetWiperResponse{} }
+func (m *QueryGetWiperResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWiperResponse) ProtoMessage()    {}
+func (*QueryGetWiperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{48}
+}
+func (m *QueryGetWiperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWiperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWiperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetWiperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWiperResponse.Merge(m, src)
+}
+func (m *QueryGetWiperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWiperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWiperResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetWiperResponse proto.InternalMessageInfo
+
+func (m *QueryGetWiperResponse) GetWiper() Wiper {
+	if m != nil {
+		return m.Wiper
 	}
-	return dAtA[:n], nil
+	return Wiper{}
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingWiperRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetPendingWiperRequest) Reset()         { *m = QueryGetPendingWiperRequest{} }
+func (m *QueryGetPendingWiperRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingWiperRequest) ProtoMessage()    {}
+func (*QueryGetPendingWiperRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{49}
+}
+func (m *QueryGetPendingWiperRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingWiperRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingWiperRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingWiperRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingWiperRequest.Merge(m, src)
+}
+func (m *QueryGetPendingWiperRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingWiperRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingWiperRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingWiperRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingWiperRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingWiperResponse struct {
+	PendingWiper PendingRole `protobuf:"bytes,1,opt,name=pendingWiper,proto3" json:"pendingWiper"`
 }
 
-func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPendingWiperResponse) Reset()         { *m = QueryGetPendingWiperResponse{} }
+func (m *QueryGetPendingWiperResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingWiperResponse) ProtoMessage()    {}
+func (*QueryGetPendingWiperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{50}
+}
+func (m *QueryGetPendingWiperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingWiperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingWiperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingWiperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingWiperResponse.Merge(m, src)
+}
+func (m *QueryGetPendingWiperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingWiperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingWiperResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingWiperResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingWiperResponse) GetPendingWiper() PendingRole {
+	if m != nil {
+		return m.PendingWiper
 	}
-	return dAtA[:n], nil
+	return PendingRole{}
 }
 
-func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetFrozenRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetFrozenRequest) Reset()         { *m = QueryGetFrozenRequest{} }
+func (m *QueryGetFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFrozenRequest) ProtoMessage()    {}
+func (*QueryGetFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{51}
+}
+func (m *QueryGetFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.MinterController) > 0 {
-		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryGetFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFrozenRequest.Merge(m, src)
+}
+func (m *QueryGetFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFrozenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryGetFrozenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetFrozenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetFrozenResponse struct {
+	Frozen Frozen `protobuf:"bytes,1,opt,name=frozen,proto3" json:"frozen"`
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetFrozenResponse) Reset()         { *m = QueryGetFrozenResponse{} }
+func (m *QueryGetFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFrozenResponse) ProtoMessage()    {}
+func (*QueryGetFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{52}
+}
+func (m *QueryGetFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFrozenResponse.Merge(m, src)
+}
+func (m *QueryGetFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFrozenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryGetFrozenResponse) GetFrozen() Frozen {
+	if m != nil {
+		return m.Frozen
 	}
-	return dAtA[:n], nil
+	return Frozen{}
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllFrozenRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllFrozenRequest) Reset()         { *m = QueryAllFrozenRequest{} }
+func (m *QueryAllFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFrozenRequest) ProtoMessage()    {}
+func (*QueryAllFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{53}
+}
+func (m *QueryAllFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFrozenRequest.Merge(m, src)
+}
+func (m *QueryAllFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFrozenRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryAllFrozenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGetBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *QueryGetBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllFrozenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = m.Blacklisted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAllFrozenResponse struct {
+	Frozen     []Frozen            `protobuf:"bytes,1,rep,name=frozen,proto3" json:"frozen"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Blacklisted) > 0 {
-		for _, e := range m.Blacklisted {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllFrozenResponse) Reset()         { *m = QueryAllFrozenResponse{} }
+func (m *QueryAllFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFrozenResponse) ProtoMessage()    {}
+func (*QueryAllFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{54}
+}
+func (m *QueryAllFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryAllFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFrozenResponse.Merge(m, src)
+}
+func (m *QueryAllFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFrozenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryAllFrozenResponse) GetFrozen() []Frozen {
+	if m != nil {
+		return m.Frozen
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryGetPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllFrozenResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Paused.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetMasterMinterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryGetAllowedChannelRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMasterMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllowedChannelRequest) Reset()         { *m = QueryGetAllowedChannelRequest{} }
+func (m *QueryGetAllowedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedChannelRequest) ProtoMessage()    {}
+func (*QueryGetAllowedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{55}
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.MasterMinter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedChannelRequest.Merge(m, src)
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedChannelRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryGetAllowedChannelRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllowedChannelRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllowedChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	var l int
-	_ = l
-	l = m.Minters.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetAllowedChannelRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Minters) > 0 {
-		for _, e := range m.Minters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryGetAllowedChannelResponse struct {
+	AllowedChannel AllowedChannel `protobuf:"bytes,1,opt,name=allowed_channel,json=allowedChannel,proto3" json:"allowed_channel"`
+}
+
+func (m *QueryGetAllowedChannelResponse) Reset()         { *m = QueryGetAllowedChannelResponse{} }
+func (m *QueryGetAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedChannelResponse) ProtoMessage()    {}
+func (*QueryGetAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{56}
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedChannelResponse.Merge(m, src)
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedChannelResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPauserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetAllowedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllowedChannelResponse) GetAllowedChannel() AllowedChannel {
+	if m != nil {
+		return m.AllowedChannel
 	}
-	var l int
-	_ = l
-	return n
+	return AllowedChannel{}
 }
 
-func (m *QueryGetPauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pauser.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryAllAllowedChannelRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetBlacklisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAllowedChannelRequest) Reset()         { *m = QueryAllAllowedChannelRequest{} }
+func (m *QueryAllAllowedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedChannelRequest) ProtoMessage()    {}
+func (*QueryAllAllowedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{57}
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedChannelRequest.Merge(m, src)
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedChannelRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllAllowedChannelRequest proto.InternalMessageInfo
+
+func (m *QueryAllAllowedChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Blacklister.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAllowedChannelRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryGetOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Owner.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryAllAllowedChannelResponse struct {
+	AllowedChannels []AllowedChannel    `protobuf:"bytes,1,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// whether transfers are restricted to the allowed channels
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *QueryGetMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ControllerAddress)
+func (m *QueryAllAllowedChannelResponse) Reset()         { *m = QueryAllAllowedChannelResponse{} }
+func (m *QueryAllAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedChannelResponse) ProtoMessage()    {}
+func (*QueryAllAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{58}
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedChannelResponse.Merge(m, src)
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAllowedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllAllowedChannelResponse) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *QueryAllAllowedChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllAllowedChannelResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type QueryChannelRateLimitRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryChannelRateLimitRequest) Reset()         { *m = QueryChannelRateLimitRequest{} }
+func (m *QueryChannelRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitRequest) ProtoMessage()    {}
+func (*QueryChannelRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{59}
+}
+func (m *QueryChannelRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitRequest.Merge(m, src)
+}
+func (m *QueryChannelRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryChannelRateLimitRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryChannelRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryChannelRateLimitResponse struct {
+	RateLimit ChannelRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// amounts received and sent over the channel within the rolling window
+	Flow ChannelFlow `protobuf:"bytes,2,opt,name=flow,proto3" json:"flow"`
+	// amount that can still be received in the rolling window, empty when inflow is not limited
+	RemainingInflow *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_inflow,json=remainingInflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_inflow,omitempty"`
+	// amount that can still be sent in the rolling window, empty when outflow is not limited
+	RemainingOutflow *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_outflow,json=remainingOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_outflow,omitempty"`
+	// block height or unix time at which the oldest amount transferred leaves the window, zero when
+	// nothing was transferred within the window
+	NextRelease int64 `protobuf:"varint,5,opt,name=next_release,json=nextRelease,proto3" json:"next_release,omitempty"`
+}
+
+func (m *QueryChannelRateLimitResponse) Reset()         { *m = QueryChannelRateLimitResponse{} }
+func (m *QueryChannelRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitResponse) ProtoMessage()    {}
+func (*QueryChannelRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{60}
+}
+func (m *QueryChannelRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitResponse.Merge(m, src)
+}
+func (m *QueryChannelRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitResponse) GetRateLimit() ChannelRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return ChannelRateLimit{}
+}
+
+func (m *QueryChannelRateLimitResponse) GetFlow() ChannelFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return ChannelFlow{}
+}
+
+func (m *QueryChannelRateLimitResponse) GetNextRelease() int64 {
+	if m != nil {
+		return m.NextRelease
+	}
+	return 0
+}
+
+type QueryAllChannelRateLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAllChannelRateLimitRequest) Reset()         { *m = QueryAllChannelRateLimitRequest{} }
+func (m *QueryAllChannelRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelRateLimitRequest) ProtoMessage()    {}
+func (*QueryAllChannelRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{61}
+}
+func (m *QueryAllChannelRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelRateLimitRequest.Merge(m, src)
+}
+func (m *QueryAllChannelRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAllChannelRateLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllChannelRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryAllChannelRateLimitResponse struct {
+	RateLimits []ChannelRateLimit  `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllChannelRateLimitResponse) Reset()         { *m = QueryAllChannelRateLimitResponse{} }
+func (m *QueryAllChannelRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelRateLimitResponse) ProtoMessage()    {}
+func (*QueryAllChannelRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{62}
+}
+func (m *QueryAllChannelRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelRateLimitResponse.Merge(m, src)
+}
+func (m *QueryAllChannelRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelRateLimitResponse) GetRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *QueryAllChannelRateLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetQuarantinedRefundRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryGetQuarantinedRefundRequest) Reset()         { *m = QueryGetQuarantinedRefundRequest{} }
+func (m *QueryGetQuarantinedRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQuarantinedRefundRequest) ProtoMessage()    {}
+func (*QueryGetQuarantinedRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{63}
+}
+func (m *QueryGetQuarantinedRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQuarantinedRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQuarantinedRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetQuarantinedRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQuarantinedRefundRequest.Merge(m, src)
+}
+func (m *QueryGetQuarantinedRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQuarantinedRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQuarantinedRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetQuarantinedRefundRequest proto.InternalMessageInfo
+
+func (m *QueryGetQuarantinedRefundRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryGetQuarantinedRefundRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryGetQuarantinedRefundResponse struct {
+	QuarantinedRefund QuarantinedRefund `protobuf:"bytes,1,opt,name=quarantined_refund,json=quarantinedRefund,proto3" json:"quarantined_refund"`
+}
+
+func (m *QueryGetQuarantinedRefundResponse) Reset()         { *m = QueryGetQuarantinedRefundResponse{} }
+func (m *QueryGetQuarantinedRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQuarantinedRefundResponse) ProtoMessage()    {}
+func (*QueryGetQuarantinedRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{64}
+}
+func (m *QueryGetQuarantinedRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQuarantinedRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQuarantinedRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetQuarantinedRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQuarantinedRefundResponse.Merge(m, src)
+}
+func (m *QueryGetQuarantinedRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQuarantinedRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQuarantinedRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetQuarantinedRefundResponse proto.InternalMessageInfo
+
+func (m *QueryGetQuarantinedRefundResponse) GetQuarantinedRefund() QuarantinedRefund {
+	if m != nil {
+		return m.QuarantinedRefund
+	}
+	return QuarantinedRefund{}
+}
+
+type QueryAllQuarantinedRefundRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAllQuarantinedRefundRequest) Reset()         { *m = QueryAllQuarantinedRefundRequest{} }
+func (m *QueryAllQuarantinedRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQuarantinedRefundRequest) ProtoMessage()    {}
+func (*QueryAllQuarantinedRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{65}
+}
+func (m *QueryAllQuarantinedRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQuarantinedRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQuarantinedRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllQuarantinedRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQuarantinedRefundRequest.Merge(m, src)
+}
+func (m *QueryAllQuarantinedRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQuarantinedRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQuarantinedRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllQuarantinedRefundRequest proto.InternalMessageInfo
+
+func (m *QueryAllQuarantinedRefundRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllQuarantinedRefundRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryAllQuarantinedRefundResponse struct {
+	QuarantinedRefunds []QuarantinedRefund `protobuf:"bytes,1,rep,name=quarantined_refunds,json=quarantinedRefunds,proto3" json:"quarantined_refunds"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllQuarantinedRefundResponse) Reset()         { *m = QueryAllQuarantinedRefundResponse{} }
+func (m *QueryAllQuarantinedRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQuarantinedRefundResponse) ProtoMessage()    {}
+func (*QueryAllQuarantinedRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{66}
+}
+func (m *QueryAllQuarantinedRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQuarantinedRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQuarantinedRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllQuarantinedRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQuarantinedRefundResponse.Merge(m, src)
+}
+func (m *QueryAllQuarantinedRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQuarantinedRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQuarantinedRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllQuarantinedRefundResponse proto.InternalMessageInfo
+
+func (m *QueryAllQuarantinedRefundResponse) GetQuarantinedRefunds() []QuarantinedRefund {
+	if m != nil {
+		return m.QuarantinedRefunds
+	}
+	return nil
+}
+
+func (m *QueryAllQuarantinedRefundResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{67}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{68}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedRequest")
+	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedResponse")
+	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedRequest")
+	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedResponse")
+	proto.RegisterType((*QueryGetPausedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPausedRequest")
+	proto.RegisterType((*QueryGetPausedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPausedResponse")
+	proto.RegisterType((*PauseRemaining)(nil), "circle.fiattokenfactory.v1.PauseRemaining")
+	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterRequest")
+	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterResponse")
+	proto.RegisterType((*QueryGetMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintersRequest")
+	proto.RegisterType((*QueryGetMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintersResponse")
+	proto.RegisterType((*QueryAllMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMintersRequest")
+	proto.RegisterType((*QueryAllMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMintersResponse")
+	proto.RegisterType((*QueryGetPauserRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPauserRequest")
+	proto.RegisterType((*QueryGetPauserResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPauserResponse")
+	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterRequest")
+	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterResponse")
+	proto.RegisterType((*QueryGetOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerRequest")
+	proto.RegisterType((*QueryGetOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerResponse")
+	proto.RegisterType((*QueryGetPendingOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingOwnerRequest")
+	proto.RegisterType((*QueryGetPendingOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingOwnerResponse")
+	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerRequest")
+	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerResponse")
+	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerRequest")
+	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerResponse")
+	proto.RegisterType((*QueryMintersOfControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryMintersOfControllerRequest")
+	proto.RegisterType((*QueryMintersOfControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryMintersOfControllerResponse")
+	proto.RegisterType((*QueryControllersOfMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryControllersOfMinterRequest")
+	proto.RegisterType((*QueryControllersOfMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryControllersOfMinterResponse")
+	proto.RegisterType((*QueryGetMinterControllerByMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerByMinterRequest")
+	proto.RegisterType((*QueryGetMinterControllerByMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerByMinterResponse")
+	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomRequest")
+	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomResponse")
+	proto.RegisterType((*QueryGetFiatTokenRequest)(nil), "circle.fiattokenfactory.v1.QueryGetFiatTokenRequest")
+	proto.RegisterType((*QueryGetFiatTokenResponse)(nil), "circle.fiattokenfactory.v1.QueryGetFiatTokenResponse")
+	proto.RegisterType((*QueryAllFiatTokenRequest)(nil), "circle.fiattokenfactory.v1.QueryAllFiatTokenRequest")
+	proto.RegisterType((*QueryAllFiatTokenResponse)(nil), "circle.fiattokenfactory.v1.QueryAllFiatTokenResponse")
+	proto.RegisterType((*QueryMintRateLimitRequest)(nil), "circle.fiattokenfactory.v1.QueryMintRateLimitRequest")
+	proto.RegisterType((*QueryMintRateLimitResponse)(nil), "circle.fiattokenfactory.v1.QueryMintRateLimitResponse")
+	proto.RegisterType((*QueryMaxSupplyRequest)(nil), "circle.fiattokenfactory.v1.QueryMaxSupplyRequest")
+	proto.RegisterType((*QueryMaxSupplyResponse)(nil), "circle.fiattokenfactory.v1.QueryMaxSupplyResponse")
+	proto.RegisterType((*QueryGetPendingMasterMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingMasterMinterRequest")
+	proto.RegisterType((*QueryGetPendingMasterMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingMasterMinterResponse")
+	proto.RegisterType((*QueryGetPendingPauserRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingPauserRequest")
+	proto.RegisterType((*QueryGetPendingPauserResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingPauserResponse")
+	proto.RegisterType((*QueryGetPendingBlacklisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingBlacklisterRequest")
+	proto.RegisterType((*QueryGetPendingBlacklisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingBlacklisterResponse")
+	proto.RegisterType((*QueryGetWiperRequest)(nil), "circle.fiattokenfactory.v1.QueryGetWiperRequest")
+	proto.RegisterType((*QueryGetWiperResponse)(nil), "circle.fiattokenfactory.v1.QueryGetWiperResponse")
+	proto.RegisterType((*QueryGetPendingWiperRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingWiperRequest")
+	proto.RegisterType((*QueryGetPendingWiperResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingWiperResponse")
+	proto.RegisterType((*QueryGetFrozenRequest)(nil), "circle.fiattokenfactory.v1.QueryGetFrozenRequest")
+	proto.RegisterType((*QueryGetFrozenResponse)(nil), "circle.fiattokenfactory.v1.QueryGetFrozenResponse")
+	proto.RegisterType((*QueryAllFrozenRequest)(nil), "circle.fiattokenfactory.v1.QueryAllFrozenRequest")
+	proto.RegisterType((*QueryAllFrozenResponse)(nil), "circle.fiattokenfactory.v1.QueryAllFrozenResponse")
+	proto.RegisterType((*QueryGetAllowedChannelRequest)(nil), "circle.fiattokenfactory.v1.QueryGetAllowedChannelRequest")
+	proto.RegisterType((*QueryGetAllowedChannelResponse)(nil), "circle.fiattokenfactory.v1.QueryGetAllowedChannelResponse")
+	proto.RegisterType((*QueryAllAllowedChannelRequest)(nil), "circle.fiattokenfactory.v1.QueryAllAllowedChannelRequest")
+	proto.RegisterType((*QueryAllAllowedChannelResponse)(nil), "circle.fiattokenfactory.v1.QueryAllAllowedChannelResponse")
+	proto.RegisterType((*QueryChannelRateLimitRequest)(nil), "circle.fiattokenfactory.v1.QueryChannelRateLimitRequest")
+	proto.RegisterType((*QueryChannelRateLimitResponse)(nil), "circle.fiattokenfactory.v1.QueryChannelRateLimitResponse")
+	proto.RegisterType((*QueryAllChannelRateLimitRequest)(nil), "circle.fiattokenfactory.v1.QueryAllChannelRateLimitRequest")
+	proto.RegisterType((*QueryAllChannelRateLimitResponse)(nil), "circle.fiattokenfactory.v1.QueryAllChannelRateLimitResponse")
+	proto.RegisterType((*QueryGetQuarantinedRefundRequest)(nil), "circle.fiattokenfactory.v1.QueryGetQuarantinedRefundRequest")
+	proto.RegisterType((*QueryGetQuarantinedRefundResponse)(nil), "circle.fiattokenfactory.v1.QueryGetQuarantinedRefundResponse")
+	proto.RegisterType((*QueryAllQuarantinedRefundRequest)(nil), "circle.fiattokenfactory.v1.QueryAllQuarantinedRefundRequest")
+	proto.RegisterType((*QueryAllQuarantinedRefundResponse)(nil), "circle.fiattokenfactory.v1.QueryAllQuarantinedRefundResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "circle.fiattokenfactory.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "circle.fiattokenfactory.v1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/query.proto", fileDescriptor_0e79ac8fb1676620)
+}
+
+var fileDescriptor_0e79ac8fb1676620 = []byte{
+	// 2809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xdf, 0x6f, 0x1d, 0x47,
+	0xf5, 0xcf, 0xc4, 0xb5, 0x53, 0x9f, 0xfc, 0x1e, 0xe7, 0x87, 0xb3, 0x4d, 0xec, 0x64, 0xd3, 0xc4,
+	0x4e, 0x1c, 0xdf, 0x1b, 0x3b, 0x49, 0xf3, 0xd3, 0x69, 0x1d, 0x27, 0xce, 0xd7, 0xdf, 0xd6, 0x24,
+	0xb9, 0x41, 0x0d, 0x14, 0x91, 0xab, 0xf5, 0xbd, 0x6b, 0x67, 0x95, 0xbd, 0xbb, 0x37, 0xbb, 0xeb,
+	0x38, 0xa9, 0x65, 0x81, 0x8a, 0x04, 0x52, 0x9f, 0x40, 0x7d, 0x40, 0xe2, 0xa1, 0x12, 0x42, 0x15,
+	0x08, 0x54, 0xe0, 0xa1, 0x08, 0x09, 0x5e, 0x90, 0x10, 0x52, 0x24, 0x84, 0xa8, 0x1a, 0x90, 0x2a,
+	0x1e, 0x4a, 0x95, 0xc0, 0x3f, 0xc0, 0x5f, 0x80, 0x76, 0xf6, 0xec, 0xee, 0xec, 0xcf, 0x3b, 0xbb,
+	0xb6, 0x91, 0xf2, 0x76, 0x77, 0x76, 0xce, 0x99, 0xcf, 0x39, 0x73, 0xe6, 0xcc, 0x99, 0xf9, 0xec,
+	0x85, 0xa3, 0x0d, 0xcd, 0x6a, 0xe8, 0x6a, 0x75, 0x5e, 0x53, 0x1c, 0xc7, 0xbc, 0xaf, 0x1a, 0xf3,
+	0x4a, 0xc3, 0x31, 0xad, 0xc7, 0xd5, 0x87, 0x63, 0xd5, 0x07, 0x8b, 0xaa, 0xf5, 0xb8, 0xd2, 0xb6,
+	0x4c, 0xc7, 0xa4, 0x92, 0xd7, 0xaf, 0x12, 0xef, 0x57, 0x79, 0x38, 0x26, 0x9d, 0xcc, 0xd1, 0xa1,
+	0xe8, 0xba, 0xb9, 0xa4, 0x36, 0xeb, 0x8d, 0x7b, 0x8a, 0x61, 0xa8, 0xba, 0xa7, 0x4d, 0x3a, 0x91,
+	0x23, 0x31, 0xa7, 0x2b, 0x8d, 0xfb, 0xba, 0x66, 0x3b, 0x6a, 0xb3, 0x50, 0x6f, 0x0b, 0x7b, 0x9f,
+	0xca, 0xe9, 0x8d, 0x28, 0xea, 0x96, 0xe2, 0xa8, 0x75, 0x5d, 0x6b, 0x69, 0x0e, 0x0a, 0x8d, 0xe4,
+	0x08, 0xb9, 0x6d, 0x75, 0xd6, 0x88, 0x9d, 0x87, 0xf2, 0x3a, 0x5b, 0xe6, 0xbb, 0x41, 0xc7, 0x4a,
+	0x4e, 0xc7, 0x96, 0xe2, 0x62, 0xae, 0xb7, 0x34, 0x23, 0x84, 0x3e, 0x92, 0xdb, 0xff, 0x51, 0xdd,
+	0x5e, 0x6c, 0xb7, 0x75, 0x9c, 0x91, 0x5c, 0xaf, 0xbb, 0x5a, 0x93, 0x46, 0x8e, 0x77, 0x90, 0x50,
+	0xad, 0x7a, 0xc3, 0x34, 0x1c, 0xcb, 0xd4, 0xf5, 0x00, 0xd2, 0x70, 0x47, 0x19, 0x5b, 0xc4, 0x58,
+	0xcd, 0x70, 0x34, 0x63, 0xa1, 0xde, 0x54, 0x0d, 0xb3, 0x85, 0xfd, 0xf3, 0x22, 0xcf, 0x5c, 0x32,
+	0x54, 0x4b, 0xc0, 0xdb, 0x6d, 0xc5, 0x52, 0x5a, 0xb6, 0x50, 0xc7, 0x45, 0x3b, 0x88, 0xa7, 0xd1,
+	0xbc, 0x8e, 0xaa, 0xd1, 0x74, 0x91, 0x5a, 0xa6, 0xae, 0x0a, 0x04, 0xd4, 0x83, 0x45, 0xc5, 0x52,
+	0x5c, 0xdb, 0xd4, 0x66, 0xdd, 0x52, 0xe7, 0x17, 0x8d, 0xa6, 0x28, 0x18, 0x4b, 0xc0, 0x0d, 0x4b,
+	0x5a, 0x3b, 0xe8, 0x77, 0xbc, 0x61, 0xda, 0x2d, 0xd3, 0xae, 0xce, 0x29, 0xb6, 0xea, 0xad, 0xcc,
+	0xea, 0xc3, 0xb1, 0x39, 0xd5, 0x51, 0x5c, 0x7d, 0x0b, 0x9a, 0xa1, 0x38, 0x9a, 0xe9, 0xc7, 0xdd,
+	0x00, 0xdf, 0xd7, 0xef, 0xd5, 0x30, 0x35, 0xff, 0xfd, 0x3e, 0xef, 0x7d, 0x9d, 0x3d, 0x55, 0xbd,
+	0x07, 0x7c, 0xb5, 0x6b, 0xc1, 0x5c, 0x30, 0xbd, 0x76, 0xf7, 0x17, 0xb6, 0xee, 0x5f, 0x30, 0xcd,
+	0x05, 0x5d, 0xad, 0x2a, 0x6d, 0xad, 0xaa, 0x18, 0x86, 0xe9, 0xb0, 0xd1, 0x50, 0x46, 0x7e, 0x0b,
+	0xa4, 0x5b, 0x2e, 0xa0, 0xeb, 0xaa, 0x73, 0x25, 0x5c, 0xbc, 0x35, 0xf5, 0xc1, 0xa2, 0x6a, 0x3b,
+	0xb4, 0x1f, 0x36, 0x29, 0xcd, 0xa6, 0xa5, 0xda, 0x76, 0x3f, 0x39, 0x48, 0x86, 0x7b, 0x6b, 0xfe,
+	0x23, 0xdd, 0x05, 0xdd, 0x2c, 0x20, 0xfa, 0x37, 0xb2, 0x76, 0xef, 0x41, 0x36, 0xe0, 0x95, 0x54,
+	0x6d, 0x76, 0xdb, 0x34, 0x6c, 0x95, 0xde, 0x80, 0xcd, 0x5c, 0x86, 0x60, 0x2a, 0x37, 0x8f, 0x0f,
+	0x55, 0xb2, 0xd3, 0x53, 0x85, 0xd3, 0x72, 0xe5, 0xa5, 0x27, 0x5f, 0x0c, 0x6e, 0xa8, 0xf1, 0x1a,
+	0xe4, 0x77, 0x11, 0xfd, 0xa4, 0xae, 0xa7, 0xa0, 0x9f, 0x06, 0x08, 0xdd, 0x8b, 0xa3, 0x1d, 0xad,
+	0xa0, 0xcb, 0x5c, 0xff, 0x56, 0xbc, 0x2c, 0x89, 0x5e, 0xae, 0xdc, 0x54, 0x16, 0x54, 0x94, 0xad,
+	0x71, 0x92, 0x19, 0xb6, 0xfe, 0x96, 0xc0, 0x2b, 0xa9, 0x83, 0x67, 0x19, 0xdb, 0xb5, 0x3a, 0x63,
+	0xe9, 0xf5, 0x88, 0x39, 0x1b, 0x7d, 0xe7, 0x75, 0x30, 0xc7, 0x43, 0xc3, 0xdb, 0x23, 0x8f, 0xc2,
+	0x6e, 0x7f, 0x96, 0x6e, 0xb2, 0xb5, 0xe5, 0x3b, 0x2c, 0x30, 0x94, 0xf0, 0x86, 0xfe, 0x9c, 0xc0,
+	0x9e, 0x78, 0x7f, 0xb4, 0xf1, 0x0d, 0xe8, 0xf1, 0x56, 0x27, 0x7a, 0x57, 0xce, 0x33, 0xcf, 0x93,
+	0x45, 0xcb, 0x50, 0x8e, 0x7e, 0x05, 0x7a, 0x2d, 0xb5, 0xa5, 0x68, 0x86, 0x66, 0x2c, 0xf4, 0x6f,
+	0x64, 0x3e, 0x3a, 0xde, 0x51, 0x49, 0xcd, 0x97, 0x40, 0x65, 0xa1, 0x0a, 0xf9, 0xdb, 0x04, 0xb6,
+	0x45, 0xfb, 0xd0, 0x4b, 0xd0, 0x6d, 0x37, 0xcc, 0xb6, 0xca, 0x30, 0x6e, 0x1b, 0x3f, 0xda, 0x51,
+	0xfd, 0x6d, 0xb7, 0x77, 0xcd, 0x13, 0xa2, 0x7b, 0xa0, 0x67, 0x4e, 0x37, 0x1b, 0xf7, 0x6d, 0xe6,
+	0xf1, 0xae, 0x1a, 0x3e, 0xb9, 0x4b, 0xc3, 0x56, 0x1b, 0xa6, 0xd1, 0xb4, 0xfb, 0xbb, 0xd8, 0x0b,
+	0xff, 0x51, 0x3e, 0x15, 0x2e, 0x82, 0x59, 0xb6, 0x51, 0xcc, 0xb2, 0x5c, 0x9b, 0xef, 0x64, 0x0b,
+	0xf6, 0xa7, 0x0b, 0xa1, 0xa7, 0x6b, 0xb0, 0xa5, 0xc5, 0xb5, 0xa3, 0xbf, 0x87, 0xf3, 0x6c, 0xe1,
+	0xf5, 0xa0, 0xa3, 0x22, 0x3a, 0xe4, 0xff, 0x0b, 0xe7, 0xd5, 0x6b, 0xb1, 0xcb, 0xae, 0xfb, 0xbb,
+	0xb0, 0x37, 0xa1, 0x09, 0x81, 0x4f, 0xc1, 0x26, 0xdc, 0x6b, 0x10, 0xf3, 0xe1, 0x5c, 0xcc, 0x5e,
+	0x57, 0x84, 0xeb, 0x4b, 0xca, 0x0f, 0x11, 0xe9, 0xa4, 0xae, 0xc7, 0x90, 0xae, 0xef, 0x1a, 0xff,
+	0x29, 0x81, 0xbd, 0x89, 0x81, 0xd3, 0x0c, 0xeb, 0x2a, 0x67, 0xd8, 0xfa, 0xad, 0xe9, 0x0e, 0xe1,
+	0xf6, 0x4e, 0x6c, 0x49, 0x5b, 0x89, 0x25, 0x6d, 0x09, 0x2f, 0x69, 0x2b, 0xb2, 0xa4, 0x2d, 0x79,
+	0x3c, 0x6d, 0x4b, 0xe9, 0x80, 0x27, 0x75, 0xe3, 0xb0, 0xd2, 0x73, 0xa9, 0x55, 0x6c, 0xe3, 0xb0,
+	0x92, 0xb9, 0xd4, 0x92, 0x4f, 0xc0, 0x2e, 0x7f, 0xbc, 0x1b, 0x4b, 0x46, 0x27, 0x74, 0x6f, 0xc3,
+	0xee, 0x58, 0x6f, 0xc4, 0x35, 0x01, 0xdd, 0xac, 0xdc, 0x41, 0x44, 0x87, 0xf2, 0x10, 0x31, 0x49,
+	0xc4, 0xe2, 0x49, 0xf1, 0x99, 0xe2, 0xa6, 0x57, 0xbb, 0x08, 0x80, 0x79, 0x00, 0xfb, 0xd3, 0x85,
+	0x10, 0xd3, 0x2d, 0xd8, 0xd2, 0xe6, 0xda, 0x45, 0x9c, 0x85, 0x7a, 0x6a, 0xa6, 0xae, 0xfa, 0x89,
+	0x82, 0x57, 0x21, 0xab, 0x30, 0x18, 0x5d, 0xde, 0x53, 0x41, 0xa9, 0xe9, 0x63, 0x3d, 0x01, 0x3b,
+	0xc3, 0xfa, 0x73, 0x32, 0x92, 0x3b, 0x92, 0x2f, 0x32, 0x56, 0xdb, 0x7b, 0x04, 0x0e, 0x66, 0x8f,
+	0x83, 0xe6, 0xdd, 0x85, 0x1d, 0xad, 0xd8, 0x3b, 0x34, 0xf1, 0x44, 0xe7, 0xf5, 0x17, 0xca, 0xa0,
+	0x9d, 0x09, 0x5d, 0xf2, 0xb7, 0x60, 0x30, 0xba, 0xe2, 0x93, 0xb6, 0xae, 0x6f, 0xce, 0xf9, 0xb3,
+	0xef, 0x85, 0x54, 0x04, 0xb9, 0x5e, 0xe8, 0x5a, 0x2b, 0x2f, 0xac, 0x5d, 0x5e, 0xfa, 0x98, 0xa0,
+	0x3f, 0x31, 0x01, 0xde, 0x98, 0x5f, 0x6d, 0xec, 0x4c, 0xa7, 0x40, 0x5b, 0x95, 0xf7, 0xbb, 0x52,
+	0xbd, 0x9f, 0x8a, 0xf7, 0x45, 0xf3, 0xfe, 0x47, 0xbe, 0xf7, 0x43, 0xe5, 0xf6, 0x8d, 0xf9, 0x68,
+	0x3d, 0xf2, 0x2a, 0x6c, 0xf5, 0x00, 0x44, 0x3d, 0x1f, 0x6d, 0xfc, 0x5f, 0x79, 0x3d, 0x15, 0xe7,
+	0x0b, 0x18, 0xf3, 0x43, 0x59, 0x79, 0xec, 0xca, 0xe3, 0x32, 0xde, 0x4f, 0xcd, 0x14, 0xb1, 0x39,
+	0xe9, 0x2a, 0x3b, 0x27, 0xf2, 0x53, 0x02, 0xc3, 0x9d, 0xf1, 0xbe, 0x68, 0xb3, 0x70, 0x80, 0x2b,
+	0xc3, 0xbd, 0x2b, 0x8c, 0xab, 0xae, 0xd7, 0xd0, 0x01, 0x91, 0x82, 0x3b, 0xf2, 0x9a, 0x2b, 0xb8,
+	0xb9, 0x76, 0xa1, 0x82, 0x9b, 0xeb, 0x1f, 0x14, 0xdc, 0x5c, 0x9b, 0x7c, 0x12, 0xfa, 0xfd, 0x31,
+	0xa7, 0x35, 0xc5, 0xf9, 0xaa, 0x2b, 0x9f, 0xbf, 0xd9, 0xcf, 0xc3, 0xbe, 0x14, 0x09, 0x84, 0x38,
+	0x03, 0xbd, 0xf3, 0x7e, 0x23, 0xe2, 0x3b, 0x92, 0x87, 0x2f, 0xd0, 0xe0, 0x1f, 0x9b, 0x02, 0x69,
+	0x79, 0x0e, 0x91, 0x4d, 0xea, 0x7a, 0x02, 0xd9, 0x1a, 0x6d, 0x77, 0xf2, 0xaf, 0x08, 0xec, 0x4b,
+	0x19, 0x24, 0xdd, 0x98, 0xae, 0xf2, 0xc6, 0xac, 0x5d, 0x08, 0xbd, 0x89, 0x80, 0xdd, 0x89, 0xad,
+	0x29, 0x8e, 0xfa, 0x96, 0x7b, 0x21, 0x57, 0xf6, 0x8c, 0xf4, 0x39, 0x01, 0x29, 0x4d, 0x1b, 0xda,
+	0x3f, 0x0b, 0xbd, 0x96, 0xdf, 0x88, 0x4e, 0x3e, 0xd6, 0x29, 0xd8, 0x02, 0x2d, 0xc1, 0x39, 0xd8,
+	0x6f, 0x70, 0xdd, 0xc9, 0x9f, 0xab, 0xc9, 0x70, 0xef, 0x95, 0x11, 0xb7, 0xcf, 0x3f, 0xbe, 0x18,
+	0xdc, 0xed, 0x79, 0xc2, 0x6e, 0xde, 0xaf, 0x68, 0x66, 0xb5, 0xa5, 0x38, 0xf7, 0x2a, 0x33, 0x86,
+	0xf3, 0xd9, 0x27, 0xa3, 0x80, 0x2e, 0x9a, 0x31, 0x1c, 0xee, 0x48, 0x4d, 0x0f, 0xc2, 0x66, 0x43,
+	0x7d, 0xe4, 0xd4, 0x54, 0x5d, 0x55, 0x6c, 0x15, 0x4f, 0xbb, 0x7c, 0x53, 0x70, 0xf8, 0x98, 0x55,
+	0x1e, 0xdd, 0x66, 0xd7, 0x9c, 0xf9, 0x41, 0xfd, 0x6f, 0xff, 0x42, 0x81, 0xeb, 0x1f, 0x46, 0x41,
+	0xcb, 0x6f, 0x14, 0x09, 0xe9, 0x40, 0x83, 0xef, 0x81, 0x40, 0x9a, 0x9e, 0x85, 0x1e, 0xef, 0xce,
+	0x15, 0x23, 0x60, 0x5f, 0x24, 0x02, 0xfc, 0xb9, 0x9f, 0x32, 0x35, 0x3f, 0x82, 0xb0, 0x3b, 0xbd,
+	0x0e, 0x2f, 0xdf, 0x53, 0x95, 0xa6, 0x65, 0xfa, 0xbb, 0x54, 0x31, 0xcf, 0x05, 0xc2, 0xf2, 0x05,
+	0x90, 0x63, 0x95, 0xba, 0xf8, 0x7d, 0xc0, 0x77, 0x09, 0x1c, 0xce, 0x15, 0x46, 0x87, 0xd5, 0xa1,
+	0xaf, 0x9d, 0x7c, 0x5d, 0xae, 0xe8, 0x4f, 0xd3, 0x24, 0x9f, 0x4e, 0x1c, 0x37, 0x44, 0xce, 0x97,
+	0x0e, 0x1c, 0xc8, 0x90, 0x42, 0xdc, 0xb7, 0x61, 0x6b, 0x9b, 0x7f, 0x51, 0x0e, 0x71, 0x54, 0x87,
+	0x7c, 0x1e, 0x0e, 0xc5, 0x46, 0x15, 0x3e, 0x80, 0x7e, 0x87, 0x80, 0x9c, 0x27, 0x8b, 0xb0, 0xbf,
+	0x09, 0xb4, 0x9d, 0x78, 0x5b, 0x0e, 0x7b, 0x8a, 0x22, 0xfe, 0x58, 0x7a, 0x47, 0x6b, 0x17, 0x38,
+	0x96, 0x62, 0xef, 0xf0, 0x58, 0xca, 0xae, 0x9f, 0x45, 0x8e, 0xa5, 0x4c, 0xd2, 0x3f, 0x96, 0x32,
+	0xa9, 0x94, 0x63, 0xa9, 0x00, 0x98, 0xe4, 0xb1, 0x34, 0x8a, 0x29, 0x3c, 0x96, 0xde, 0xd1, 0xda,
+	0x65, 0x7d, 0x16, 0x51, 0x21, 0x5f, 0x0f, 0xed, 0x9f, 0x66, 0xd4, 0x4d, 0xd9, 0xd4, 0xcc, 0xdd,
+	0x86, 0xf8, 0x8a, 0xc2, 0xdb, 0x10, 0x8f, 0x15, 0x12, 0xb9, 0x0d, 0xf1, 0x64, 0xfd, 0x6c, 0xe2,
+	0xc9, 0xc9, 0x8b, 0xb0, 0x3b, 0xd8, 0xf4, 0x22, 0x20, 0xd7, 0xf7, 0x14, 0xf9, 0x13, 0x02, 0x7b,
+	0xe2, 0xe3, 0xa6, 0xd8, 0xd4, 0x55, 0xc6, 0xa6, 0xb5, 0xdb, 0x60, 0x5b, 0x61, 0x9a, 0x98, 0xf4,
+	0xc8, 0xc6, 0x29, 0x8f, 0xe5, 0xf3, 0x9d, 0xb4, 0x17, 0x36, 0xb5, 0x4d, 0xcb, 0xa9, 0x6b, 0x4d,
+	0x9c, 0xc9, 0x1e, 0xf7, 0x71, 0xa6, 0x49, 0x0f, 0x00, 0xf8, 0x84, 0xa0, 0xd6, 0x44, 0xd3, 0x7b,
+	0xb1, 0x65, 0xa6, 0x99, 0x71, 0xcc, 0x58, 0x86, 0x81, 0xac, 0xe1, 0xd0, 0x37, 0x5f, 0x87, 0xed,
+	0x31, 0xd6, 0x13, 0x67, 0x26, 0xf7, 0x52, 0x3a, 0xaa, 0x0c, 0x9d, 0xb5, 0x4d, 0x89, 0xb4, 0xca,
+	0x2b, 0x68, 0xeb, 0xa4, 0xae, 0xa7, 0xdb, 0xba, 0xbe, 0x01, 0xf1, 0x25, 0x81, 0x81, 0xac, 0xf1,
+	0xd1, 0xf8, 0x6f, 0xc0, 0x8e, 0x98, 0xf1, 0xfe, 0xd5, 0x66, 0x71, 0xeb, 0xb7, 0x47, 0xad, 0x5f,
+	0xbb, 0x9b, 0x4e, 0x77, 0x71, 0xab, 0x86, 0x32, 0xa7, 0xab, 0x4d, 0x36, 0xb9, 0x2f, 0xd7, 0xfc,
+	0x47, 0x59, 0xc7, 0x14, 0xe4, 0xdb, 0x15, 0xaf, 0xd8, 0xd6, 0x36, 0x98, 0xde, 0xef, 0x82, 0x03,
+	0x19, 0xc3, 0x05, 0x29, 0x0f, 0x42, 0x1e, 0x57, 0xe4, 0x92, 0x2a, 0xae, 0x29, 0x59, 0xd6, 0x4d,
+	0xc2, 0x4b, 0xf3, 0xba, 0xb9, 0x14, 0xfa, 0xaf, 0xa3, 0xb2, 0x69, 0xdd, 0x5c, 0x42, 0x3d, 0x4c,
+	0x94, 0xbe, 0x0d, 0x3b, 0x82, 0xda, 0xae, 0xae, 0x19, 0x4c, 0x5d, 0x58, 0xe6, 0x10, 0xd1, 0x32,
+	0x67, 0x7b, 0xa0, 0x64, 0x86, 0xe9, 0xa0, 0x5f, 0x83, 0x9d, 0xa1, 0x5e, 0x73, 0xd1, 0x61, 0x8a,
+	0x5f, 0x2a, 0xae, 0x38, 0x44, 0x77, 0xc3, 0x53, 0x42, 0x0f, 0xc1, 0x16, 0xb7, 0xda, 0xac, 0x5b,
+	0x58, 0x81, 0x76, 0x27, 0x2b, 0x50, 0xee, 0xd6, 0x2e, 0x6b, 0xf6, 0xd7, 0x77, 0x79, 0xfd, 0x81,
+	0xbb, 0xb5, 0xcb, 0x0c, 0x88, 0xdb, 0xb0, 0x39, 0x0c, 0x08, 0x5b, 0xe4, 0xd8, 0x9c, 0x11, 0x11,
+	0x10, 0x44, 0xc4, 0x1a, 0x52, 0x08, 0xb5, 0xf0, 0xf6, 0xf5, 0x56, 0x48, 0x8d, 0xd7, 0x18, 0x33,
+	0x5e, 0x76, 0x67, 0xfd, 0x1e, 0x81, 0x43, 0x39, 0x4a, 0xd1, 0x2f, 0x73, 0x40, 0x93, 0x64, 0x3c,
+	0x4e, 0xd1, 0x68, 0x9e, 0x7b, 0x12, 0x2a, 0xd1, 0x3f, 0x3b, 0x1f, 0xc4, 0x5f, 0xb8, 0xc4, 0x60,
+	0x30, 0x41, 0x99, 0xe6, 0xad, 0x6f, 0x8c, 0x7c, 0xe6, 0x3b, 0x23, 0x1d, 0x02, 0x3a, 0xa3, 0x09,
+	0x7d, 0x49, 0x67, 0xf8, 0xc1, 0x52, 0xca, 0x1b, 0x34, 0xe1, 0x8d, 0x35, 0x8c, 0x9a, 0x5d, 0x40,
+	0x99, 0x4d, 0x37, 0xd9, 0xe7, 0x1c, 0xfe, 0xed, 0xca, 0x1d, 0xe8, 0x8b, 0xb4, 0xf2, 0xe4, 0x92,
+	0xdb, 0x22, 0x46, 0x2e, 0xb9, 0x3d, 0x43, 0x72, 0xc9, 0x7d, 0x1a, 0x7f, 0x7f, 0x0c, 0xba, 0x99,
+	0x66, 0xfa, 0x1b, 0x02, 0x9b, 0x39, 0xc6, 0x9c, 0xbe, 0x96, 0xef, 0x9a, 0xac, 0x6f, 0x1c, 0xa4,
+	0xb3, 0x85, 0xe5, 0x3c, 0x63, 0xe4, 0x33, 0xef, 0x3d, 0xfd, 0xd7, 0x07, 0x1b, 0xab, 0x74, 0xb4,
+	0x6a, 0x98, 0x73, 0x69, 0x5f, 0x81, 0x70, 0xe4, 0x7d, 0x75, 0x19, 0xd7, 0xc9, 0x0a, 0xfd, 0x25,
+	0x81, 0x6d, 0x9c, 0xba, 0x49, 0x5d, 0x17, 0x80, 0x9e, 0xfa, 0x81, 0x83, 0x74, 0xb6, 0xb0, 0x1c,
+	0x42, 0x1f, 0x61, 0xd0, 0x8f, 0xd0, 0xc3, 0x02, 0xd0, 0xe9, 0x0f, 0x09, 0xf4, 0x78, 0xdc, 0x3d,
+	0x1d, 0x13, 0xf1, 0x55, 0xe4, 0x9b, 0x02, 0x69, 0xbc, 0x88, 0x08, 0xc2, 0x3b, 0xca, 0xe0, 0x1d,
+	0xa4, 0x03, 0x59, 0xf0, 0xf0, 0xe3, 0x81, 0x5f, 0x13, 0xd8, 0xc2, 0x1f, 0x56, 0xa9, 0xd0, 0x5c,
+	0xa6, 0x1c, 0xc2, 0xa5, 0x73, 0xc5, 0x05, 0x11, 0xeb, 0x28, 0xc3, 0x3a, 0x44, 0x8f, 0x64, 0x61,
+	0x8d, 0x7c, 0x2c, 0x46, 0x3f, 0x22, 0xb0, 0x69, 0x16, 0xc9, 0x5f, 0x21, 0xd7, 0x44, 0xf9, 0x6e,
+	0xe9, 0x54, 0x21, 0x19, 0xc4, 0x38, 0xc6, 0x30, 0x8e, 0xd0, 0x63, 0x99, 0x18, 0x3d, 0x01, 0x2e,
+	0x4a, 0x3f, 0x24, 0x00, 0xa8, 0xc6, 0x8d, 0xd0, 0x71, 0x91, 0x48, 0x2b, 0x0c, 0x35, 0xc9, 0xaa,
+	0xcb, 0x43, 0x0c, 0xea, 0x21, 0x3a, 0xd8, 0x01, 0x6a, 0x18, 0x95, 0x56, 0x81, 0xa8, 0xb4, 0x8a,
+	0x47, 0xa5, 0x55, 0x30, 0x2a, 0x2d, 0xfa, 0x8b, 0x48, 0x62, 0xb2, 0x8a, 0x26, 0x26, 0xab, 0x64,
+	0x62, 0xb2, 0xca, 0xac, 0x6e, 0x8b, 0x7e, 0x40, 0xa0, 0x9b, 0xb1, 0xbc, 0xf4, 0xa4, 0xc8, 0x78,
+	0x3c, 0x41, 0x2d, 0x8d, 0x15, 0x90, 0x40, 0x6c, 0x47, 0x18, 0xb6, 0x41, 0x7a, 0x20, 0x0b, 0x1b,
+	0x63, 0xc6, 0xd9, 0xca, 0xe6, 0xd9, 0x6d, 0xb1, 0x95, 0x9d, 0x42, 0xa2, 0x4b, 0xe7, 0x8a, 0x0b,
+	0x8a, 0xae, 0x6c, 0xff, 0x7b, 0x43, 0x0f, 0xf2, 0xdf, 0x08, 0xec, 0x88, 0xb3, 0x1c, 0xf4, 0xa2,
+	0xf8, 0x72, 0x4d, 0xf0, 0xa2, 0xd2, 0xa5, 0x72, 0xc2, 0x08, 0xff, 0x1a, 0x83, 0xff, 0x3a, 0x9d,
+	0xc8, 0x5f, 0x49, 0xdc, 0x67, 0xa3, 0xd5, 0xe5, 0x04, 0xdb, 0xba, 0x42, 0xff, 0x48, 0xa0, 0x2f,
+	0x3e, 0x86, 0x9b, 0x11, 0x2e, 0x8a, 0xaf, 0xee, 0x32, 0x96, 0xe5, 0x90, 0xdf, 0xa2, 0xe9, 0x8c,
+	0xb3, 0x8c, 0xfe, 0x33, 0xb0, 0x22, 0xc2, 0xe8, 0x0a, 0x58, 0x91, 0xcd, 0x5b, 0x4b, 0x97, 0xca,
+	0x09, 0xa3, 0x15, 0xb3, 0xcc, 0x8a, 0xeb, 0xf4, 0xda, 0xaa, 0xe6, 0x27, 0xc8, 0x87, 0x7f, 0x27,
+	0xd0, 0x97, 0xc2, 0x9e, 0x0a, 0x58, 0x98, 0xcd, 0x0d, 0x4b, 0x97, 0xca, 0x09, 0xa3, 0x85, 0x53,
+	0xcc, 0xc2, 0x09, 0x7a, 0xb1, 0xe3, 0xb6, 0x13, 0x61, 0x3b, 0x57, 0xaa, 0xa1, 0x99, 0x36, 0xfd,
+	0x0f, 0x81, 0xfe, 0x2c, 0x52, 0x92, 0x4e, 0x95, 0x59, 0x21, 0x31, 0x0a, 0x56, 0xba, 0xba, 0x3a,
+	0x25, 0x68, 0xec, 0x9b, 0xcc, 0xd8, 0x6b, 0x74, 0x4a, 0x7c, 0x3a, 0xe7, 0x1e, 0x63, 0x55, 0x10,
+	0x77, 0x80, 0x57, 0xd8, 0x70, 0xcc, 0xa1, 0x60, 0x61, 0x93, 0xa4, 0x39, 0xa5, 0x73, 0xc5, 0x05,
+	0x85, 0x0b, 0x1b, 0xfe, 0xc3, 0x70, 0xfa, 0x31, 0x81, 0xde, 0x80, 0x93, 0xa3, 0xa7, 0x45, 0x86,
+	0x8d, 0x33, 0x8d, 0xd2, 0x99, 0x82, 0x52, 0x88, 0xf4, 0x14, 0x43, 0x3a, 0x4a, 0x47, 0xb2, 0x90,
+	0x86, 0xff, 0x02, 0xb0, 0xab, 0xcb, 0x0c, 0xee, 0x0a, 0xfd, 0x19, 0x81, 0x2d, 0x81, 0x2a, 0x37,
+	0xa1, 0x9d, 0x16, 0xc9, 0x49, 0x25, 0x20, 0xa7, 0xb1, 0x9d, 0x9d, 0xb7, 0x68, 0x0e, 0x32, 0xfd,
+	0x1d, 0x81, 0xad, 0x11, 0xba, 0x8f, 0x9e, 0x11, 0xca, 0x3c, 0xf1, 0x2b, 0x10, 0xe9, 0xb5, 0xa2,
+	0x62, 0x88, 0xf6, 0x02, 0x43, 0x7b, 0x9a, 0x8e, 0xe7, 0x85, 0x02, 0xf7, 0x9f, 0x05, 0xbe, 0x90,
+	0xfc, 0x0b, 0x81, 0xbe, 0x14, 0x02, 0x8b, 0x5e, 0x2e, 0xb0, 0x2f, 0xa7, 0x55, 0xec, 0xaf, 0x97,
+	0x96, 0x17, 0x3d, 0xbe, 0xf9, 0xdb, 0x7b, 0xb4, 0x80, 0xff, 0x84, 0xc0, 0xd6, 0x08, 0xa5, 0x45,
+	0x8b, 0x54, 0x18, 0xd1, 0x2a, 0xf4, 0x7c, 0x09, 0x49, 0x44, 0x5f, 0x61, 0xe8, 0x87, 0xe9, 0xd1,
+	0x4e, 0xe8, 0xb1, 0x28, 0x7d, 0x42, 0x80, 0x26, 0x79, 0x2d, 0x3a, 0x51, 0x00, 0x41, 0x4a, 0x89,
+	0x7a, 0xb9, 0xac, 0xb8, 0xe8, 0xca, 0xf5, 0xad, 0xe0, 0x2b, 0xd6, 0x0f, 0x09, 0xf4, 0x06, 0xbc,
+	0xaf, 0x40, 0xf1, 0x1f, 0x67, 0xa5, 0xa5, 0xf1, 0x22, 0x22, 0x88, 0xf4, 0x38, 0x43, 0xfa, 0x2a,
+	0x95, 0xb3, 0x8f, 0x79, 0xfe, 0x7f, 0x7c, 0x58, 0x49, 0xcd, 0x18, 0x2a, 0xb1, 0x92, 0x9a, 0x27,
+	0xd7, 0xa4, 0xb1, 0x02, 0x12, 0xa2, 0x25, 0x35, 0x63, 0xf5, 0xf8, 0x92, 0xda, 0x03, 0x57, 0xa4,
+	0xa4, 0x8e, 0x60, 0x3c, 0x57, 0x5c, 0xb0, 0x68, 0x49, 0xed, 0x41, 0xfe, 0x31, 0x81, 0x1e, 0x8f,
+	0x80, 0x12, 0x3b, 0xe3, 0x45, 0x08, 0x36, 0x69, 0xbc, 0x88, 0x08, 0x02, 0x3c, 0xc9, 0x00, 0x1e,
+	0xa7, 0xc3, 0x99, 0x79, 0x99, 0xf5, 0xe7, 0xf2, 0xdb, 0x8f, 0xdc, 0x7d, 0x8f, 0x35, 0xba, 0x9b,
+	0xc8, 0x98, 0xd0, 0x76, 0x50, 0x10, 0x66, 0x82, 0xc2, 0xeb, 0x7c, 0x14, 0x45, 0xa2, 0xee, 0xaf,
+	0x04, 0xb6, 0x45, 0xe9, 0x19, 0x2a, 0x94, 0x73, 0x52, 0x09, 0x2a, 0xe9, 0x42, 0x19, 0x51, 0x44,
+	0x3c, 0xcd, 0x10, 0xbf, 0x41, 0x2f, 0x67, 0x21, 0x8e, 0x31, 0x4f, 0xd5, 0x65, 0xa4, 0x6a, 0x56,
+	0xaa, 0xcb, 0x21, 0x37, 0xb3, 0x42, 0x7f, 0x4f, 0x60, 0x67, 0x74, 0x88, 0x49, 0x5d, 0xc4, 0xa8,
+	0x2c, 0xd6, 0x4d, 0xba, 0x50, 0x46, 0x14, 0x8d, 0xaa, 0x32, 0xa3, 0x8e, 0xd1, 0x21, 0x41, 0xa3,
+	0xe8, 0x53, 0x02, 0x3b, 0xe2, 0x57, 0xfa, 0x02, 0xfb, 0x47, 0x06, 0xa5, 0x21, 0x9d, 0x2f, 0x21,
+	0x89, 0xd0, 0xff, 0x9f, 0x41, 0xbf, 0x4a, 0xaf, 0x64, 0x41, 0x4f, 0xfe, 0xdd, 0xd2, 0xce, 0x9a,
+	0x93, 0x3f, 0xb9, 0x47, 0x8f, 0xd8, 0x40, 0xc2, 0x47, 0xc4, 0x2c, 0xdb, 0x2e, 0x95, 0x13, 0x16,
+	0xdd, 0x58, 0x52, 0xcc, 0x73, 0x57, 0xcb, 0xce, 0xc4, 0x1d, 0x3a, 0x15, 0x3a, 0x85, 0x67, 0x31,
+	0x0a, 0xd2, 0x44, 0x49, 0x69, 0xb4, 0x63, 0x82, 0xd9, 0x71, 0x96, 0x9e, 0xc9, 0xb2, 0x23, 0x85,
+	0x2a, 0xe0, 0x92, 0xd3, 0x13, 0xe2, 0x7e, 0x50, 0x12, 0x53, 0xee, 0x4e, 0x8d, 0x90, 0x77, 0x57,
+	0x61, 0x54, 0x1e, 0xc3, 0xd1, 0x79, 0x72, 0x52, 0x8c, 0xa2, 0x3f, 0x60, 0xf7, 0x7d, 0x2e, 0x07,
+	0x40, 0x2b, 0x1d, 0x87, 0x8f, 0x90, 0x11, 0x52, 0x55, 0xb8, 0xbf, 0xf8, 0x4d, 0x1f, 0xa3, 0x26,
+	0xee, 0x3e, 0x79, 0x36, 0x40, 0x3e, 0x7d, 0x36, 0x40, 0xbe, 0x7c, 0x36, 0x40, 0xbe, 0xff, 0x7c,
+	0x60, 0xc3, 0xa7, 0xcf, 0x07, 0x36, 0x7c, 0xfe, 0x7c, 0x60, 0xc3, 0x3b, 0x57, 0x17, 0x34, 0xe7,
+	0xde, 0xe2, 0x5c, 0xa5, 0x61, 0xb6, 0xaa, 0xde, 0xe0, 0xf3, 0x9a, 0xe1, 0x69, 0x1b, 0x4d, 0x68,
+	0x7b, 0x94, 0x1c, 0xc0, 0x79, 0xdc, 0x56, 0xed, 0xb9, 0x1e, 0xf6, 0x1f, 0xcd, 0x53, 0xff, 0x1d,
+	0x00, 0xce, 0xb2, 0xea, 0xce, 0xe9, 0x3d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a Blacklisted by index.
+	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
+	// Queries a Paused by index.
+	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
+	// Queries a PendingOwner by index.
+	PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error)
+	// Queries a MinterController by index.
+	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
+	// Queries all minters managed by a controller.
+	MintersOfController(ctx context.Context, in *QueryMintersOfControllerRequest, opts ...grpc.CallOption) (*QueryMintersOfControllerResponse, error)
+	// Queries all controllers managing a minter.
+	ControllersOfMinter(ctx context.Context, in *QueryControllersOfMinterRequest, opts ...grpc.CallOption) (*QueryControllersOfMinterResponse, error)
+	// Queries the MinterControllers managing a minter, either as their primary
+	// minter or through a link. It reads the same minter to controller index as
+	// ControllersOfMinter, under the minter_controller routes.
+	MinterControllerByMinter(ctx context.Context, in *QueryGetMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerByMinterResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+	// Queries a FiatToken by denom.
+	FiatToken(ctx context.Context, in *QueryGetFiatTokenRequest, opts ...grpc.CallOption) (*QueryGetFiatTokenResponse, error)
+	// Queries a list of FiatToken items.
+	FiatTokenAll(ctx context.Context, in *QueryAllFiatTokenRequest, opts ...grpc.CallOption) (*QueryAllFiatTokenResponse, error)
+	// Queries the mint rate limit of a minter and its remaining capacity.
+	MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error)
+	// Queries a PendingMasterMinter by index.
+	PendingMasterMinter(ctx context.Context, in *QueryGetPendingMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetPendingMasterMinterResponse, error)
+	// Queries a PendingPauser by index.
+	PendingPauser(ctx context.Context, in *QueryGetPendingPauserRequest, opts ...grpc.CallOption) (*QueryGetPendingPauserResponse, error)
+	// Queries a PendingBlacklister by index.
+	PendingBlacklister(ctx context.Context, in *QueryGetPendingBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetPendingBlacklisterResponse, error)
+	// Queries the MaxSupply together with the current supply and headroom.
+	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
+	// Queries a Wiper by index.
+	Wiper(ctx context.Context, in *QueryGetWiperRequest, opts ...grpc.CallOption) (*QueryGetWiperResponse, error)
+	// Queries a PendingWiper by index.
+	PendingWiper(ctx context.Context, in *QueryGetPendingWiperRequest, opts ...grpc.CallOption) (*QueryGetPendingWiperResponse, error)
+	// Queries a Frozen by index.
+	Frozen(ctx context.Context, in *QueryGetFrozenRequest, opts ...grpc.CallOption) (*QueryGetFrozenResponse, error)
+	// Queries a list of Frozen items.
+	FrozenAll(ctx context.Context, in *QueryAllFrozenRequest, opts ...grpc.CallOption) (*QueryAllFrozenResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error)
+	// Queries the rate limit of a channel together with its usage in its rolling window.
+	ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error)
+	// Queries a list of ChannelRateLimit items.
+	ChannelRateLimitAll(ctx context.Context, in *QueryAllChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChannelRateLimitResponse, error)
+	// Queries the refunds quarantined for an address.
+	QuarantinedRefund(ctx context.Context, in *QueryGetQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryGetQuarantinedRefundResponse, error)
+	// Queries a list of QuarantinedRefund items.
+	QuarantinedRefundAll(ctx context.Context, in *QueryAllQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryAllQuarantinedRefundResponse, error)
+	// Queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
+	out := new(QueryGetBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
+	out := new(QueryAllBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/BlacklistedAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
+	out := new(QueryGetPausedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
+	out := new(QueryGetMasterMinterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MasterMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
+	out := new(QueryGetMintersResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Minters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
+	out := new(QueryAllMintersResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintersAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
+	out := new(QueryGetPauserResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Pauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
+	out := new(QueryGetBlacklisterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
+	out := new(QueryGetOwnerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Owner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error) {
+	out := new(QueryGetPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
+	out := new(QueryGetMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
+	out := new(QueryAllMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterControllerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintersOfController(ctx context.Context, in *QueryMintersOfControllerRequest, opts ...grpc.CallOption) (*QueryMintersOfControllerResponse, error) {
+	out := new(QueryMintersOfControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintersOfController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ControllersOfMinter(ctx context.Context, in *QueryControllersOfMinterRequest, opts ...grpc.CallOption) (*QueryControllersOfMinterResponse, error) {
+	out := new(QueryControllersOfMinterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ControllersOfMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerByMinter(ctx context.Context, in *QueryGetMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerByMinterResponse, error) {
+	out := new(QueryGetMinterControllerByMinterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterControllerByMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
+	out := new(QueryGetMintingDenomResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintingDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FiatToken(ctx context.Context, in *QueryGetFiatTokenRequest, opts ...grpc.CallOption) (*QueryGetFiatTokenResponse, error) {
+	out := new(QueryGetFiatTokenResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/FiatToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FiatTokenAll(ctx context.Context, in *QueryAllFiatTokenRequest, opts ...grpc.CallOption) (*QueryAllFiatTokenResponse, error) {
+	out := new(QueryAllFiatTokenResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/FiatTokenAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error) {
+	out := new(QueryMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMasterMinter(ctx context.Context, in *QueryGetPendingMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetPendingMasterMinterResponse, error) {
+	out := new(QueryGetPendingMasterMinterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingMasterMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPauser(ctx context.Context, in *QueryGetPendingPauserRequest, opts ...grpc.CallOption) (*QueryGetPendingPauserResponse, error) {
+	out := new(QueryGetPendingPauserResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingPauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBlacklister(ctx context.Context, in *QueryGetPendingBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetPendingBlacklisterResponse, error) {
+	out := new(QueryGetPendingBlacklisterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingBlacklister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error) {
+	out := new(QueryMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Wiper(ctx context.Context, in *QueryGetWiperRequest, opts ...grpc.CallOption) (*QueryGetWiperResponse, error) {
+	out := new(QueryGetWiperResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Wiper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingWiper(ctx context.Context, in *QueryGetPendingWiperRequest, opts ...grpc.CallOption) (*QueryGetPendingWiperResponse, error) {
+	out := new(QueryGetPendingWiperResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingWiper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Frozen(ctx context.Context, in *QueryGetFrozenRequest, opts ...grpc.CallOption) (*QueryGetFrozenResponse, error) {
+	out := new(QueryGetFrozenResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Frozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAll(ctx context.Context, in *QueryAllFrozenRequest, opts ...grpc.CallOption) (*QueryAllFrozenResponse, error) {
+	out := new(QueryAllFrozenResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/FrozenAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error) {
+	out := new(QueryGetAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/AllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error) {
+	out := new(QueryAllAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/AllowedChannelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimit(ctx context.Context, in *QueryChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitResponse, error) {
+	out := new(QueryChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ChannelRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimitAll(ctx context.Context, in *QueryAllChannelRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChannelRateLimitResponse, error) {
+	out := new(QueryAllChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ChannelRateLimitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedRefund(ctx context.Context, in *QueryGetQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryGetQuarantinedRefundResponse, error) {
+	out := new(QueryGetQuarantinedRefundResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/QuarantinedRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuarantinedRefundAll(ctx context.Context, in *QueryAllQuarantinedRefundRequest, opts ...grpc.CallOption) (*QueryAllQuarantinedRefundResponse, error) {
+	out := new(QueryAllQuarantinedRefundResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/QuarantinedRefundAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a Blacklisted by index.
+	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
+	// Queries a Paused by index.
+	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
+	// Queries a PendingOwner by index.
+	PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error)
+	// Queries a MinterController by index.
+	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
+	// Queries all minters managed by a controller.
+	MintersOfController(context.Context, *QueryMintersOfControllerRequest) (*QueryMintersOfControllerResponse, error)
+	// Queries all controllers managing a minter.
+	ControllersOfMinter(context.Context, *QueryControllersOfMinterRequest) (*QueryControllersOfMinterResponse, error)
+	// Queries the MinterControllers managing a minter, either as their primary
+	// minter or through a link. It reads the same minter to controller index as
+	// ControllersOfMinter, under the minter_controller routes.
+	MinterControllerByMinter(context.Context, *QueryGetMinterControllerByMinterRequest) (*QueryGetMinterControllerByMinterResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+	// Queries a FiatToken by denom.
+	FiatToken(context.Context, *QueryGetFiatTokenRequest) (*QueryGetFiatTokenResponse, error)
+	// Queries a list of FiatToken items.
+	FiatTokenAll(context.Context, *QueryAllFiatTokenRequest) (*QueryAllFiatTokenResponse, error)
+	// Queries the mint rate limit of a minter and its remaining capacity.
+	MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error)
+	// Queries a PendingMasterMinter by index.
+	PendingMasterMinter(context.Context, *QueryGetPendingMasterMinterRequest) (*QueryGetPendingMasterMinterResponse, error)
+	// Queries a PendingPauser by index.
+	PendingPauser(context.Context, *QueryGetPendingPauserRequest) (*QueryGetPendingPauserResponse, error)
+	// Queries a PendingBlacklister by index.
+	PendingBlacklister(context.Context, *QueryGetPendingBlacklisterRequest) (*QueryGetPendingBlacklisterResponse, error)
+	// Queries the MaxSupply together with the current supply and headroom.
+	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
+	// Queries a Wiper by index.
+	Wiper(context.Context, *QueryGetWiperRequest) (*QueryGetWiperResponse, error)
+	// Queries a PendingWiper by index.
+	PendingWiper(context.Context, *QueryGetPendingWiperRequest) (*QueryGetPendingWiperResponse, error)
+	// Queries a Frozen by index.
+	Frozen(context.Context, *QueryGetFrozenRequest) (*QueryGetFrozenResponse, error)
+	// Queries a list of Frozen items.
+	FrozenAll(context.Context, *QueryAllFrozenRequest) (*QueryAllFrozenResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(context.Context, *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(context.Context, *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error)
+	// Queries the rate limit of a channel together with its usage in its rolling window.
+	ChannelRateLimit(context.Context, *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error)
+	// Queries a list of ChannelRateLimit items.
+	ChannelRateLimitAll(context.Context, *QueryAllChannelRateLimitRequest) (*QueryAllChannelRateLimitResponse, error)
+	// Queries the refunds quarantined for an address.
+	QuarantinedRefund(context.Context, *QueryGetQuarantinedRefundRequest) (*QueryGetQuarantinedRefundResponse, error)
+	// Queries a list of QuarantinedRefund items.
+	QuarantinedRefundAll(context.Context, *QueryAllQuarantinedRefundRequest) (*QueryAllQuarantinedRefundResponse, error)
+	// Queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+}
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+}
+func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+}
+func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+}
+func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
+}
+func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+}
+func (*UnimplementedQueryServer) PendingOwner(ctx context.Context, req *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
+func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
+}
+func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
+}
+func (*UnimplementedQueryServer) MintersOfController(ctx context.Context, req *QueryMintersOfControllerRequest) (*QueryMintersOfControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintersOfController not implemented")
+}
+func (*UnimplementedQueryServer) ControllersOfMinter(ctx context.Context, req *QueryControllersOfMinterRequest) (*QueryControllersOfMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllersOfMinter not implemented")
+}
+func (*UnimplementedQueryServer) MinterControllerByMinter(ctx context.Context, req *QueryGetMinterControllerByMinterRequest) (*QueryGetMinterControllerByMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerByMinter not implemented")
+}
+func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+}
+func (*UnimplementedQueryServer) FiatToken(ctx context.Context, req *QueryGetFiatTokenRequest) (*QueryGetFiatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FiatToken not implemented")
+}
+func (*UnimplementedQueryServer) FiatTokenAll(ctx context.Context, req *QueryAllFiatTokenRequest) (*QueryAllFiatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FiatTokenAll not implemented")
+}
+func (*UnimplementedQueryServer) MintRateLimit(ctx context.Context, req *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) PendingMasterMinter(ctx context.Context, req *QueryGetPendingMasterMinterRequest) (*QueryGetPendingMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMasterMinter not implemented")
+}
+func (*UnimplementedQueryServer) PendingPauser(ctx context.Context, req *QueryGetPendingPauserRequest) (*QueryGetPendingPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPauser not implemented")
+}
+func (*UnimplementedQueryServer) PendingBlacklister(ctx context.Context, req *QueryGetPendingBlacklisterRequest) (*QueryGetPendingBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBlacklister not implemented")
+}
+func (*UnimplementedQueryServer) MaxSupply(ctx context.Context, req *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxSupply not implemented")
+}
+func (*UnimplementedQueryServer) Wiper(ctx context.Context, req *QueryGetWiperRequest) (*QueryGetWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wiper not implemented")
+}
+func (*UnimplementedQueryServer) PendingWiper(ctx context.Context, req *QueryGetPendingWiperRequest) (*QueryGetPendingWiperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWiper not implemented")
+}
+func (*UnimplementedQueryServer) Frozen(ctx context.Context, req *QueryGetFrozenRequest) (*QueryGetFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAll(ctx context.Context, req *QueryAllFrozenRequest) (*QueryAllFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAll not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannel(ctx context.Context, req *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannel not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannelAll(ctx context.Context, req *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannelAll not implemented")
+}
+func (*UnimplementedQueryServer) ChannelRateLimit(ctx context.Context, req *QueryChannelRateLimitRequest) (*QueryChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) ChannelRateLimitAll(ctx context.Context, req *QueryAllChannelRateLimitRequest) (*QueryAllChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) QuarantinedRefund(ctx context.Context, req *QueryGetQuarantinedRefundRequest) (*QueryGetQuarantinedRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedRefund not implemented")
+}
+func (*UnimplementedQueryServer) QuarantinedRefundAll(ctx context.Context, req *QueryAllQuarantinedRefundRequest) (*QueryAllQuarantinedRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedRefundAll not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistedAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/BlacklistedAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMasterMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MasterMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Minters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintersAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintersAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Pauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Owner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryGetPendingOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterControllerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintersOfController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintersOfControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintersOfController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintersOfController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintersOfController(ctx, req.(*QueryMintersOfControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ControllersOfMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllersOfMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ControllersOfMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ControllersOfMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ControllersOfMinter(ctx, req.(*QueryControllersOfMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerByMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterControllerByMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterControllerByMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, req.(*QueryGetMinterControllerByMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintingDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintingDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FiatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFiatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FiatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/FiatToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FiatToken(ctx, req.(*QueryGetFiatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FiatTokenAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFiatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FiatTokenAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/FiatTokenAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FiatTokenAll(ctx, req.(*QueryAllFiatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRateLimit(ctx, req.(*QueryMintRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingMasterMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingMasterMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMasterMinter(ctx, req.(*QueryGetPendingMasterMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingPauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPauser(ctx, req.(*QueryGetPendingPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBlacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingBlacklisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBlacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingBlacklister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBlacklister(ctx, req.(*QueryGetPendingBlacklisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxSupply(ctx, req.(*QueryMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Wiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWiperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Wiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Wiper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Wiper(ctx, req.(*QueryGetWiperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWiper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingWiperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWiper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingWiper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWiper(ctx, req.(*QueryGetPendingWiperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Frozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Frozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Frozen(ctx, req.(*QueryGetFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/FrozenAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAll(ctx, req.(*QueryAllFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/AllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannel(ctx, req.(*QueryGetAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/AllowedChannelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannelAll(ctx, req.(*QueryAllAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ChannelRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimit(ctx, req.(*QueryChannelRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ChannelRateLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimitAll(ctx, req.(*QueryAllChannelRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQuarantinedRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/QuarantinedRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedRefund(ctx, req.(*QueryGetQuarantinedRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuarantinedRefundAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQuarantinedRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedRefundAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/QuarantinedRefundAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedRefundAll(ctx, req.(*QueryAllQuarantinedRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "circle.fiattokenfactory.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Blacklisted",
+			Handler:    _Query_Blacklisted_Handler,
+		},
+		{
+			MethodName: "BlacklistedAll",
+			Handler:    _Query_BlacklistedAll_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "MasterMinter",
+			Handler:    _Query_MasterMinter_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "MintersAll",
+			Handler:    _Query_MintersAll_Handler,
+		},
+		{
+			MethodName: "Pauser",
+			Handler:    _Query_Pauser_Handler,
+		},
+		{
+			MethodName: "Blacklister",
+			Handler:    _Query_Blacklister_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
+		{
+			MethodName: "MinterController",
+			Handler:    _Query_MinterController_Handler,
+		},
+		{
+			MethodName: "MinterControllerAll",
+			Handler:    _Query_MinterControllerAll_Handler,
+		},
+		{
+			MethodName: "MintersOfController",
+			Handler:    _Query_MintersOfController_Handler,
+		},
+		{
+			MethodName: "ControllersOfMinter",
+			Handler:    _Query_ControllersOfMinter_Handler,
+		},
+		{
+			MethodName: "MinterControllerByMinter",
+			Handler:    _Query_MinterControllerByMinter_Handler,
+		},
+		{
+			MethodName: "MintingDenom",
+			Handler:    _Query_MintingDenom_Handler,
+		},
+		{
+			MethodName: "FiatToken",
+			Handler:    _Query_FiatToken_Handler,
+		},
+		{
+			MethodName: "FiatTokenAll",
+			Handler:    _Query_FiatTokenAll_Handler,
+		},
+		{
+			MethodName: "MintRateLimit",
+			Handler:    _Query_MintRateLimit_Handler,
+		},
+		{
+			MethodName: "PendingMasterMinter",
+			Handler:    _Query_PendingMasterMinter_Handler,
+		},
+		{
+			MethodName: "PendingPauser",
+			Handler:    _Query_PendingPauser_Handler,
+		},
+		{
+			MethodName: "PendingBlacklister",
+			Handler:    _Query_PendingBlacklister_Handler,
+		},
+		{
+			MethodName: "MaxSupply",
+			Handler:    _Query_MaxSupply_Handler,
+		},
+		{
+			MethodName: "Wiper",
+			Handler:    _Query_Wiper_Handler,
+		},
+		{
+			MethodName: "PendingWiper",
+			Handler:    _Query_PendingWiper_Handler,
+		},
+		{
+			MethodName: "Frozen",
+			Handler:    _Query_Frozen_Handler,
+		},
+		{
+			MethodName: "FrozenAll",
+			Handler:    _Query_FrozenAll_Handler,
+		},
+		{
+			MethodName: "AllowedChannel",
+			Handler:    _Query_AllowedChannel_Handler,
+		},
+		{
+			MethodName: "AllowedChannelAll",
+			Handler:    _Query_AllowedChannelAll_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimit",
+			Handler:    _Query_ChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimitAll",
+			Handler:    _Query_ChannelRateLimitAll_Handler,
+		},
+		{
+			MethodName: "QuarantinedRefund",
+			Handler:    _Query_QuarantinedRefund_Handler,
+		},
+		{
+			MethodName: "QuarantinedRefundAll",
+			Handler:    _Query_QuarantinedRefundAll_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "circle/fiattokenfactory/v1/query.proto",
+}
+
+func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blacklisted) > 0 {
+		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseRemaining) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseRemaining) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseRemaining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Scope != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingOwner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ControllerAddress) > 0 {
+		i -= len(m.ControllerAddress)
+		copy(dAtA[i:], m.ControllerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintersOfControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintersOfControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintersOfControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ControllerAddress) > 0 {
+		i -= len(m.ControllerAddress)
+		copy(dAtA[i:], m.ControllerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintersOfControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintersOfControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintersOfControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllersOfMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllersOfMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllersOfMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterAddress) > 0 {
+		i -= len(m.MinterAddress)
+		copy(dAtA[i:], m.MinterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllersOfMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllersOfMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllersOfMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerByMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerByMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerByMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterAddress) > 0 {
+		i -= len(m.MinterAddress)
+		copy(dAtA[i:], m.MinterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerByMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerByMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerByMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFiatTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFiatTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFiatTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFiatTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFiatTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFiatTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FiatToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFiatTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFiatTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFiatTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFiatTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFiatTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFiatTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FiatToken) > 0 {
+		for iNdEx := len(m.FiatToken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FiatToken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRelease != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRelease))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Headroom.Size()
+		i -= size
+		if _, err := m.Headroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MaxSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingMasterMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingMasterMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingMasterMinter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPauserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPauserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingPauser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingBlacklisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingBlacklisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingBlacklister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWiperRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWiperRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWiperRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWiperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWiperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWiperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Wiper.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingWiperRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingWiperRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingWiperRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingWiperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingWiperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingWiperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingWiper.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Frozen.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frozen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRelease != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRelease))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RemainingOutflow != nil {
+		{
+			size := m.RemainingOutflow.Size()
+			i -= size
+			if _, err := m.RemainingOutflow.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemainingInflow != nil {
+		{
+			size := m.RemainingInflow.Size()
+			i -= size
+			if _, err := m.RemainingInflow.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQuarantinedRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQuarantinedRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQuarantinedRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQuarantinedRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQuarantinedRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQuarantinedRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QuarantinedRefund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQuarantinedRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQuarantinedRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQuarantinedRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQuarantinedRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQuarantinedRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQuarantinedRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QuarantinedRefunds) > 0 {
+		for iNdEx := len(m.QuarantinedRefunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blacklisted) > 0 {
+		for _, e := range m.Blacklisted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paused.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseRemaining) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		n += 1 + sovQuery(uint64(m.Scope))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovQuery(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *QueryGetMasterMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMasterMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MasterMinter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPauserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pauser.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBlacklisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklister.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Owner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingOwner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ControllerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinterController.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintersOfControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ControllerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintersOfControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryControllersOfMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryControllersOfMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerByMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerByMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintingDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetMintingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetFiatTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFiatTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FiatToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFiatTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFiatTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FiatToken) > 0 {
+		for _, e := range m.FiatToken {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextRelease != 0 {
+		n += 1 + sovQuery(uint64(m.NextRelease))
+	}
+	return n
+}
+
+func (m *QueryMaxSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingMasterMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingMasterMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingMasterMinter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingPauserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingPauser.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingBlacklisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingBlacklisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingBlacklister.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetWiperRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWiperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Wiper.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingWiperRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingWiperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingWiper.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Frozen.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Frozen) > 0 {
+		for _, e := range m.Frozen {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAllowedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryChannelRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingInflow != nil {
+		l = m.RemainingInflow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingOutflow != nil {
+		l = m.RemainingOutflow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextRelease != 0 {
+		n += 1 + sovQuery(uint64(m.NextRelease))
+	}
+	return n
+}
+
+func (m *QueryAllChannelRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChannelRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetQuarantinedRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetQuarantinedRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QuarantinedRefund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllQuarantinedRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *QueryAllQuarantinedRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QuarantinedRefunds) > 0 {
+		for _, e := range m.QuarantinedRefunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
+			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, PauseRemaining{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseRemaining) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseRemaining: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseRemaining: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= PauseScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minters{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintersOfControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintersOfControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintersOfControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintersOfControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintersOfControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintersOfControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllersOfMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllersOfMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllersOfMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllersOfMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllersOfMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllersOfMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerByMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerByMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerByMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerByMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerByMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerByMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFiatTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFiatTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFiatTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFiatTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFiatTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFiatTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiatToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FiatToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFiatTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFiatTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFiatTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFiatTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFiatTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFiatTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiatToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FiatToken = append(m.FiatToken, FiatToken{})
+			if err := m.FiatToken[len(m.FiatToken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRelease", wireType)
+			}
+			m.NextRelease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRelease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingMasterMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingMasterMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingMasterMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingMasterMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingMasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingPauserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPauserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryGetPendingPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingBlacklisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingBlacklisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.MinterController.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryAllMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinterController) > 0 {
-		for _, e := range m.MinterController {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPendingBlacklisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingBlacklisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBlacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingBlacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetMintingDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetMintingDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MintingDenom.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWiperRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2770,15 +13466,15 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWiperRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWiperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2806,7 +13502,7 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2829,7 +13525,7 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWiperResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2852,15 +13548,15 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWiperResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWiperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wiper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2887,9 +13583,91 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Wiper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingWiperRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingWiperRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingWiperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2912,7 +13690,7 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPendingWiperResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2935,15 +13713,15 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPendingWiperResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPendingWiperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWiper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2970,10 +13748,7 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingWiper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2998,7 +13773,7 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFrozenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3021,17 +13796,17 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFrozenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3041,31 +13816,29 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
-			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3075,27 +13848,23 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3118,7 +13887,7 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3141,12 +13910,45 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3168,7 +13970,7 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFrozenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,15 +13993,15 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFrozenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,60 +14028,45 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3301,7 +14088,7 @@ func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,15 +14111,15 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3359,7 +14146,44 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Frozen = append(m.Frozen, Frozen{})
+			if err := m.Frozen[len(m.Frozen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3384,7 +14208,7 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3407,15 +14231,79 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3443,7 +14331,7 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3466,7 +14354,7 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,15 +14377,15 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3524,7 +14412,7 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3549,7 +14437,7 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowedChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,10 +14460,10 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowedChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3614,6 +14502,38 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3635,7 +14555,7 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowedChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,15 +14578,15 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowedChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3693,8 +14613,8 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minters = append(m.Minters, Minters{})
-			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3734,6 +14654,26 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3755,7 +14695,7 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3778,12 +14718,108 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3805,7 +14841,7 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3828,15 +14864,15 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3863,60 +14899,134 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v cosmossdk_io_math.Int
+			m.RemainingInflow = &v
+			if err := m.RemainingInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v cosmossdk_io_math.Int
+			m.RemainingOutflow = &v
+			if err := m.RemainingOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRelease", wireType)
+			}
+			m.NextRelease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRelease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3938,7 +15048,7 @@ func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChannelRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,15 +15071,15 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChannelRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChannelRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3996,60 +15106,45 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4071,7 +15166,7 @@ func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChannelRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4094,15 +15189,15 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChannelRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChannelRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4129,7 +15224,44 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, ChannelRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4154,7 +15286,7 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetQuarantinedRefundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4177,15 +15309,15 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetQuarantinedRefundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetQuarantinedRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4213,7 +15345,39 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4236,7 +15400,7 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetQuarantinedRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4259,15 +15423,15 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetQuarantinedRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetQuarantinedRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRefund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4294,7 +15458,7 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuarantinedRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4319,7 +15483,7 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllQuarantinedRefundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4342,10 +15506,10 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllQuarantinedRefundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllQuarantinedRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4384,6 +15548,38 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4405,7 +15601,7 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllQuarantinedRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,15 +15624,15 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllQuarantinedRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllQuarantinedRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedRefunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4463,8 +15659,8 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterController = append(m.MinterController, MinterController{})
-			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuarantinedRefunds = append(m.QuarantinedRefunds, QuarantinedRefund{})
+			if err := m.QuarantinedRefunds[len(m.QuarantinedRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4525,7 +15721,7 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4548,10 +15744,10 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4575,7 +15771,7 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4598,15 +15794,15 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4633,7 +15829,7 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
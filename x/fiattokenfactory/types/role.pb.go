@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/role.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role enumerates the privileged roles of a fiat token.
+type Role int32
+
+const (
+	ROLE_UNSPECIFIED   Role = 0
+	ROLE_OWNER         Role = 1
+	ROLE_MASTER_MINTER Role = 2
+	ROLE_PAUSER        Role = 3
+	ROLE_BLACKLISTER   Role = 4
+	ROLE_WIPER         Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_OWNER",
+	2: "ROLE_MASTER_MINTER",
+	3: "ROLE_PAUSER",
+	4: "ROLE_BLACKLISTER",
+	5: "ROLE_WIPER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":   0,
+	"ROLE_OWNER":         1,
+	"ROLE_MASTER_MINTER": 2,
+	"ROLE_PAUSER":        3,
+	"ROLE_BLACKLISTER":   4,
+	"ROLE_WIPER":         5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_545dea2e320084e3, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("circle.fiattokenfactory.v1.Role", Role_name, Role_value)
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/role.proto", fileDescriptor_545dea2e320084e3)
+}
+
+var fileDescriptor_545dea2e320084e3 = []byte{
+	// 259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2c, 0x4a,
+	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
+	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xca, 0xcf, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x92, 0x82, 0x28, 0xd3, 0x43, 0x57, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
+	0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x68, 0x35, 0x32, 0x72, 0xb1, 0x04, 0xe5, 0xe7,
+	0xa4, 0x0a, 0x89, 0x70, 0x09, 0x04, 0xf9, 0xfb, 0xb8, 0xc6, 0x87, 0xfa, 0x05, 0x07, 0xb8, 0x3a,
+	0x7b, 0xba, 0x79, 0xba, 0xba, 0x08, 0x30, 0x08, 0xf1, 0x71, 0x71, 0x81, 0x45, 0xfd, 0xc3, 0xfd,
+	0x5c, 0x83, 0x04, 0x18, 0x85, 0xc4, 0xb8, 0x84, 0xc0, 0x7c, 0x5f, 0xc7, 0xe0, 0x10, 0xd7, 0xa0,
+	0x78, 0x5f, 0x4f, 0xbf, 0x10, 0xd7, 0x20, 0x01, 0x26, 0x21, 0x7e, 0x2e, 0x6e, 0xb0, 0x78, 0x80,
+	0x63, 0x68, 0xb0, 0x6b, 0x90, 0x00, 0x33, 0xdc, 0x38, 0x27, 0x1f, 0x47, 0x67, 0x6f, 0x1f, 0x4f,
+	0x90, 0x6a, 0x01, 0x16, 0xb8, 0x71, 0xe1, 0x9e, 0x01, 0xae, 0x41, 0x02, 0xac, 0x52, 0x2c, 0x1d,
+	0x8b, 0xe5, 0x18, 0x9c, 0xe2, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
+	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
+	0x25, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe2, 0xb5, 0xb4, 0xcc,
+	0x3c, 0xfd, 0xbc, 0xfc, 0xa4, 0x9c, 0x54, 0x5d, 0x8c, 0xa0, 0xa8, 0xc0, 0x0c, 0x9d, 0x92, 0xca,
+	0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x57, 0x8d, 0x01, 0x03, 0x00, 0x19, 0x44, 0x20, 0xdb, 0x45,
+	0x01, 0x00, 0x00,
+}